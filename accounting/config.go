@@ -0,0 +1,28 @@
+package accounting
+
+import (
+	"errors"
+)
+
+type Config struct {
+	FiatCurrency string `long:"accounting.fiatcurrency" description:"Fiat currency to which the fees in fee reports can be converted"`
+	PriceFile    string `long:"accounting.pricefile" description:"Path to a CSV file with the daily prices of the currencies in the fiat currency"`
+}
+
+func (cfg *Config) Validate() error {
+	if cfg.PriceFile != "" && cfg.FiatCurrency == "" {
+		return errors.New("fiat currency has to be set when a price file is configured")
+	}
+
+	return nil
+}
+
+// LoadPrices reads the configured price file. It is read for every report, so that it can be updated while the daemon
+// is running
+func (cfg *Config) LoadPrices() (*Prices, error) {
+	if cfg.PriceFile == "" {
+		return nil, errors.New("no price file configured")
+	}
+
+	return LoadPrices(cfg.PriceFile)
+}
@@ -0,0 +1,118 @@
+package accounting
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+type price struct {
+	date  time.Time
+	value float64
+}
+
+// Prices are the daily prices of currencies in a fiat currency
+type Prices struct {
+	// Sorted by date for every currency
+	prices map[string][]price
+}
+
+// LoadPrices reads a CSV file with lines like "2021-03-01,BTC,45000.50". The price is the one of a whole coin and a
+// header line that starts with "date" is skipped
+func LoadPrices(path string) (*Prices, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, errors.New("could not open price file: " + err.Error())
+	}
+
+	defer file.Close()
+
+	return ParsePrices(file)
+}
+
+func ParsePrices(reader io.Reader) (*Prices, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = 3
+	csvReader.TrimLeadingSpace = true
+
+	prices := &Prices{
+		prices: make(map[string][]price),
+	}
+
+	for line := 1; ; line++ {
+		record, err := csvReader.Read()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, errors.New("could not read price file: " + err.Error())
+		}
+
+		if line == 1 && strings.EqualFold(record[0], "date") {
+			continue
+		}
+
+		date, err := time.Parse(dateLayout, record[0])
+
+		if err != nil {
+			return nil, errors.New("invalid date in line " + strconv.Itoa(line) + " of price file: " + record[0])
+		}
+
+		value, err := strconv.ParseFloat(record[2], 64)
+
+		if err != nil {
+			return nil, errors.New("invalid price in line " + strconv.Itoa(line) + " of price file: " + record[2])
+		}
+
+		symbol := strings.ToUpper(record[1])
+		prices.prices[symbol] = append(prices.prices[symbol], price{
+			date:  date,
+			value: value,
+		})
+	}
+
+	for _, currencyPrices := range prices.prices {
+		sort.Slice(currencyPrices, func(i, j int) bool {
+			return currencyPrices[i].date.Before(currencyPrices[j].date)
+		})
+	}
+
+	return prices, nil
+}
+
+// GetPrice returns the latest price of a currency from the day of the time or before it
+func (prices *Prices) GetPrice(symbol string, at time.Time) (float64, error) {
+	currencyPrices := prices.prices[strings.ToUpper(symbol)]
+
+	day := at.UTC().Truncate(24 * time.Hour)
+	index := sort.Search(len(currencyPrices), func(i int) bool {
+		return currencyPrices[i].date.After(day)
+	})
+
+	if index == 0 {
+		return 0, errors.New("no price of " + symbol + " found for " + day.Format(dateLayout))
+	}
+
+	return currencyPrices[index-1].value, nil
+}
+
+// ToFiat converts an amount in satoshis of a currency to the fiat currency with the price of the day of the time
+func (prices *Prices) ToFiat(symbol string, satoshis float64, at time.Time) (float64, error) {
+	value, err := prices.GetPrice(symbol, at)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return satoshis / 100000000 * value, nil
+}
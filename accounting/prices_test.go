@@ -0,0 +1,42 @@
+package accounting
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePrices(t *testing.T) {
+	prices, err := ParsePrices(strings.NewReader("date,symbol,price\n2021-03-02,BTC,50000\n2021-03-01,btc,45000.5\n2021-03-01,LTC,180\n"))
+	assert.Nil(t, err)
+
+	price, err := prices.GetPrice("BTC", time.Date(2021, 3, 1, 23, 59, 0, 0, time.UTC))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 45000.5, price)
+
+	// The latest known price is used for days without one
+	price, err = prices.GetPrice("BTC", time.Date(2021, 3, 10, 12, 0, 0, 0, time.UTC))
+
+	assert.Nil(t, err)
+	assert.Equal(t, float64(50000), price)
+
+	_, err = prices.GetPrice("BTC", time.Date(2021, 2, 28, 12, 0, 0, 0, time.UTC))
+	assert.Equal(t, "no price of BTC found for 2021-02-28", err.Error())
+
+	_, err = prices.GetPrice("LBTC", time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC))
+	assert.NotNil(t, err)
+
+	fiat, err := prices.ToFiat("LTC", 50000000, time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	assert.Nil(t, err)
+	assert.Equal(t, float64(90), fiat)
+
+	_, err = ParsePrices(strings.NewReader("2021-03-01,BTC,abc\n"))
+	assert.Equal(t, "invalid price in line 1 of price file: abc", err.Error())
+
+	_, err = ParsePrices(strings.NewReader("01.03.2021,BTC,45000\n"))
+	assert.Equal(t, "invalid date in line 1 of price file: 01.03.2021", err.Error())
+}
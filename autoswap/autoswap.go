@@ -0,0 +1,415 @@
+package autoswap
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/lnd"
+	"github.com/BoltzExchange/boltz-lnd/logger"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// Swapper creates swaps the same way they are created through the gRPC interface
+type Swapper interface {
+	GetServiceInfo(ctx context.Context, request *boltzrpc.GetServiceInfoRequest) (*boltzrpc.GetServiceInfoResponse, error)
+	CreateSwap(ctx context.Context, request *boltzrpc.CreateSwapRequest) (*boltzrpc.CreateSwapResponse, error)
+	CreateReverseSwap(ctx context.Context, request *boltzrpc.CreateReverseSwapRequest) (*boltzrpc.CreateReverseSwapResponse, error)
+}
+
+// Recommendation is a swap that would bring the local balance of a channel, or of all channels if ChannelId is 0,
+// back in between the configured thresholds
+type Recommendation struct {
+	Type      database.SwapType
+	Amount    uint64
+	ChannelId uint64
+
+	LocalBalance int64
+	Capacity     int64
+
+	FeeEstimation uint64
+
+	// Recommendations that are dismissed will not be executed
+	DismissedReason string
+}
+
+// Confirmation target of the transactions with which swaps are funded
+const fundingConfTarget = 2
+
+type AutoSwapper struct {
+	// The first node is the one of the [LND] section
+	nodes    []*lnd.LND
+	database *database.Database
+	swapper  Swapper
+
+	cfg     Config
+	cfgLock sync.RWMutex
+}
+
+func (autoSwapper *AutoSwapper) Init(cfg *Config, nodes []*lnd.LND, database *database.Database) error {
+	autoSwapper.nodes = nodes
+	err := autoSwapper.validateConfig(cfg)
+
+	if err != nil {
+		return errors.New("invalid autoswap config: " + err.Error())
+	}
+
+	autoSwapper.cfg = *cfg
+	autoSwapper.database = database
+
+	return nil
+}
+
+// Start checks the channel balances periodically and creates swaps with the Swapper when needed
+func (autoSwapper *AutoSwapper) Start(swapper Swapper) {
+	autoSwapper.swapper = swapper
+
+	logger.Info("Starting autoswap")
+
+	go func() {
+		for {
+			cfg := autoSwapper.GetConfig()
+
+			if cfg.Enabled {
+				autoSwapper.run(&cfg)
+			}
+
+			time.Sleep(time.Duration(cfg.Interval) * time.Second)
+		}
+	}()
+}
+
+func (autoSwapper *AutoSwapper) GetConfig() Config {
+	autoSwapper.cfgLock.RLock()
+	defer autoSwapper.cfgLock.RUnlock()
+
+	return autoSwapper.cfg
+}
+
+// SetConfig replaces the config until the daemon is restarted
+func (autoSwapper *AutoSwapper) SetConfig(cfg Config) error {
+	err := autoSwapper.validateConfig(&cfg)
+
+	if err != nil {
+		return err
+	}
+
+	autoSwapper.cfgLock.Lock()
+	autoSwapper.cfg = cfg
+	autoSwapper.cfgLock.Unlock()
+
+	logger.Info("Updated autoswap config")
+
+	return nil
+}
+
+func (autoSwapper *AutoSwapper) validateConfig(cfg *Config) error {
+	err := cfg.Validate()
+
+	if err != nil {
+		return err
+	}
+
+	_, err = autoSwapper.getNode(cfg.Node)
+	return err
+}
+
+func (autoSwapper *AutoSwapper) getNode(name string) (*lnd.LND, error) {
+	if name == "" {
+		return autoSwapper.nodes[0], nil
+	}
+
+	for _, node := range autoSwapper.nodes {
+		if node.Name == name {
+			return node, nil
+		}
+	}
+
+	return nil, errors.New("could not find node " + name)
+}
+
+func (autoSwapper *AutoSwapper) GetRecommendations() ([]*Recommendation, error) {
+	cfg := autoSwapper.GetConfig()
+	return autoSwapper.getRecommendations(&cfg)
+}
+
+func (autoSwapper *AutoSwapper) getRecommendations(cfg *Config) ([]*Recommendation, error) {
+	if autoSwapper.swapper == nil {
+		return nil, errors.New("autoswap was not started yet")
+	}
+
+	node, err := autoSwapper.getNode(cfg.Node)
+
+	if err != nil {
+		return nil, err
+	}
+
+	channels, err := node.ListChannels()
+
+	if err != nil {
+		return nil, errors.New("could not list channels: " + err.Error())
+	}
+
+	serviceInfo, err := autoSwapper.swapper.GetServiceInfo(context.Background(), &boltzrpc.GetServiceInfoRequest{
+		Node: cfg.Node,
+	})
+
+	if err != nil {
+		return nil, errors.New("could not get service info: " + err.Error())
+	}
+
+	recommendations := calculateRecommendations(cfg, channels.Channels, serviceInfo.Fees, serviceInfo.Limits)
+
+	spentFees, err := autoSwapper.database.QueryAutoSwapFees(time.Now().Add(-time.Duration(cfg.BudgetInterval) * time.Second))
+
+	if err != nil {
+		return nil, errors.New("could not query spent fees: " + err.Error())
+	}
+
+	pendingSwaps, err := autoSwapper.database.QueryPendingAutoSwapCount()
+
+	if err != nil {
+		return nil, errors.New("could not query pending swaps: " + err.Error())
+	}
+
+	applyLimits(cfg, recommendations, spentFees, pendingSwaps)
+
+	return recommendations, nil
+}
+
+func (autoSwapper *AutoSwapper) run(cfg *Config) {
+	recommendations, err := autoSwapper.getRecommendations(cfg)
+
+	if err != nil {
+		logger.Error("Could not get autoswap recommendations: " + err.Error())
+		return
+	}
+
+	for _, recommendation := range recommendations {
+		description := formatRecommendation(recommendation)
+
+		if recommendation.DismissedReason != "" {
+			logger.Info("Autoswap dismissed " + description + ": " + recommendation.DismissedReason)
+			continue
+		}
+
+		if cfg.DryRun {
+			logger.Info("Autoswap would create " + description)
+			continue
+		}
+
+		logger.Info("Autoswap creating " + description)
+
+		err := autoSwapper.execute(cfg, recommendation)
+
+		if err != nil {
+			logger.Error("Autoswap could not create " + description + ": " + err.Error())
+		}
+	}
+}
+
+func (autoSwapper *AutoSwapper) execute(cfg *Config, recommendation *Recommendation) error {
+	autoSwap := database.AutoSwap{
+		Type:          recommendation.Type,
+		Amount:        recommendation.Amount,
+		FeeEstimation: recommendation.FeeEstimation,
+		CreatedAt:     time.Now(),
+	}
+
+	switch recommendation.Type {
+	case database.SubmarineSwap:
+		response, err := autoSwapper.swapper.CreateSwap(context.Background(), &boltzrpc.CreateSwapRequest{
+			Amount: int64(recommendation.Amount),
+			FundFromWallet: &boltzrpc.WalletFunding{
+				ConfTarget: fundingConfTarget,
+			},
+			Node: cfg.Node,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		autoSwap.Id = response.Id
+		err = autoSwapper.database.CreateAutoSwap(autoSwap)
+
+		if err != nil {
+			return errors.New("could not save autoswap in database: " + err.Error())
+		}
+
+		logger.Info("Autoswap funded Swap " + response.Id + " with transaction " + response.LockupTransactionId)
+
+	case database.ReverseSubmarineSwap:
+		response, err := autoSwapper.swapper.CreateReverseSwap(context.Background(), &boltzrpc.CreateReverseSwapRequest{
+			Amount:    int64(recommendation.Amount),
+			Node:      cfg.Node,
+			ChannelId: recommendation.ChannelId,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		autoSwap.Id = response.Id
+		err = autoSwapper.database.CreateAutoSwap(autoSwap)
+
+		if err != nil {
+			return errors.New("could not save autoswap in database: " + err.Error())
+		}
+
+	default:
+		return errors.New("unsupported swap type")
+	}
+
+	return nil
+}
+
+func calculateRecommendations(cfg *Config, channels []*lnrpc.Channel, fees *boltzrpc.Fees, limits *boltzrpc.Limits) []*Recommendation {
+	var recommendations []*Recommendation
+
+	if cfg.PerChannel {
+		for _, channel := range channels {
+			if !channel.Active {
+				continue
+			}
+
+			recommendation := calculateRecommendation(cfg, channel.ChanId, channel.LocalBalance, channel.RemoteBalance, fees, limits)
+
+			if recommendation == nil {
+				continue
+			}
+
+			// Reverse swaps are paid through their channel, but Boltz chooses the route of the payment of swaps
+			if recommendation.Type == database.SubmarineSwap && recommendation.DismissedReason == "" {
+				recommendation.DismissedReason = "swaps cannot be routed through a specific channel"
+			}
+
+			recommendations = append(recommendations, recommendation)
+		}
+
+		return recommendations
+	}
+
+	var localBalance, remoteBalance int64
+
+	for _, channel := range channels {
+		if channel.Active {
+			localBalance += channel.LocalBalance
+			remoteBalance += channel.RemoteBalance
+		}
+	}
+
+	recommendation := calculateRecommendation(cfg, 0, localBalance, remoteBalance, fees, limits)
+
+	if recommendation != nil {
+		recommendations = append(recommendations, recommendation)
+	}
+
+	return recommendations
+}
+
+func calculateRecommendation(
+	cfg *Config,
+	channelId uint64,
+	localBalance int64,
+	remoteBalance int64,
+	fees *boltzrpc.Fees,
+	limits *boltzrpc.Limits,
+) *Recommendation {
+	capacity := localBalance + remoteBalance
+
+	if capacity == 0 {
+		return nil
+	}
+
+	targetBalance := capacity * int64(cfg.targetBalancePercent()) / 100
+
+	recommendation := &Recommendation{
+		ChannelId:    channelId,
+		LocalBalance: localBalance,
+		Capacity:     capacity,
+	}
+
+	var minerFee uint32
+
+	if localBalance*100 < capacity*int64(cfg.MinLocalBalancePercent) {
+		recommendation.Type = database.SubmarineSwap
+		recommendation.Amount = uint64(targetBalance - localBalance)
+		minerFee = fees.Miner.Normal
+	} else if localBalance*100 > capacity*int64(cfg.MaxLocalBalancePercent) {
+		recommendation.Type = database.ReverseSubmarineSwap
+		recommendation.Amount = uint64(localBalance - targetBalance)
+		minerFee = fees.Miner.Reverse
+	} else {
+		return nil
+	}
+
+	if recommendation.Amount > uint64(limits.Maximal) {
+		recommendation.Amount = uint64(limits.Maximal)
+	}
+
+	recommendation.FeeEstimation = uint64(math.Ceil(float64(recommendation.Amount)*float64(fees.Percentage)/100)) + uint64(minerFee)
+
+	if recommendation.Amount < uint64(limits.Minimal) {
+		recommendation.DismissedReason = "amount is less than the minimal swap amount of " + strconv.FormatInt(limits.Minimal, 10)
+	}
+
+	return recommendation
+}
+
+func applyLimits(cfg *Config, recommendations []*Recommendation, spentFees uint64, pendingSwaps uint32) {
+	for _, recommendation := range recommendations {
+		if recommendation.DismissedReason != "" {
+			continue
+		}
+
+		if float64(recommendation.FeeEstimation) > float64(recommendation.Amount)*cfg.MaxFeePercent/100 {
+			recommendation.DismissedReason = "fee of " + strconv.FormatUint(recommendation.FeeEstimation, 10) +
+				" is more than " + strconv.FormatFloat(cfg.MaxFeePercent, 'f', -1, 64) + "% of the amount"
+			continue
+		}
+
+		if spentFees+recommendation.FeeEstimation > cfg.Budget {
+			recommendation.DismissedReason = "fee of " + strconv.FormatUint(recommendation.FeeEstimation, 10) +
+				" exceeds the remaining budget of " + strconv.FormatUint(remainingBudget(cfg.Budget, spentFees), 10)
+			continue
+		}
+
+		if pendingSwaps >= cfg.MaxInFlight {
+			recommendation.DismissedReason = "maximal number of " + strconv.Itoa(int(cfg.MaxInFlight)) + " pending swaps reached"
+			continue
+		}
+
+		spentFees += recommendation.FeeEstimation
+		pendingSwaps += 1
+	}
+}
+
+func remainingBudget(budget uint64, spentFees uint64) uint64 {
+	if spentFees > budget {
+		return 0
+	}
+
+	return budget - spentFees
+}
+
+func formatRecommendation(recommendation *Recommendation) string {
+	swapType := "Swap"
+
+	if recommendation.Type == database.ReverseSubmarineSwap {
+		swapType = "Reverse Swap"
+	}
+
+	description := swapType + " of " + strconv.FormatUint(recommendation.Amount, 10) + " satoshis"
+
+	if recommendation.ChannelId != 0 {
+		description += " for channel " + strconv.FormatUint(recommendation.ChannelId, 10)
+	}
+
+	return description
+}
@@ -0,0 +1,145 @@
+package autoswap
+
+import (
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/lnd"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+)
+
+var testConfig = Config{
+	Interval: 60,
+
+	MinLocalBalancePercent: 25,
+	MaxLocalBalancePercent: 75,
+
+	MaxFeePercent:  1,
+	Budget:         10000,
+	BudgetInterval: 60,
+
+	MaxInFlight: 2,
+}
+
+var testFees = &boltzrpc.Fees{
+	Percentage: 0.5,
+	Miner: &boltzrpc.MinerFees{
+		Normal:  100,
+		Reverse: 200,
+	},
+}
+
+var testLimits = &boltzrpc.Limits{
+	Minimal: 10000,
+	Maximal: 300000,
+}
+
+func TestValidate(t *testing.T) {
+	cfg := testConfig
+	assert.Nil(t, cfg.Validate())
+
+	cfg.MinLocalBalancePercent = 80
+	assert.Equal(t, "minimal local balance 80% has to be less than maximal local balance 75%", cfg.Validate().Error())
+
+	cfg = testConfig
+	cfg.Interval = 0
+	assert.Equal(t, "interval has to be greater than 0", cfg.Validate().Error())
+}
+
+func TestCalculateRecommendations(t *testing.T) {
+	channels := []*lnrpc.Channel{
+		{ChanId: 1, Active: true, LocalBalance: 100000, RemoteBalance: 900000},
+		{ChanId: 2, Active: true, LocalBalance: 950000, RemoteBalance: 50000},
+		{ChanId: 3, Active: true, LocalBalance: 500000, RemoteBalance: 500000},
+		{ChanId: 4, Active: false, LocalBalance: 0, RemoteBalance: 1000000},
+		{ChanId: 5, Active: true, LocalBalance: 4000, RemoteBalance: 16000},
+	}
+
+	cfg := testConfig
+
+	// Sum of all active channels is balanced
+	assert.Empty(t, calculateRecommendations(&cfg, channels, testFees, testLimits))
+
+	cfg.PerChannel = true
+	recommendations := calculateRecommendations(&cfg, channels, testFees, testLimits)
+
+	assert.Len(t, recommendations, 3)
+
+	// Swaps are dismissed because the payment of Boltz cannot be pinned to the channel
+	assert.Equal(t, &Recommendation{
+		Type:            database.SubmarineSwap,
+		Amount:          300000,
+		ChannelId:       1,
+		LocalBalance:    100000,
+		Capacity:        1000000,
+		FeeEstimation:   1600,
+		DismissedReason: "swaps cannot be routed through a specific channel",
+	}, recommendations[0])
+
+	assert.Equal(t, &Recommendation{
+		Type:          database.ReverseSubmarineSwap,
+		Amount:        300000,
+		ChannelId:     2,
+		LocalBalance:  950000,
+		Capacity:      1000000,
+		FeeEstimation: 1700,
+	}, recommendations[1])
+
+	assert.Equal(t, uint64(6000), recommendations[2].Amount)
+	assert.Equal(t, "amount is less than the minimal swap amount of 10000", recommendations[2].DismissedReason)
+}
+
+func TestApplyLimits(t *testing.T) {
+	newRecommendations := func() []*Recommendation {
+		return []*Recommendation{
+			{Type: database.SubmarineSwap, Amount: 200000, FeeEstimation: 1100},
+			{Type: database.ReverseSubmarineSwap, Amount: 100000, FeeEstimation: 1100},
+			{Type: database.ReverseSubmarineSwap, Amount: 100000, FeeEstimation: 500},
+		}
+	}
+
+	cfg := testConfig
+
+	recommendations := newRecommendations()
+	applyLimits(&cfg, recommendations, 0, 0)
+
+	assert.Equal(t, "", recommendations[0].DismissedReason)
+	assert.Equal(t, "fee of 1100 is more than 1% of the amount", recommendations[1].DismissedReason)
+	assert.Equal(t, "", recommendations[2].DismissedReason)
+
+	recommendations = newRecommendations()
+	applyLimits(&cfg, recommendations, 9000, 0)
+
+	assert.Equal(t, "fee of 1100 exceeds the remaining budget of 1000", recommendations[0].DismissedReason)
+	assert.Equal(t, "", recommendations[2].DismissedReason)
+
+	recommendations = newRecommendations()
+	applyLimits(&cfg, recommendations, 0, 1)
+
+	assert.Equal(t, "", recommendations[0].DismissedReason)
+	assert.Equal(t, "maximal number of 2 pending swaps reached", recommendations[2].DismissedReason)
+}
+
+func TestValidateNode(t *testing.T) {
+	autoSwapper := &AutoSwapper{
+		nodes: []*lnd.LND{{Name: "lnd"}, {Name: "second"}},
+	}
+
+	cfg := testConfig
+
+	for _, name := range []string{"", "lnd", "second"} {
+		cfg.Node = name
+		assert.Nil(t, autoSwapper.validateConfig(&cfg))
+	}
+
+	node, err := autoSwapper.getNode("second")
+
+	assert.Nil(t, err)
+	assert.Equal(t, autoSwapper.nodes[1], node)
+
+	cfg.Node = "third"
+	assert.Equal(t, "could not find node third", autoSwapper.validateConfig(&cfg).Error())
+	assert.Equal(t, "could not find node third", autoSwapper.SetConfig(cfg).Error())
+}
@@ -0,0 +1,55 @@
+package autoswap
+
+import (
+	"errors"
+	"strconv"
+)
+
+type Config struct {
+	Enabled bool `long:"autoswap.enabled" description:"Whether swaps should be created automatically to rebalance the channels"`
+	DryRun  bool `long:"autoswap.dryrun" description:"Only log the swaps that would be created instead of creating them"`
+
+	Interval uint32 `long:"autoswap.interval" description:"Interval in seconds in which the channel balances are checked"`
+
+	Node string `long:"autoswap.node" description:"Name of the LND node of which the channels are rebalanced"`
+
+	PerChannel             bool   `long:"autoswap.perchannel" description:"Whether the balance thresholds apply to every channel instead of the sum of all channels. Only reverse swaps are created for single channels"`
+	MinLocalBalancePercent uint32 `long:"autoswap.minlocalbalance" description:"Percentage of local balance below which a swap is created"`
+	MaxLocalBalancePercent uint32 `long:"autoswap.maxlocalbalance" description:"Percentage of local balance above which a reverse swap is created"`
+
+	MaxFeePercent  float64 `long:"autoswap.maxfeepercent" description:"Maximal percentage of the swap amount that can be spent on fees"`
+	Budget         uint64  `long:"autoswap.budget" description:"Maximal amount of satoshis that can be spent on fees in a budget interval"`
+	BudgetInterval uint64  `long:"autoswap.budgetinterval" description:"Length of the budget interval in seconds"`
+
+	MaxInFlight uint32 `long:"autoswap.maxinflight" description:"Maximal number of swaps created by autoswap that can be pending at the same time"`
+}
+
+func (cfg *Config) Validate() error {
+	if cfg.Interval == 0 {
+		return errors.New("interval has to be greater than 0")
+	}
+
+	if cfg.MaxLocalBalancePercent > 100 {
+		return errors.New("maximal local balance cannot be more than 100%")
+	}
+
+	if cfg.MinLocalBalancePercent >= cfg.MaxLocalBalancePercent {
+		return errors.New("minimal local balance " + strconv.Itoa(int(cfg.MinLocalBalancePercent)) +
+			"% has to be less than maximal local balance " + strconv.Itoa(int(cfg.MaxLocalBalancePercent)) + "%")
+	}
+
+	if cfg.MaxFeePercent < 0 {
+		return errors.New("maximal fee percentage cannot be negative")
+	}
+
+	if cfg.BudgetInterval == 0 {
+		return errors.New("budget interval has to be greater than 0")
+	}
+
+	return nil
+}
+
+// The balance swaps try to reach is in the middle of the thresholds
+func (cfg *Config) targetBalancePercent() uint32 {
+	return (cfg.MinLocalBalancePercent + cfg.MaxLocalBalancePercent) / 2
+}
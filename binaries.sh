@@ -54,8 +54,10 @@ for build_system in "$@"; do
   if [[ $os == "windows" ]]; then
       mv boltzd "$destinationPath"/boltzd.exe
       mv boltzcli "$destinationPath"/boltzcli.exe
+      mv boltzrefund "$destinationPath"/boltzrefund.exe
   else
       mv boltzd "$destinationPath"/boltzd
       mv boltzcli "$destinationPath"/boltzcli
+      mv boltzrefund "$destinationPath"/boltzrefund
   fi
 done
@@ -4,9 +4,16 @@ import (
 	"crypto/sha256"
 	"errors"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 )
 
+var invalidAddress = errors.New("invalid address")
+
+// Output types of lockup addresses of Swaps that can be verified with a redeem script
+var swapOutputTypes = []OutputType{Compatibility, SegWit}
+
 func CheckSwapAddress(chainParams *chaincfg.Params, address string, redeemScript []byte, isNested bool) error {
 	var err error
 	var encodedAddress string
@@ -22,12 +29,60 @@ func CheckSwapAddress(chainParams *chaincfg.Params, address string, redeemScript
 	}
 
 	if address != encodedAddress {
-		return errors.New("invalid address")
+		return invalidAddress
 	}
 
 	return nil
 }
 
+// ParseSwapOutputType parses the output types that FindSwapOutputType can return
+func ParseSwapOutputType(outputType string) (OutputType, error) {
+	for _, swapOutputType := range swapOutputTypes {
+		if swapOutputType.String() == outputType {
+			return swapOutputType, nil
+		}
+	}
+
+	return 0, errors.New("unsupported output type " + outputType)
+}
+
+// FindSwapOutputType verifies that the address is either a nested or a native P2WSH address of the redeem script and
+// returns which one of those it is
+func FindSwapOutputType(chainParams *chaincfg.Params, address string, redeemScript []byte) (OutputType, error) {
+	for _, outputType := range swapOutputTypes {
+		err := CheckSwapAddress(chainParams, address, redeemScript, outputType == Compatibility)
+
+		if err != invalidAddress {
+			return outputType, err
+		}
+	}
+
+	return 0, invalidAddress
+}
+
+// FindLockupOutput returns the index and output type of the first output that pays to the nested or native P2WSH
+// address of the redeem script
+func FindLockupOutput(chainParams *chaincfg.Params, redeemScript []byte, outputs []*wire.TxOut) (uint32, OutputType, error) {
+	for vout, output := range outputs {
+		_, outputAddresses, _, err := txscript.ExtractPkScriptAddrs(output.PkScript, chainParams)
+
+		// Just ignore outputs we can't decode
+		if err != nil {
+			continue
+		}
+
+		for _, outputAddress := range outputAddresses {
+			outputType, err := FindSwapOutputType(chainParams, outputAddress.EncodeAddress(), redeemScript)
+
+			if err == nil {
+				return uint32(vout), outputType, nil
+			}
+		}
+	}
+
+	return 0, 0, errors.New("could not find lockup output")
+}
+
 func WitnessScriptHashAddress(chainParams *chaincfg.Params, redeemScript []byte) (string, error) {
 	hash := sha256.Sum256(redeemScript)
 	address, err := btcutil.NewAddressWitnessScriptHash(hash[:], chainParams)
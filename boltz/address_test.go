@@ -4,6 +4,9 @@ import (
 	"encoding/hex"
 	"errors"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -47,3 +50,67 @@ func TestNestedScriptHashAddress(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, "3F8UixJcrfxCaGpRryyRuKotBFXRFeW7ej", address)
 }
+
+func TestFindSwapOutputType(t *testing.T) {
+	outputType, err := FindSwapOutputType(chainParams, "3F8UixJcrfxCaGpRryyRuKotBFXRFeW7ej", redeemScript)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Compatibility, outputType)
+
+	outputType, err = FindSwapOutputType(chainParams, "bc1q73lzkly9le40qxym5wh5wyp0davanw3u9m0u28wafay4ay7z34cscztt48", redeemScript)
+
+	assert.Nil(t, err)
+	assert.Equal(t, SegWit, outputType)
+
+	_, err = FindSwapOutputType(chainParams, "32Hjgh4J1kZFGbuJ9aPwqmqz3L5GkhNAzR", redeemScript)
+	assert.Equal(t, errors.New("invalid address"), err)
+}
+
+func TestParseSwapOutputType(t *testing.T) {
+	for _, outputType := range []OutputType{SegWit, Compatibility} {
+		parsed, err := ParseSwapOutputType(outputType.String())
+
+		assert.Nil(t, err)
+		assert.Equal(t, outputType, parsed)
+	}
+
+	for _, outputType := range []OutputType{Legacy, Taproot} {
+		_, err := ParseSwapOutputType(outputType.String())
+		assert.Equal(t, "unsupported output type "+outputType.String(), err.Error())
+	}
+}
+
+func TestFindLockupOutput(t *testing.T) {
+	newOutput := func(address string) *wire.TxOut {
+		decodedAddress, err := btcutil.DecodeAddress(address, chainParams)
+		assert.Nil(t, err)
+
+		outputScript, err := txscript.PayToAddrScript(decodedAddress)
+		assert.Nil(t, err)
+
+		return wire.NewTxOut(100000, outputScript)
+	}
+
+	outputs := []*wire.TxOut{
+		newOutput("32Hjgh4J1kZFGbuJ9aPwqmqz3L5GkhNAzR"),
+		// Outputs that cannot be decoded are ignored
+		wire.NewTxOut(0, []byte{txscript.OP_RETURN}),
+		newOutput("bc1q73lzkly9le40qxym5wh5wyp0davanw3u9m0u28wafay4ay7z34cscztt48"),
+	}
+
+	vout, outputType, err := FindLockupOutput(chainParams, redeemScript, outputs)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), vout)
+	assert.Equal(t, SegWit, outputType)
+
+	outputs = append(outputs[:1], newOutput("3F8UixJcrfxCaGpRryyRuKotBFXRFeW7ej"))
+	vout, outputType, err = FindLockupOutput(chainParams, redeemScript, outputs)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), vout)
+	assert.Equal(t, Compatibility, outputType)
+
+	_, _, err = FindLockupOutput(chainParams, redeemScript, outputs[:1])
+	assert.Equal(t, "could not find lockup output", err.Error())
+}
@@ -7,6 +7,9 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"time"
+
+	"github.com/BoltzExchange/boltz-lnd/metrics"
 )
 
 type Boltz struct {
@@ -83,6 +86,17 @@ type GetSwapTransactionResponse struct {
 	Error string `json:"error"`
 }
 
+type GetTransactionRequest struct {
+	Currency      string `json:"currency"`
+	TransactionId string `json:"transactionId"`
+}
+
+type GetTransactionResponse struct {
+	TransactionHex string `json:"transactionHex"`
+
+	Error string `json:"error"`
+}
+
 type BroadcastTransactionRequest struct {
 	Currency       string `json:"currency"`
 	TransactionHex string `json:"transactionHex"`
@@ -101,6 +115,8 @@ type CreateSwapRequest struct {
 	RefundPublicKey string `json:"refundPublicKey"`
 	Invoice         string `json:"invoice"`
 	PreimageHash    string `json:"preimageHash"`
+	// Type of the lockup output the client asks for. Boltz chooses one if it is not set
+	OutputType string `json:"outputType,omitempty"`
 }
 
 type CreateSwapResponse struct {
@@ -112,6 +128,12 @@ type CreateSwapResponse struct {
 	ExpectedAmount     uint64 `json:"expectedAmount"`
 	TimeoutBlockHeight uint32 `json:"timeoutBlockHeight"`
 
+	// Only set for swaps on Liquid
+	BlindingKey string `json:"blindingKey"`
+
+	// Only set for Taproot swaps, which are rejected
+	SwapTree json.RawMessage `json:"swapTree,omitempty"`
+
 	Error string `json:"error"`
 }
 
@@ -171,6 +193,9 @@ type CreateReverseSwapResponse struct {
 	LockupAddress      string `json:"lockupAddress"`
 	TimeoutBlockHeight uint32 `json:"TimeoutBlockHeight"`
 
+	// Only set for swaps on Liquid
+	BlindingKey string `json:"blindingKey"`
+
 	Error string `json:"error"`
 }
 
@@ -178,6 +203,10 @@ func (boltz *Boltz) Init(symbol string) {
 	boltz.symbol = symbol
 }
 
+func (boltz *Boltz) Symbol() string {
+	return boltz.symbol
+}
+
 func (boltz *Boltz) GetVersion() (*GetVersionResponse, error) {
 	var response GetVersionResponse
 	err := boltz.sendGetRequest("/version", &response)
@@ -192,8 +221,8 @@ func (boltz *Boltz) GetPairs() (*GetPairsResponse, error) {
 	return &response, err
 }
 
-func (boltz *Boltz) GetFeeEstimation() (*map[string]uint64, error) {
-	var response map[string]uint64
+func (boltz *Boltz) GetFeeEstimation() (*map[string]float64, error) {
+	var response map[string]float64
 	err := boltz.sendGetRequest("/getfeeestimation", &response)
 
 	return &response, err
@@ -236,6 +265,20 @@ func (boltz *Boltz) GetSwapTransaction(id string) (*GetSwapTransactionResponse,
 	return &response, err
 }
 
+func (boltz *Boltz) GetTransaction(transactionId string) (*GetTransactionResponse, error) {
+	var response GetTransactionResponse
+	err := boltz.sendPostRequest("/gettransaction", GetTransactionRequest{
+		Currency:      boltz.symbol,
+		TransactionId: transactionId,
+	}, &response)
+
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	return &response, err
+}
+
 func (boltz *Boltz) BroadcastTransaction(transactionHex string) (*BroadcastTransactionResponse, error) {
 	var response BroadcastTransactionResponse
 	err := boltz.sendPostRequest("/broadcasttransaction", BroadcastTransactionRequest{
@@ -304,38 +347,61 @@ func (boltz *Boltz) CreateReverseSwap(request CreateReverseSwapRequest) (*Create
 	return &response, err
 }
 
-func (boltz *Boltz) sendGetRequest(endpoint string, response interface{}) error {
+func (boltz *Boltz) sendGetRequest(endpoint string, response interface{}) (err error) {
+	start := time.Now()
+	var apiError string
+
+	defer func() {
+		metrics.ObserveApiRequest(endpoint, start, err != nil || apiError != "")
+	}()
+
 	res, err := http.Get(boltz.URL + endpoint)
 
 	if err != nil {
 		return err
 	}
 
-	return unmarshalJson(res.Body, &response)
+	apiError, err = unmarshalJson(res.Body, &response)
+	return err
 }
 
-func (boltz *Boltz) sendPostRequest(endpoint string, requestBody interface{}, response interface{}) error {
+func (boltz *Boltz) sendPostRequest(endpoint string, requestBody interface{}, response interface{}) (err error) {
 	rawBody, err := json.Marshal(requestBody)
 
 	if err != nil {
 		return err
 	}
 
+	start := time.Now()
+	var apiError string
+
+	defer func() {
+		metrics.ObserveApiRequest(endpoint, start, err != nil || apiError != "")
+	}()
+
 	res, err := http.Post(boltz.URL+endpoint, "application/json", bytes.NewBuffer(rawBody))
 
 	if err != nil {
 		return err
 	}
 
-	return unmarshalJson(res.Body, &response)
+	apiError, err = unmarshalJson(res.Body, &response)
+	return err
 }
 
-func unmarshalJson(body io.ReadCloser, response interface{}) error {
+// unmarshalJson decodes the response body and also returns the error with which the Boltz API rejected the request
+func unmarshalJson(body io.ReadCloser, response interface{}) (string, error) {
 	rawBody, err := ioutil.ReadAll(body)
 
 	if err != nil {
-		return err
+		return "", err
+	}
+
+	// Responses that are not JSON objects cannot contain an error
+	var errorResponse struct {
+		Error string `json:"error"`
 	}
+	_ = json.Unmarshal(rawBody, &errorResponse)
 
-	return json.Unmarshal(rawBody, &response)
+	return errorResponse.Error, json.Unmarshal(rawBody, &response)
 }
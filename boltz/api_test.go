@@ -0,0 +1,34 @@
+package boltz
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalJson(t *testing.T) {
+	var response SwapStatusResponse
+
+	apiError, err := unmarshalJson(ioutil.NopCloser(strings.NewReader(`{"error":"could not find swap"}`)), &response)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "could not find swap", apiError)
+	assert.Equal(t, "could not find swap", response.Error)
+
+	var feeEstimations map[string]int
+
+	apiError, err = unmarshalJson(ioutil.NopCloser(strings.NewReader(`{"BTC":20}`)), &feeEstimations)
+
+	assert.Nil(t, err)
+	assert.Empty(t, apiError)
+	assert.Equal(t, 20, feeEstimations["BTC"])
+
+	var pairs []string
+
+	apiError, err = unmarshalJson(ioutil.NopCloser(strings.NewReader(`["BTC/BTC"]`)), &pairs)
+
+	assert.Nil(t, err)
+	assert.Empty(t, apiError)
+}
@@ -96,12 +96,14 @@ const (
 	ChannelNone ChannelState = iota
 	ChannelAccepted
 	ChannelSettled
+	ChannelError
 )
 
 var channelStateStrings = map[string]ChannelState{
 	"none":     ChannelNone,
 	"accepted": ChannelAccepted,
 	"settled":  ChannelSettled,
+	"error":    ChannelError,
 }
 
 func (event ChannelState) String() string {
@@ -0,0 +1,330 @@
+package boltz
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/vulpemventures/go-elements/address"
+	"github.com/vulpemventures/go-elements/confidential"
+	"github.com/vulpemventures/go-elements/elementsutil"
+	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/transaction"
+)
+
+type LiquidOutputDetails struct {
+	LockupTransaction *transaction.Transaction
+	Vout              uint32
+	OutputType        OutputType
+
+	RedeemScript []byte
+	PrivateKey   *btcec.PrivateKey
+
+	// Private blinding key of the lockup address. Can be nil in case the lockup output is not confidential
+	BlindingKey *btcec.PrivateKey
+
+	// Should be set to an empty array in case of a refund
+	Preimage []byte
+
+	// Can be zero in case of a claim transaction
+	TimeoutBlockHeight uint32
+}
+
+type UnblindedOutput struct {
+	Asset []byte
+	Value uint64
+}
+
+func ParseLiquidTransaction(transactionHex string) (*transaction.Transaction, error) {
+	return transaction.NewTxFromHex(transactionHex)
+}
+
+func SerializeLiquidTransaction(transaction *transaction.Transaction) (string, error) {
+	return transaction.ToHex()
+}
+
+// UnblindLiquidOutput reveals the asset and value of an output. Confidential outputs are unblinded with the
+// private blinding key, explicit ones are just decoded
+func UnblindLiquidOutput(output *transaction.TxOutput, blindingKey *btcec.PrivateKey) (*UnblindedOutput, error) {
+	if !output.IsConfidential() {
+		value, err := elementsutil.ElementsToSatoshiValue(output.Value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &UnblindedOutput{
+			Asset: elementsutil.ReverseBytes(output.Asset[1:]),
+			Value: value,
+		}, nil
+	}
+
+	if blindingKey == nil {
+		return nil, errors.New("no blinding key to unblind confidential output")
+	}
+
+	unblinded, err := confidential.UnblindOutputWithKey(output, blindingKey.Serialize())
+
+	if err != nil {
+		return nil, errors.New("could not unblind output: " + err.Error())
+	}
+
+	return &UnblindedOutput{
+		Asset: elementsutil.ReverseBytes(unblinded.Asset),
+		Value: unblinded.Value,
+	}, nil
+}
+
+// FindLiquidLockupVout looks for the output with the expected script that locks the L-BTC asset of the network
+// and returns its index and unblinded value
+func FindLiquidLockupVout(
+	liquidNetwork *network.Network,
+	lockupTransaction *transaction.Transaction,
+	lockupScript []byte,
+	blindingKey *btcec.PrivateKey,
+) (uint32, uint64, error) {
+	for vout, output := range lockupTransaction.Outputs {
+		if !bytes.Equal(output.Script, lockupScript) {
+			continue
+		}
+
+		unblinded, err := UnblindLiquidOutput(output, blindingKey)
+
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if hex.EncodeToString(unblinded.Asset) != liquidNetwork.AssetID {
+			return 0, 0, errors.New("lockup output does not lock L-BTC")
+		}
+
+		return uint32(vout), unblinded.Value, nil
+	}
+
+	return 0, 0, errors.New("could not find lockup vout")
+}
+
+// LiquidOutputScript returns the output script of a Liquid address. Confidential addresses are supported
+func LiquidOutputScript(liquidNetwork *network.Network, encodedAddress string) ([]byte, error) {
+	addressNetwork, err := address.NetworkForAddress(encodedAddress)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if addressNetwork.Name != liquidNetwork.Name {
+		return nil, errors.New("address is not on network " + liquidNetwork.Name)
+	}
+
+	return address.ToOutputScript(encodedAddress)
+}
+
+func LiquidSwapOutputScript(redeemScript []byte, isNested bool) ([]byte, error) {
+	if isNested {
+		return txscript.NewScriptBuilder().
+			AddOp(txscript.OP_HASH160).
+			AddData(btcutil.Hash160(createNestedP2shScript(redeemScript))).
+			AddOp(txscript.OP_EQUAL).
+			Script()
+	}
+
+	return createNestedP2shScript(redeemScript), nil
+}
+
+// CheckLiquidSwapAddress verifies that a confidential lockup address pays to the redeem script and is blinded
+// with the public key of the blinding key we got from Boltz
+func CheckLiquidSwapAddress(
+	liquidNetwork *network.Network,
+	encodedAddress string,
+	redeemScript []byte,
+	blindingKey *btcec.PrivateKey,
+	isNested bool,
+) error {
+	addressScript, err := LiquidOutputScript(liquidNetwork, encodedAddress)
+
+	if err != nil {
+		return errors.New("could not decode address: " + err.Error())
+	}
+
+	expectedScript, err := LiquidSwapOutputScript(redeemScript, isNested)
+
+	if err != nil {
+		return errors.New("could not encode address")
+	}
+
+	if !bytes.Equal(addressScript, expectedScript) {
+		return invalidAddress
+	}
+
+	confidentialAddress, err := address.FromConfidential(encodedAddress)
+
+	if err != nil {
+		return errors.New("address is not confidential")
+	}
+
+	if !bytes.Equal(confidentialAddress.BlindingKey, blindingKey.PubKey().SerializeCompressed()) {
+		return errors.New("invalid blinding key of address")
+	}
+
+	return nil
+}
+
+// FindLiquidSwapOutputType is the Liquid equivalent of FindSwapOutputType
+func FindLiquidSwapOutputType(
+	liquidNetwork *network.Network,
+	encodedAddress string,
+	redeemScript []byte,
+	blindingKey *btcec.PrivateKey,
+) (OutputType, error) {
+	for _, outputType := range swapOutputTypes {
+		err := CheckLiquidSwapAddress(liquidNetwork, encodedAddress, redeemScript, blindingKey, outputType == Compatibility)
+
+		if err != invalidAddress {
+			return outputType, err
+		}
+	}
+
+	return 0, invalidAddress
+}
+
+func ConstructLiquidTransaction(
+	liquidNetwork *network.Network,
+	outputs []LiquidOutputDetails,
+	outputAddress string,
+	satPerVbyte int64,
+) (*transaction.Transaction, error) {
+	noFeeTransaction, err := constructLiquidTransaction(liquidNetwork, outputs, outputAddress, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return constructLiquidTransaction(
+		liquidNetwork,
+		outputs,
+		outputAddress,
+		int64(noFeeTransaction.VirtualSize())*satPerVbyte,
+	)
+}
+
+// The output of the transaction is not blinded. This means the amount that was claimed or refunded is public
+func constructLiquidTransaction(
+	liquidNetwork *network.Network,
+	outputs []LiquidOutputDetails,
+	outputAddress string,
+	fee int64,
+) (*transaction.Transaction, error) {
+	liquidTransaction := transaction.NewTx(2)
+
+	var inputSum int64
+	var inputValues [][]byte
+
+	for _, output := range outputs {
+		// Set the highest timeout block height as locktime
+		if output.TimeoutBlockHeight > liquidTransaction.Locktime {
+			liquidTransaction.Locktime = output.TimeoutBlockHeight
+		}
+
+		lockupOutput := output.LockupTransaction.Outputs[output.Vout]
+		unblinded, err := UnblindLiquidOutput(lockupOutput, output.BlindingKey)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Calculate the sum of all inputs
+		inputSum += int64(unblinded.Value)
+		inputValues = append(inputValues, lockupOutput.Value)
+
+		// Add the input to the transaction
+		lockupHash := output.LockupTransaction.TxHash()
+		input := transaction.NewTxInput(lockupHash.CloneBytes(), output.Vout)
+		input.Sequence = 0
+
+		liquidTransaction.AddInput(input)
+	}
+
+	if inputSum <= fee {
+		return nil, errors.New("fee is higher than the value of the inputs")
+	}
+
+	assetId, err := hex.DecodeString(liquidNetwork.AssetID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	asset := append([]byte{0x01}, elementsutil.ReverseBytes(assetId)...)
+
+	// Add the output
+	outputScript, err := LiquidOutputScript(liquidNetwork, outputAddress)
+
+	if err != nil {
+		return nil, err
+	}
+
+	outputValue, err := elementsutil.SatoshiToElementsValue(uint64(inputSum - fee))
+
+	if err != nil {
+		return nil, err
+	}
+
+	liquidTransaction.AddOutput(transaction.NewTxOutput(asset, outputValue, outputScript))
+
+	// Fees on Liquid have to be an explicit output with an empty script
+	feeValue, err := elementsutil.SatoshiToElementsValue(uint64(fee))
+
+	if err != nil {
+		return nil, err
+	}
+
+	liquidTransaction.AddOutput(transaction.NewTxOutput(asset, feeValue, []byte{}))
+
+	// Construct the signature script and witnesses and sign the inputs
+	for i, output := range outputs {
+		switch output.OutputType {
+		case Legacy:
+			return nil, errors.New("legacy outputs are not supported on Liquid")
+
+		case Taproot:
+			return nil, errTaprootNotSupported
+
+		case Compatibility:
+			// Set the signature script for compatibility outputs
+			signatureScriptBuilder := txscript.NewScriptBuilder()
+			signatureScriptBuilder.AddData(createNestedP2shScript(output.RedeemScript))
+
+			signatureScript, err := signatureScriptBuilder.Script()
+
+			if err != nil {
+				return nil, err
+			}
+
+			liquidTransaction.Inputs[i].Script = signatureScript
+		}
+
+		signatureHash := liquidTransaction.HashForWitnessV0(
+			i,
+			output.RedeemScript,
+			inputValues[i],
+			txscript.SigHashAll,
+		)
+
+		signature, err := output.PrivateKey.Sign(signatureHash[:])
+
+		if err != nil {
+			return nil, err
+		}
+
+		liquidTransaction.Inputs[i].Witness = transaction.TxWitness{
+			append(signature.Serialize(), byte(txscript.SigHashAll)),
+			output.Preimage,
+			output.RedeemScript,
+		}
+	}
+
+	return liquidTransaction, nil
+}
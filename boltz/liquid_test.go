@@ -0,0 +1,105 @@
+package boltz
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+	"github.com/vulpemventures/go-elements/address"
+	"github.com/vulpemventures/go-elements/elementsutil"
+	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/transaction"
+	"testing"
+)
+
+var liquidNetwork = &network.Regtest
+
+func liquidLockupAddress(t *testing.T, blindingKey *btcec.PrivateKey) string {
+	redeemScriptHash := sha256.Sum256(redeemScript)
+
+	unconfidentialAddress, err := address.ToBech32(&address.Bech32{
+		Prefix:  liquidNetwork.Bech32,
+		Version: 0,
+		Program: redeemScriptHash[:],
+	})
+	assert.Nil(t, err)
+
+	confidentialAddress, err := address.ToConfidential(&address.ConfidentialAddress{
+		Address:     unconfidentialAddress,
+		BlindingKey: blindingKey.PubKey().SerializeCompressed(),
+	})
+	assert.Nil(t, err)
+
+	return confidentialAddress
+}
+
+func TestCheckLiquidSwapAddress(t *testing.T) {
+	blindingKey, _ := btcec.NewPrivateKey(btcec.S256())
+	lockupAddress := liquidLockupAddress(t, blindingKey)
+
+	assert.Nil(t, CheckLiquidSwapAddress(liquidNetwork, lockupAddress, redeemScript, blindingKey, false))
+
+	otherBlindingKey, _ := btcec.NewPrivateKey(btcec.S256())
+	assert.Equal(
+		t,
+		errors.New("invalid blinding key of address"),
+		CheckLiquidSwapAddress(liquidNetwork, lockupAddress, redeemScript, otherBlindingKey, false),
+	)
+
+	assert.Equal(
+		t,
+		errors.New("invalid address"),
+		CheckLiquidSwapAddress(liquidNetwork, lockupAddress, redeemScript, blindingKey, true),
+	)
+}
+
+func TestConstructLiquidTransaction(t *testing.T) {
+	privateKey, _ := btcec.NewPrivateKey(btcec.S256())
+	blindingKey, _ := btcec.NewPrivateKey(btcec.S256())
+
+	lockupScript, err := LiquidSwapOutputScript(redeemScript, false)
+	assert.Nil(t, err)
+
+	assetId, _ := hex.DecodeString(liquidNetwork.AssetID)
+	asset := append([]byte{0x01}, elementsutil.ReverseBytes(assetId)...)
+
+	lockupValue, _ := elementsutil.SatoshiToElementsValue(100000)
+
+	lockupTransaction := transaction.NewTx(2)
+	lockupTransaction.AddInput(transaction.NewTxInput(make([]byte, 32), 0))
+	lockupTransaction.AddOutput(transaction.NewTxOutput(asset, lockupValue, lockupScript))
+
+	vout, value, err := FindLiquidLockupVout(liquidNetwork, lockupTransaction, lockupScript, blindingKey)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), vout)
+	assert.Equal(t, uint64(100000), value)
+
+	claimTransaction, err := ConstructLiquidTransaction(
+		liquidNetwork,
+		[]LiquidOutputDetails{
+			{
+				LockupTransaction: lockupTransaction,
+				Vout:              vout,
+				OutputType:        SegWit,
+				RedeemScript:      redeemScript,
+				PrivateKey:        privateKey,
+				Preimage:          make([]byte, 32),
+			},
+		},
+		liquidLockupAddress(t, blindingKey),
+		1,
+	)
+	assert.Nil(t, err)
+
+	assert.Len(t, claimTransaction.Inputs, 1)
+	assert.Len(t, claimTransaction.Inputs[0].Witness, 3)
+	assert.Len(t, claimTransaction.Outputs, 2)
+
+	claimValue, _ := elementsutil.ElementsToSatoshiValue(claimTransaction.Outputs[0].Value)
+	feeValue, _ := elementsutil.ElementsToSatoshiValue(claimTransaction.Outputs[1].Value)
+
+	assert.Equal(t, uint64(100000), claimValue+feeValue)
+	assert.Equal(t, uint64(claimTransaction.VirtualSize()), feeValue)
+	assert.Empty(t, claimTransaction.Outputs[1].Script)
+}
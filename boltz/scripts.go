@@ -73,6 +73,143 @@ func CheckReverseSwapScript(redeemScript, preimageHash []byte, claimKey *btcec.P
 	return nil
 }
 
+// RedeemScriptValues are the values that are pushed in a redeem script of a Swap or Reverse Swap
+type RedeemScriptValues struct {
+	// RIPEMD160 of the SHA256 hash of the preimage
+	PreimageHash160    []byte
+	ClaimPublicKey     *btcec.PublicKey
+	RefundPublicKey    *btcec.PublicKey
+	TimeoutBlockHeight uint32
+}
+
+// Disassembled redeem script with empty strings where values are pushed and the positions of those values
+type scriptTemplate struct {
+	words []string
+
+	preimageHash160 int
+	claimKey        int
+	timeout         int
+	refundKey       int
+}
+
+var swapScriptTemplate = scriptTemplate{
+	words: []string{
+		"OP_HASH160", "", "OP_EQUAL",
+		"OP_IF", "",
+		"OP_ELSE", "", "OP_CHECKLOCKTIMEVERIFY", "OP_DROP", "",
+		"OP_ENDIF", "OP_CHECKSIG",
+	},
+	preimageHash160: 1,
+	claimKey:        4,
+	timeout:         6,
+	refundKey:       9,
+}
+
+var reverseSwapScriptTemplate = scriptTemplate{
+	words: []string{
+		"OP_SIZE", "20", "OP_EQUAL",
+		"OP_IF", "OP_HASH160", "", "OP_EQUALVERIFY", "",
+		"OP_ELSE", "OP_DROP", "", "OP_CHECKLOCKTIMEVERIFY", "OP_DROP", "",
+		"OP_ENDIF", "OP_CHECKSIG",
+	},
+	preimageHash160: 5,
+	claimKey:        7,
+	timeout:         10,
+	refundKey:       13,
+}
+
+// ParseSwapScript extracts the values of a redeem script of a Swap without knowing any of them beforehand
+func ParseSwapScript(redeemScript []byte) (*RedeemScriptValues, error) {
+	return parseScript(redeemScript, swapScriptTemplate)
+}
+
+// ParseReverseSwapScript extracts the values of a redeem script of a Reverse Swap without knowing any of them beforehand
+func ParseReverseSwapScript(redeemScript []byte) (*RedeemScriptValues, error) {
+	return parseScript(redeemScript, reverseSwapScriptTemplate)
+}
+
+func parseScript(redeemScript []byte, template scriptTemplate) (*RedeemScriptValues, error) {
+	disassembledScript, err := txscript.DisasmString(redeemScript)
+
+	if err != nil {
+		return nil, err
+	}
+
+	words := strings.Split(disassembledScript, " ")
+
+	if len(words) != len(template.words) {
+		return nil, invalidRedeemScript
+	}
+
+	for i, word := range template.words {
+		if word != "" && words[i] != word {
+			return nil, invalidRedeemScript
+		}
+	}
+
+	preimageHash160, err := hex.DecodeString(words[template.preimageHash160])
+
+	if err != nil || len(preimageHash160) != 20 {
+		return nil, invalidRedeemScript
+	}
+
+	claimKey, err := parsePublicKey(words[template.claimKey])
+
+	if err != nil {
+		return nil, invalidRedeemScript
+	}
+
+	refundKey, err := parsePublicKey(words[template.refundKey])
+
+	if err != nil {
+		return nil, invalidRedeemScript
+	}
+
+	timeoutBlockHeight, err := parseHeight(words[template.timeout])
+
+	if err != nil {
+		return nil, invalidRedeemScript
+	}
+
+	return &RedeemScriptValues{
+		PreimageHash160:    preimageHash160,
+		ClaimPublicKey:     claimKey,
+		RefundPublicKey:    refundKey,
+		TimeoutBlockHeight: timeoutBlockHeight,
+	}, nil
+}
+
+func parsePublicKey(encodedKey string) (*btcec.PublicKey, error) {
+	key, err := hex.DecodeString(encodedKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return btcec.ParsePubKey(key, btcec.S256())
+}
+
+// Parses the little endian script number of formatHeight
+func parseHeight(encodedHeight string) (uint32, error) {
+	height, err := hex.DecodeString(encodedHeight)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(height) == 0 || len(height) > 4 || height[len(height)-1]&0x80 != 0 {
+		return 0, invalidRedeemScript
+	}
+
+	var parsedHeight uint32
+
+	for i, heightByte := range height {
+		parsedHeight |= uint32(heightByte) << (8 * i)
+	}
+
+	return parsedHeight, nil
+}
+
 func formatHeight(height uint32) string {
 	test, _ := txscript.NewScriptBuilder().AddInt64(int64(height)).Script()
 	return hex.EncodeToString(test[1:])
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/input"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -46,6 +47,56 @@ func TestCheckReverseSwapScript(t *testing.T) {
 	assert.Equal(t, err, CheckReverseSwapScript(redeemScript, preimageHash, claimKey, 0))
 }
 
+func TestParseSwapScript(t *testing.T) {
+	redeemScript, _ := hex.DecodeString("a9140d90b94f98198ea9ba3a94a34d27897c27024305876321037c7980160182adad9eaea06c1b1cdf9dfdce5ef865c386a112bff4a62196caf66702f800b1752103de7f16653d93ff6ceac681050e75692d7a6fa05ea473d7df90aeac40fa11e28d68ac")
+	preimageHash, _ := hex.DecodeString("26cb777d4fa07a4fe47aa25bed4db29dfe32edfaac3f708299decc6d1199109c")
+
+	key, _ := hex.DecodeString("88c4ac1e6d099ea63eda4a0ae4863420dbca9aa1bce536aa63d46db28c7b780e")
+	refundKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), key)
+
+	values, err := ParseSwapScript(redeemScript)
+
+	assert.Nil(t, err)
+	assert.Equal(t, input.Ripemd160H(preimageHash), values.PreimageHash160)
+	assert.Equal(t, "037c7980160182adad9eaea06c1b1cdf9dfdce5ef865c386a112bff4a62196caf6", hex.EncodeToString(values.ClaimPublicKey.SerializeCompressed()))
+	assert.True(t, refundKey.PubKey().IsEqual(values.RefundPublicKey))
+	assert.Equal(t, uint32(248), values.TimeoutBlockHeight)
+
+	_, err = ParseReverseSwapScript(redeemScript)
+	assert.Equal(t, invalidRedeemScript, err)
+}
+
+func TestParseReverseSwapScript(t *testing.T) {
+	redeemScript, _ := hex.DecodeString("8201208763a9147ba0ab22fcffda41fd324aba4b5ce192ba9ec5dd882102e82694032768e49526972307874d868b67c87c37e9256c05a2c5c0474e7395e3677502f800b175210247d7443123302272524c9754b44a6e7e6e1236719e9f468e15927aa4ea26301168ac")
+	preimageHash, _ := hex.DecodeString("fa9ef1d253d34e9e44da97b00c6ec6a95058f646de35ddb7649fc3313ac6fc61")
+
+	key, _ := hex.DecodeString("dddc90e33843662631fb8c3833c4743ffd8f00a94715735633bf178e62eb291c")
+	claimKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), key)
+
+	values, err := ParseReverseSwapScript(redeemScript)
+
+	assert.Nil(t, err)
+	assert.Equal(t, input.Ripemd160H(preimageHash), values.PreimageHash160)
+	assert.True(t, claimKey.PubKey().IsEqual(values.ClaimPublicKey))
+	assert.Equal(t, "0247d7443123302272524c9754b44a6e7e6e1236719e9f468e15927aa4ea263011", hex.EncodeToString(values.RefundPublicKey.SerializeCompressed()))
+	assert.Equal(t, uint32(248), values.TimeoutBlockHeight)
+
+	_, err = ParseSwapScript(redeemScript)
+	assert.Equal(t, invalidRedeemScript, err)
+}
+
+func TestParseHeight(t *testing.T) {
+	for _, height := range []uint32{104, 248, 632630, 2147483647} {
+		parsedHeight, err := parseHeight(formatHeight(height))
+
+		assert.Nil(t, err)
+		assert.Equal(t, height, parsedHeight)
+	}
+
+	_, err := parseHeight("80")
+	assert.Equal(t, invalidRedeemScript, err)
+}
+
 func TestFormatHeight(t *testing.T) {
 	assert.Equal(t, "68", formatHeight(104))
 	assert.Equal(t, "36a709", formatHeight(632630))
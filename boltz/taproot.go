@@ -0,0 +1,81 @@
+package boltz
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightningnetwork/lnd/input"
+)
+
+// Key-path spends need MuSig2 signing with Boltz and script-path spends need Schnorr signatures and the BIP341
+// signature hash. Neither is supported by the version of btcd this daemon is built with
+// Taproot swaps are therefore rejected when they are created
+var errTaprootNotSupported = errors.New("spending Taproot outputs is not supported yet")
+
+var ErrTaprootSwap = errors.New("swaps with Taproot outputs are not supported")
+
+// SwapTree contains the leaves of the script tree of a Taproot swap output. The internal key of the output is the
+// MuSig2 aggregate of the keys of Boltz and the client, which allows for cooperative key-path spends
+type SwapTree struct {
+	ClaimLeaf  []byte
+	RefundLeaf []byte
+}
+
+// CheckTaprootSwapScript is the Taproot equivalent of CheckSwapScript. It verifies that Boltz can only claim with the
+// preimage and that the refund key can spend the output after the timeout
+func CheckTaprootSwapScript(swapTree *SwapTree, preimageHash []byte, refundKey *btcec.PrivateKey, timeoutBlockHeight uint32) error {
+	disassembledClaimLeaf, err := txscript.DisasmString(swapTree.ClaimLeaf)
+
+	if err != nil {
+		return err
+	}
+
+	claimLeaf := strings.Split(disassembledClaimLeaf, " ")
+
+	if len(claimLeaf) != 8 {
+		return invalidRedeemScript
+	}
+
+	// The size check of the preimage makes sure that it can also be used in the HTLCs of Lightning payments
+	expectedClaimLeaf := []string{
+		"OP_SIZE",
+		"20",
+		"OP_EQUALVERIFY",
+		"OP_HASH160",
+		hex.EncodeToString(input.Ripemd160H(preimageHash)),
+		"OP_EQUALVERIFY",
+		claimLeaf[6],
+		"OP_CHECKSIG",
+	}
+
+	if disassembledClaimLeaf != strings.Join(expectedClaimLeaf, " ") || len(claimLeaf[6]) != 64 {
+		return invalidRedeemScript
+	}
+
+	disassembledRefundLeaf, err := txscript.DisasmString(swapTree.RefundLeaf)
+
+	if err != nil {
+		return err
+	}
+
+	expectedRefundLeaf := []string{
+		hex.EncodeToString(xOnlyPublicKey(refundKey.PubKey())),
+		"OP_CHECKSIGVERIFY",
+		formatHeight(timeoutBlockHeight),
+		"OP_CHECKLOCKTIMEVERIFY",
+	}
+
+	if disassembledRefundLeaf != strings.Join(expectedRefundLeaf, " ") {
+		return invalidRedeemScript
+	}
+
+	return nil
+}
+
+// Taproot scripts commit to the X coordinate of public keys only
+func xOnlyPublicKey(publicKey *btcec.PublicKey) []byte {
+	return publicKey.SerializeCompressed()[1:]
+}
@@ -0,0 +1,59 @@
+package boltz
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckTaprootSwapScript(t *testing.T) {
+	claimKey, _ := btcec.NewPrivateKey(btcec.S256())
+	refundKey, _ := btcec.NewPrivateKey(btcec.S256())
+
+	preimageHash := sha256.Sum256([]byte("preimage"))
+	timeoutBlockHeight := uint32(681000)
+
+	// The leaves byte by byte as boltz-core serializes them: the claim leaf starts with OP_SIZE, the push of 32 and
+	// OP_EQUALVERIFY and the timeout of the refund leaf is a little endian script number
+	claimLeaf, _ := hex.DecodeString(
+		"82012088a914" + hex.EncodeToString(input.Ripemd160H(preimageHash[:])) +
+			"8820" + hex.EncodeToString(xOnlyPublicKey(claimKey.PubKey())) + "ac",
+	)
+	refundLeaf, _ := hex.DecodeString(
+		"20" + hex.EncodeToString(xOnlyPublicKey(refundKey.PubKey())) + "ad" + "0328640a" + "b1",
+	)
+
+	swapTree := &SwapTree{
+		ClaimLeaf:  claimLeaf,
+		RefundLeaf: refundLeaf,
+	}
+	assert.Nil(t, CheckTaprootSwapScript(swapTree, preimageHash[:], refundKey, timeoutBlockHeight))
+
+	otherKey, _ := btcec.NewPrivateKey(btcec.S256())
+
+	assert.Equal(t, invalidRedeemScript, CheckTaprootSwapScript(swapTree, []byte{}, refundKey, timeoutBlockHeight))
+	assert.Equal(t, invalidRedeemScript, CheckTaprootSwapScript(swapTree, preimageHash[:], otherKey, timeoutBlockHeight))
+	assert.Equal(t, invalidRedeemScript, CheckTaprootSwapScript(swapTree, preimageHash[:], refundKey, 0))
+
+	// Claim leaves without the size check of the preimage are rejected
+	claimLeafWithoutSize, _ := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_HASH160).
+		AddData(input.Ripemd160H(preimageHash[:])).
+		AddOp(txscript.OP_EQUALVERIFY).
+		AddData(xOnlyPublicKey(claimKey.PubKey())).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+
+	assert.Equal(t, invalidRedeemScript, CheckTaprootSwapScript(&SwapTree{
+		ClaimLeaf:  claimLeafWithoutSize,
+		RefundLeaf: refundLeaf,
+	}, preimageHash[:], refundKey, timeoutBlockHeight))
+
+	swapTree.ClaimLeaf = swapTree.RefundLeaf
+	assert.Equal(t, invalidRedeemScript, CheckTaprootSwapScript(swapTree, preimageHash[:], refundKey, timeoutBlockHeight))
+}
@@ -1,10 +1,12 @@
 package boltz
 
 import (
+	"errors"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcwallet/wallet/txrules"
 	"math"
 )
 
@@ -14,8 +16,28 @@ const (
 	SegWit OutputType = iota
 	Compatibility
 	Legacy
+	Taproot
 )
 
+func (outputType OutputType) String() string {
+	switch outputType {
+	case SegWit:
+		return "segwit"
+
+	case Compatibility:
+		return "compatibility"
+
+	case Legacy:
+		return "legacy"
+
+	case Taproot:
+		return "taproot"
+
+	default:
+		return "unknown"
+	}
+}
+
 type OutputDetails struct {
 	LockupTransaction *btcutil.Tx
 	Vout              uint32
@@ -32,7 +54,27 @@ type OutputDetails struct {
 }
 
 func ConstructTransaction(outputs []OutputDetails, outputAddress btcutil.Address, satPerVbyte int64) (*wire.MsgTx, error) {
-	noFeeTransaction, err := constructTransaction(outputs, outputAddress, 0)
+	outputAddresses := make([]btcutil.Address, len(outputs))
+
+	for i := range outputAddresses {
+		outputAddresses[i] = outputAddress
+	}
+
+	return ConstructBatchTransaction(outputs, outputAddresses, satPerVbyte)
+}
+
+// ConstructBatchTransaction sends every output to the address at the same index. Outputs that are sent to the same
+// address are combined and the fee is split between the addresses by the number of outputs they receive
+func ConstructBatchTransaction(outputs []OutputDetails, outputAddresses []btcutil.Address, satPerVbyte int64) (*wire.MsgTx, error) {
+	if len(outputs) == 0 {
+		return nil, errors.New("no outputs to spend")
+	}
+
+	if len(outputs) != len(outputAddresses) {
+		return nil, errors.New("number of outputs and addresses does not match")
+	}
+
+	noFeeTransaction, err := constructTransaction(outputs, outputAddresses, 0)
 
 	if err != nil {
 		return nil, err
@@ -41,22 +83,40 @@ func ConstructTransaction(outputs []OutputDetails, outputAddress btcutil.Address
 	witnessSize := noFeeTransaction.SerializeSize() - noFeeTransaction.SerializeSizeStripped()
 	vByte := int64(noFeeTransaction.SerializeSizeStripped()) + int64(math.Ceil(float64(witnessSize)/4))
 
-	return constructTransaction(outputs, outputAddress, vByte*satPerVbyte)
+	return constructTransaction(outputs, outputAddresses, vByte*satPerVbyte)
 }
 
-func constructTransaction(outputs []OutputDetails, outputAddress btcutil.Address, fee int64) (*wire.MsgTx, error) {
+func constructTransaction(outputs []OutputDetails, outputAddresses []btcutil.Address, fee int64) (*wire.MsgTx, error) {
 	transaction := wire.NewMsgTx(wire.TxVersion)
 
-	var inputSum int64
+	// Sum of the inputs and number of inputs per address in the order in which the addresses appear first
+	var addresses []string
+	inputSums := make(map[string]int64)
+	inputCounts := make(map[string]int64)
+	outputScripts := make(map[string][]byte)
 
-	for _, output := range outputs {
+	for i, output := range outputs {
 		// Set the highest timeout block height as locktime
 		if output.TimeoutBlockHeight > transaction.LockTime {
 			transaction.LockTime = output.TimeoutBlockHeight
 		}
 
+		address := outputAddresses[i].EncodeAddress()
+
+		if _, hasAddress := inputSums[address]; !hasAddress {
+			outputScript, err := txscript.PayToAddrScript(outputAddresses[i])
+
+			if err != nil {
+				return nil, err
+			}
+
+			addresses = append(addresses, address)
+			outputScripts[address] = outputScript
+		}
+
 		// Calculate the sum of all inputs
-		inputSum += output.LockupTransaction.MsgTx().TxOut[output.Vout].Value
+		inputSums[address] += output.LockupTransaction.MsgTx().TxOut[output.Vout].Value
+		inputCounts[address] += 1
 
 		// Add the input to the transaction
 		input := wire.NewTxIn(wire.NewOutPoint(output.LockupTransaction.Hash(), output.Vout), nil, nil)
@@ -65,21 +125,36 @@ func constructTransaction(outputs []OutputDetails, outputAddress btcutil.Address
 		transaction.AddTxIn(input)
 	}
 
-	// Add the output
-	outputScript, err := txscript.PayToAddrScript(outputAddress)
+	// Add the outputs. What cannot be split evenly between the inputs is paid by the first output
+	feePerInput := fee / int64(len(outputs))
+	remainingFee := fee - feePerInput*int64(len(outputs))
 
-	if err != nil {
-		return nil, err
-	}
+	for i, address := range addresses {
+		value := inputSums[address] - feePerInput*inputCounts[address]
 
-	transaction.AddTxOut(&wire.TxOut{
-		PkScript: outputScript,
-		Value:    inputSum - fee,
-	})
+		if i == 0 {
+			value -= remainingFee
+		}
+
+		txOut := &wire.TxOut{
+			PkScript: outputScripts[address],
+			Value:    value,
+		}
+
+		// Nodes would not relay the transaction
+		if txrules.IsDustOutput(txOut, txrules.DefaultRelayFeePerKb) {
+			return nil, errors.New("output to " + address + " would be dust after fees")
+		}
+
+		transaction.AddTxOut(txOut)
+	}
 
 	// Construct the signature script and witnesses and sign the inputs
 	for i, output := range outputs {
 		switch output.OutputType {
+		case Taproot:
+			return nil, errTaprootNotSupported
+
 		case Legacy:
 			// Set the signed signature script for legacy output
 			signature, err := txscript.RawTxInSignature(
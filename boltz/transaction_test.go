@@ -0,0 +1,70 @@
+package boltz
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstructBatchTransaction(t *testing.T) {
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	lockupTransaction := wire.NewMsgTx(wire.TxVersion)
+	lockupTransaction.AddTxOut(&wire.TxOut{Value: 100000})
+	lockupTransaction.AddTxOut(&wire.TxOut{Value: 200000})
+	lockupTransaction.AddTxOut(&wire.TxOut{Value: 300000})
+
+	var outputs []OutputDetails
+
+	for vout := range lockupTransaction.TxOut {
+		outputs = append(outputs, OutputDetails{
+			LockupTransaction: btcutil.NewTx(lockupTransaction),
+			Vout:              uint32(vout),
+			OutputType:        SegWit,
+			RedeemScript:      []byte{0x51},
+			PrivateKey:        privateKey,
+			Preimage:          []byte{},
+		})
+	}
+
+	firstAddress, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160([]byte{1}), &chaincfg.RegressionNetParams)
+	assert.Nil(t, err)
+
+	secondAddress, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160([]byte{2}), &chaincfg.RegressionNetParams)
+	assert.Nil(t, err)
+
+	transaction, err := ConstructBatchTransaction(outputs, []btcutil.Address{firstAddress, secondAddress, firstAddress}, 0)
+	assert.Nil(t, err)
+
+	assert.Len(t, transaction.TxIn, 3)
+	assert.Len(t, transaction.TxOut, 2)
+	assert.Equal(t, int64(400000), transaction.TxOut[0].Value)
+	assert.Equal(t, int64(200000), transaction.TxOut[1].Value)
+
+	// The fee is split by the number of outputs that are sent to an address
+	transaction, err = constructTransaction(outputs, []btcutil.Address{firstAddress, secondAddress, firstAddress}, 3001)
+	assert.Nil(t, err)
+
+	assert.Equal(t, int64(400000-2000-1), transaction.TxOut[0].Value)
+	assert.Equal(t, int64(200000-1000), transaction.TxOut[1].Value)
+
+	// The second address would receive less than the dust limit after its share of the fee
+	_, err = constructTransaction(outputs, []btcutil.Address{secondAddress, firstAddress, firstAddress}, 3*(100000-200))
+	assert.Equal(t, "output to "+secondAddress.EncodeAddress()+" would be dust after fees", err.Error())
+
+	_, err = ConstructBatchTransaction(outputs, []btcutil.Address{firstAddress}, 1)
+	assert.Equal(t, "number of outputs and addresses does not match", err.Error())
+
+	_, err = ConstructBatchTransaction(nil, nil, 1)
+	assert.Equal(t, "no outputs to spend", err.Error())
+
+	outputs[0].OutputType = Taproot
+
+	_, err = ConstructTransaction(outputs, firstAddress, 1)
+	assert.Equal(t, errTaprootNotSupported, err)
+}
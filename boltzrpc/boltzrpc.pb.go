@@ -87,6 +87,103 @@ func (SwapState) EnumDescriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{0}
 }
 
+type SwapType int32
+
+const (
+	SwapType_SUBMARINE         SwapType = 0
+	SwapType_REVERSE_SUBMARINE SwapType = 1
+	SwapType_CHANNEL_CREATION  SwapType = 2
+)
+
+// Enum value maps for SwapType.
+var (
+	SwapType_name = map[int32]string{
+		0: "SUBMARINE",
+		1: "REVERSE_SUBMARINE",
+		2: "CHANNEL_CREATION",
+	}
+	SwapType_value = map[string]int32{
+		"SUBMARINE":         0,
+		"REVERSE_SUBMARINE": 1,
+		"CHANNEL_CREATION":  2,
+	}
+)
+
+func (x SwapType) Enum() *SwapType {
+	p := new(SwapType)
+	*p = x
+	return p
+}
+
+func (x SwapType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SwapType) Descriptor() protoreflect.EnumDescriptor {
+	return file_boltzrpc_proto_enumTypes[1].Descriptor()
+}
+
+func (SwapType) Type() protoreflect.EnumType {
+	return &file_boltzrpc_proto_enumTypes[1]
+}
+
+func (x SwapType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SwapType.Descriptor instead.
+func (SwapType) EnumDescriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{1}
+}
+
+type QuoteDirection int32
+
+const (
+	// The amount of the quote is the one that is sent: onchain for swaps and via Lightning for reverse swaps
+	QuoteDirection_SEND QuoteDirection = 0
+	// The amount of the quote is the one that is received: via Lightning for swaps and onchain for reverse swaps
+	QuoteDirection_RECEIVE QuoteDirection = 1
+)
+
+// Enum value maps for QuoteDirection.
+var (
+	QuoteDirection_name = map[int32]string{
+		0: "SEND",
+		1: "RECEIVE",
+	}
+	QuoteDirection_value = map[string]int32{
+		"SEND":    0,
+		"RECEIVE": 1,
+	}
+)
+
+func (x QuoteDirection) Enum() *QuoteDirection {
+	p := new(QuoteDirection)
+	*p = x
+	return p
+}
+
+func (x QuoteDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuoteDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_boltzrpc_proto_enumTypes[2].Descriptor()
+}
+
+func (QuoteDirection) Type() protoreflect.EnumType {
+	return &file_boltzrpc_proto_enumTypes[2]
+}
+
+func (x QuoteDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuoteDirection.Descriptor instead.
+func (QuoteDirection) EnumDescriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{2}
+}
+
 type SwapInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -109,6 +206,16 @@ type SwapInfo struct {
 	//If the swap times out or fails for some other reason, the damon will automatically refund the coins sent to the
 	//`lockup_address` back to the LND wallet and save the refund transaction id to the database.
 	RefundTransactionId string `protobuf:"bytes,13,opt,name=refund_transaction_id,json=refundTransactionId,proto3" json:"refund_transaction_id,omitempty"`
+	// Private key with which the lockup output is blinded. Only set for swaps on Liquid
+	BlindingKey string `protobuf:"bytes,14,opt,name=blinding_key,json=blindingKey,proto3" json:"blinding_key,omitempty"`
+	// Address to which the coins are refunded. A new address of the LND wallet is used if not set
+	RefundAddress string `protobuf:"bytes,15,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	// Type of the lockup output: "compatibility" for nested SegWit or "segwit" for native SegWit
+	OutputType string `protobuf:"bytes,16,opt,name=output_type,json=outputType,proto3" json:"output_type,omitempty"`
+	// Symbol of the onchain currency. Empty when the swap is on the chain of the LND node
+	Currency string `protobuf:"bytes,17,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Name of the LND node the swap was created with. Empty for the node of the [LND] section of the config
+	Node string `protobuf:"bytes,18,opt,name=node,proto3" json:"node,omitempty"`
 }
 
 func (x *SwapInfo) Reset() {
@@ -234,8 +341,42 @@ func (x *SwapInfo) GetRefundTransactionId() string {
 	return ""
 }
 
-//
-//Channel creations are an optional extension to a submarine swap in the data types of boltz-lnd.
+func (x *SwapInfo) GetBlindingKey() string {
+	if x != nil {
+		return x.BlindingKey
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetRefundAddress() string {
+	if x != nil {
+		return x.RefundAddress
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetOutputType() string {
+	if x != nil {
+		return x.OutputType
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+// Channel creations are an optional extension to a submarine swap in the data types of boltz-lnd.
 type ChannelCreationInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -398,6 +539,12 @@ type ReverseSwapInfo struct {
 	TimeoutBlockHeight  uint32 `protobuf:"varint,11,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
 	LockupTransactionId string `protobuf:"bytes,12,opt,name=lockup_transaction_id,json=lockupTransactionId,proto3" json:"lockup_transaction_id,omitempty"`
 	ClaimTransactionId  string `protobuf:"bytes,13,opt,name=claim_transaction_id,json=claimTransactionId,proto3" json:"claim_transaction_id,omitempty"`
+	// Private key with which the lockup output is blinded. Only set for reverse swaps on Liquid
+	BlindingKey string `protobuf:"bytes,14,opt,name=blinding_key,json=blindingKey,proto3" json:"blinding_key,omitempty"`
+	// Symbol of the onchain currency. Empty when the reverse swap is on the chain of the LND node
+	Currency string `protobuf:"bytes,15,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Name of the LND node the reverse swap was created with. Empty for the node of the [LND] section of the config
+	Node string `protobuf:"bytes,16,opt,name=node,proto3" json:"node,omitempty"`
 }
 
 func (x *ReverseSwapInfo) Reset() {
@@ -523,16 +670,120 @@ func (x *ReverseSwapInfo) GetClaimTransactionId() string {
 	return ""
 }
 
+func (x *ReverseSwapInfo) GetBlindingKey() string {
+	if x != nil {
+		return x.BlindingKey
+	}
+	return ""
+}
+
+func (x *ReverseSwapInfo) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ReverseSwapInfo) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type NodeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Empty for the node of the [LND] section of the config
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol      string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Network     string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	LndPubkey   string `protobuf:"bytes,4,opt,name=lnd_pubkey,json=lndPubkey,proto3" json:"lnd_pubkey,omitempty"`
+	BlockHeight uint32 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *NodeInfo) Reset() {
+	*x = NodeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeInfo) ProtoMessage() {}
+
+func (x *NodeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeInfo.ProtoReflect.Descriptor instead.
+func (*NodeInfo) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NodeInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetLndPubkey() string {
+	if x != nil {
+		return x.LndPubkey
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
 type GetInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Name of the LND node of which information is returned. All nodes are included if not set
+	Node string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
 }
 
 func (x *GetInfoRequest) Reset() {
 	*x = GetInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[4]
+		mi := &file_boltzrpc_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -545,7 +796,7 @@ func (x *GetInfoRequest) String() string {
 func (*GetInfoRequest) ProtoMessage() {}
 
 func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[4]
+	mi := &file_boltzrpc_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -558,7 +809,14 @@ func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{4}
+	return file_boltzrpc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetInfoRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
 }
 
 type GetInfoResponse struct {
@@ -566,18 +824,20 @@ type GetInfoResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Symbol              string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Network             string   `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
-	LndPubkey           string   `protobuf:"bytes,3,opt,name=lnd_pubkey,json=lndPubkey,proto3" json:"lnd_pubkey,omitempty"`
-	BlockHeight         uint32   `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	PendingSwaps        []string `protobuf:"bytes,5,rep,name=pending_swaps,json=pendingSwaps,proto3" json:"pending_swaps,omitempty"`
-	PendingReverseSwaps []string `protobuf:"bytes,6,rep,name=pending_reverse_swaps,json=pendingReverseSwaps,proto3" json:"pending_reverse_swaps,omitempty"`
+	// Chain of the node of the [LND] section of the config or of the one that was requested
+	Symbol              string      `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Network             string      `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	LndPubkey           string      `protobuf:"bytes,3,opt,name=lnd_pubkey,json=lndPubkey,proto3" json:"lnd_pubkey,omitempty"`
+	BlockHeight         uint32      `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	PendingSwaps        []string    `protobuf:"bytes,5,rep,name=pending_swaps,json=pendingSwaps,proto3" json:"pending_swaps,omitempty"`
+	PendingReverseSwaps []string    `protobuf:"bytes,6,rep,name=pending_reverse_swaps,json=pendingReverseSwaps,proto3" json:"pending_reverse_swaps,omitempty"`
+	Nodes               []*NodeInfo `protobuf:"bytes,7,rep,name=nodes,proto3" json:"nodes,omitempty"`
 }
 
 func (x *GetInfoResponse) Reset() {
 	*x = GetInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[5]
+		mi := &file_boltzrpc_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -590,7 +850,7 @@ func (x *GetInfoResponse) String() string {
 func (*GetInfoResponse) ProtoMessage() {}
 
 func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[5]
+	mi := &file_boltzrpc_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,7 +863,7 @@ func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{5}
+	return file_boltzrpc_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetInfoResponse) GetSymbol() string {
@@ -648,6 +908,13 @@ func (x *GetInfoResponse) GetPendingReverseSwaps() []string {
 	return nil
 }
 
+func (x *GetInfoResponse) GetNodes() []*NodeInfo {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
 type MinerFees struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -660,7 +927,7 @@ type MinerFees struct {
 func (x *MinerFees) Reset() {
 	*x = MinerFees{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[6]
+		mi := &file_boltzrpc_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -673,7 +940,7 @@ func (x *MinerFees) String() string {
 func (*MinerFees) ProtoMessage() {}
 
 func (x *MinerFees) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[6]
+	mi := &file_boltzrpc_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,7 +953,7 @@ func (x *MinerFees) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MinerFees.ProtoReflect.Descriptor instead.
 func (*MinerFees) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{6}
+	return file_boltzrpc_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *MinerFees) GetNormal() uint32 {
@@ -715,7 +982,7 @@ type Fees struct {
 func (x *Fees) Reset() {
 	*x = Fees{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[7]
+		mi := &file_boltzrpc_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -728,7 +995,7 @@ func (x *Fees) String() string {
 func (*Fees) ProtoMessage() {}
 
 func (x *Fees) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[7]
+	mi := &file_boltzrpc_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -741,7 +1008,7 @@ func (x *Fees) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Fees.ProtoReflect.Descriptor instead.
 func (*Fees) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{7}
+	return file_boltzrpc_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Fees) GetPercentage() float32 {
@@ -770,7 +1037,7 @@ type Limits struct {
 func (x *Limits) Reset() {
 	*x = Limits{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[8]
+		mi := &file_boltzrpc_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -783,7 +1050,7 @@ func (x *Limits) String() string {
 func (*Limits) ProtoMessage() {}
 
 func (x *Limits) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[8]
+	mi := &file_boltzrpc_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -796,7 +1063,7 @@ func (x *Limits) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Limits.ProtoReflect.Descriptor instead.
 func (*Limits) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{8}
+	return file_boltzrpc_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Limits) GetMinimal() int64 {
@@ -817,12 +1084,17 @@ type GetServiceInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Pair like "LTC/BTC" of which the fees and limits are returned. The pair of the chain of LND is used if not set
+	PairId string `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// Name of the LND node whose chain is the Lightning side of the pair. The node of the [LND] section is used if not set
+	Node string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
 }
 
 func (x *GetServiceInfoRequest) Reset() {
 	*x = GetServiceInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[9]
+		mi := &file_boltzrpc_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -835,7 +1107,7 @@ func (x *GetServiceInfoRequest) String() string {
 func (*GetServiceInfoRequest) ProtoMessage() {}
 
 func (x *GetServiceInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[9]
+	mi := &file_boltzrpc_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -848,7 +1120,21 @@ func (x *GetServiceInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServiceInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetServiceInfoRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{9}
+	return file_boltzrpc_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetServiceInfoRequest) GetPairId() string {
+	if x != nil {
+		return x.PairId
+	}
+	return ""
+}
+
+func (x *GetServiceInfoRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
 }
 
 type GetServiceInfoResponse struct {
@@ -858,12 +1144,14 @@ type GetServiceInfoResponse struct {
 
 	Fees   *Fees   `protobuf:"bytes,1,opt,name=fees,proto3" json:"fees,omitempty"`
 	Limits *Limits `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"`
+	// Price of the base currency of the pair in its quote currency
+	Rate float32 `protobuf:"fixed32,3,opt,name=rate,proto3" json:"rate,omitempty"`
 }
 
 func (x *GetServiceInfoResponse) Reset() {
 	*x = GetServiceInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[10]
+		mi := &file_boltzrpc_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -876,7 +1164,7 @@ func (x *GetServiceInfoResponse) String() string {
 func (*GetServiceInfoResponse) ProtoMessage() {}
 
 func (x *GetServiceInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[10]
+	mi := &file_boltzrpc_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -889,7 +1177,7 @@ func (x *GetServiceInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServiceInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetServiceInfoResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{10}
+	return file_boltzrpc_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetServiceInfoResponse) GetFees() *Fees {
@@ -906,29 +1194,45 @@ func (x *GetServiceInfoResponse) GetLimits() *Limits {
 	return nil
 }
 
-type ListSwapsRequest struct {
+func (x *GetServiceInfoResponse) GetRate() float32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type GetQuoteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Only SUBMARINE and REVERSE_SUBMARINE are supported
+	Type      SwapType       `protobuf:"varint,1,opt,name=type,proto3,enum=boltzrpc.SwapType" json:"type,omitempty"`
+	Amount    int64          `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Direction QuoteDirection `protobuf:"varint,3,opt,name=direction,proto3,enum=boltzrpc.QuoteDirection" json:"direction,omitempty"`
+	// Pair like "LTC/BTC" of the swap. The pair of the chain of LND is used if not set
+	PairId string `protobuf:"bytes,4,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// Name of the LND node of the swap. The node of the [LND] section is used if not set
+	Node string `protobuf:"bytes,5,opt,name=node,proto3" json:"node,omitempty"`
 }
 
-func (x *ListSwapsRequest) Reset() {
-	*x = ListSwapsRequest{}
+func (x *GetQuoteRequest) Reset() {
+	*x = GetQuoteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[11]
+		mi := &file_boltzrpc_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListSwapsRequest) String() string {
+func (x *GetQuoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSwapsRequest) ProtoMessage() {}
+func (*GetQuoteRequest) ProtoMessage() {}
 
-func (x *ListSwapsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[11]
+func (x *GetQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -939,38 +1243,233 @@ func (x *ListSwapsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSwapsRequest.ProtoReflect.Descriptor instead.
-func (*ListSwapsRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{12}
 }
 
-type ListSwapsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Swaps            []*SwapInfo                `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
-	ChannelCreations []*CombinedChannelSwapInfo `protobuf:"bytes,2,rep,name=channel_creations,json=channelCreations,proto3" json:"channel_creations,omitempty"`
-	ReverseSwaps     []*ReverseSwapInfo         `protobuf:"bytes,3,rep,name=reverse_swaps,json=reverseSwaps,proto3" json:"reverse_swaps,omitempty"`
+func (x *GetQuoteRequest) GetType() SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SwapType_SUBMARINE
 }
 
-func (x *ListSwapsResponse) Reset() {
-	*x = ListSwapsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetQuoteRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
 	}
+	return 0
 }
 
-func (x *ListSwapsResponse) String() string {
+func (x *GetQuoteRequest) GetDirection() QuoteDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return QuoteDirection_SEND
+}
+
+func (x *GetQuoteRequest) GetPairId() string {
+	if x != nil {
+		return x.PairId
+	}
+	return ""
+}
+
+func (x *GetQuoteRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type GetQuoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Amount of the lockup transaction for swaps and of the invoice for reverse swaps
+	SendAmount int64 `protobuf:"varint,1,opt,name=send_amount,json=sendAmount,proto3" json:"send_amount,omitempty"`
+	// Amount of the invoice for swaps and of the claimed output after miner fees for reverse swaps
+	ReceiveAmount int64 `protobuf:"varint,2,opt,name=receive_amount,json=receiveAmount,proto3" json:"receive_amount,omitempty"`
+	// All fees are denominated in the onchain currency, except for the routing fee limit
+	BoltzPercentageFee int64 `protobuf:"varint,3,opt,name=boltz_percentage_fee,json=boltzPercentageFee,proto3" json:"boltz_percentage_fee,omitempty"`
+	BoltzMinerFee      int64 `protobuf:"varint,4,opt,name=boltz_miner_fee,json=boltzMinerFee,proto3" json:"boltz_miner_fee,omitempty"`
+	//
+	//Estimated miner fee of the transaction that claims a reverse swap. For swaps it is the fee of the refund
+	//transaction, which is only paid when the swap fails
+	MinerFee int64 `protobuf:"varint,5,opt,name=miner_fee,json=minerFee,proto3" json:"miner_fee,omitempty"`
+	// Fee rate on which the estimation of the miner fee is based
+	SatPerVbyte int64 `protobuf:"varint,6,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	// Maximal fee LND pays to route the invoice of a reverse swap
+	RoutingFeeLimit int64 `protobuf:"varint,7,opt,name=routing_fee_limit,json=routingFeeLimit,proto3" json:"routing_fee_limit,omitempty"`
+	// Limits of the Boltz API for the amount of the invoice
+	Limits *Limits `protobuf:"bytes,8,opt,name=limits,proto3" json:"limits,omitempty"`
+}
+
+func (x *GetQuoteResponse) Reset() {
+	*x = GetQuoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuoteResponse) ProtoMessage() {}
+
+func (x *GetQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuoteResponse.ProtoReflect.Descriptor instead.
+func (*GetQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetQuoteResponse) GetSendAmount() int64 {
+	if x != nil {
+		return x.SendAmount
+	}
+	return 0
+}
+
+func (x *GetQuoteResponse) GetReceiveAmount() int64 {
+	if x != nil {
+		return x.ReceiveAmount
+	}
+	return 0
+}
+
+func (x *GetQuoteResponse) GetBoltzPercentageFee() int64 {
+	if x != nil {
+		return x.BoltzPercentageFee
+	}
+	return 0
+}
+
+func (x *GetQuoteResponse) GetBoltzMinerFee() int64 {
+	if x != nil {
+		return x.BoltzMinerFee
+	}
+	return 0
+}
+
+func (x *GetQuoteResponse) GetMinerFee() int64 {
+	if x != nil {
+		return x.MinerFee
+	}
+	return 0
+}
+
+func (x *GetQuoteResponse) GetSatPerVbyte() int64 {
+	if x != nil {
+		return x.SatPerVbyte
+	}
+	return 0
+}
+
+func (x *GetQuoteResponse) GetRoutingFeeLimit() int64 {
+	if x != nil {
+		return x.RoutingFeeLimit
+	}
+	return 0
+}
+
+func (x *GetQuoteResponse) GetLimits() *Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type ListSwapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only swaps of the LND node with this name are returned if set
+	Node string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *ListSwapsRequest) Reset() {
+	*x = ListSwapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSwapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSwapsRequest) ProtoMessage() {}
+
+func (x *ListSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSwapsRequest.ProtoReflect.Descriptor instead.
+func (*ListSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListSwapsRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type ListSwapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swaps            []*SwapInfo                `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+	ChannelCreations []*CombinedChannelSwapInfo `protobuf:"bytes,2,rep,name=channel_creations,json=channelCreations,proto3" json:"channel_creations,omitempty"`
+	ReverseSwaps     []*ReverseSwapInfo         `protobuf:"bytes,3,rep,name=reverse_swaps,json=reverseSwaps,proto3" json:"reverse_swaps,omitempty"`
+}
+
+func (x *ListSwapsResponse) Reset() {
+	*x = ListSwapsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSwapsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*ListSwapsResponse) ProtoMessage() {}
 
 func (x *ListSwapsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[12]
+	mi := &file_boltzrpc_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -983,7 +1482,7 @@ func (x *ListSwapsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSwapsResponse.ProtoReflect.Descriptor instead.
 func (*ListSwapsResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{12}
+	return file_boltzrpc_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListSwapsResponse) GetSwaps() []*SwapInfo {
@@ -1018,7 +1517,7 @@ type GetSwapInfoRequest struct {
 func (x *GetSwapInfoRequest) Reset() {
 	*x = GetSwapInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[13]
+		mi := &file_boltzrpc_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1031,7 +1530,7 @@ func (x *GetSwapInfoRequest) String() string {
 func (*GetSwapInfoRequest) ProtoMessage() {}
 
 func (x *GetSwapInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[13]
+	mi := &file_boltzrpc_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1044,7 +1543,7 @@ func (x *GetSwapInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSwapInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetSwapInfoRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{13}
+	return file_boltzrpc_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetSwapInfoRequest) GetId() string {
@@ -1067,7 +1566,7 @@ type GetSwapInfoResponse struct {
 func (x *GetSwapInfoResponse) Reset() {
 	*x = GetSwapInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[14]
+		mi := &file_boltzrpc_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1080,7 +1579,7 @@ func (x *GetSwapInfoResponse) String() string {
 func (*GetSwapInfoResponse) ProtoMessage() {}
 
 func (x *GetSwapInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[14]
+	mi := &file_boltzrpc_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1093,7 +1592,7 @@ func (x *GetSwapInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSwapInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetSwapInfoResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{14}
+	return file_boltzrpc_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetSwapInfoResponse) GetSwap() *SwapInfo {
@@ -1117,34 +1616,38 @@ func (x *GetSwapInfoResponse) GetReverseSwap() *ReverseSwapInfo {
 	return nil
 }
 
-type DepositRequest struct {
+type GetFeeReportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//
-	//Percentage of inbound liquidity the channel that is opened in case the invoice cannot be paid should have.
-	//25 by default.
-	InboundLiquidity uint32 `protobuf:"varint,1,opt,name=inbound_liquidity,json=inboundLiquidity,proto3" json:"inbound_liquidity,omitempty"`
+	// UNIX timestamp from which on swaps are included. Swaps are included from the beginning if not set
+	From int64 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	// UNIX timestamp before which swaps are included. The current time is used if not set
+	To int64 `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Name of the LND node of which swaps are included. Swaps of all nodes are included if not set
+	Node string `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	// Whether the fees should be converted to the fiat currency with the prices of the configured price file
+	Fiat bool `protobuf:"varint,4,opt,name=fiat,proto3" json:"fiat,omitempty"`
 }
 
-func (x *DepositRequest) Reset() {
-	*x = DepositRequest{}
+func (x *GetFeeReportRequest) Reset() {
+	*x = GetFeeReportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[15]
+		mi := &file_boltzrpc_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DepositRequest) String() string {
+func (x *GetFeeReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositRequest) ProtoMessage() {}
+func (*GetFeeReportRequest) ProtoMessage() {}
 
-func (x *DepositRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[15]
+func (x *GetFeeReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1155,45 +1658,85 @@ func (x *DepositRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
-func (*DepositRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetFeeReportRequest.ProtoReflect.Descriptor instead.
+func (*GetFeeReportRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DepositRequest) GetInboundLiquidity() uint32 {
+func (x *GetFeeReportRequest) GetFrom() int64 {
 	if x != nil {
-		return x.InboundLiquidity
+		return x.From
 	}
 	return 0
 }
 
-type DepositResponse struct {
+func (x *GetFeeReportRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *GetFeeReportRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *GetFeeReportRequest) GetFiat() bool {
+	if x != nil {
+		return x.Fiat
+	}
+	return false
+}
+
+// Amounts and fees of a single swap in satoshis of the currency they were paid in. The amounts and fees Boltz charges are
+// recorded when the swap is created, so they are set for failed swaps too.
+type FeeReportEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                 string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address            string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	TimeoutBlockHeight uint32 `protobuf:"varint,3,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
-}
-
-func (x *DepositResponse) Reset() {
-	*x = DepositResponse{}
+	Id                string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type              SwapType  `protobuf:"varint,2,opt,name=type,proto3,enum=boltzrpc.SwapType" json:"type,omitempty"`
+	State             SwapState `protobuf:"varint,3,opt,name=state,proto3,enum=boltzrpc.SwapState" json:"state,omitempty"`
+	Node              string    `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
+	OnchainCurrency   string    `protobuf:"bytes,5,opt,name=onchain_currency,json=onchainCurrency,proto3" json:"onchain_currency,omitempty"`
+	LightningCurrency string    `protobuf:"bytes,6,opt,name=lightning_currency,json=lightningCurrency,proto3" json:"lightning_currency,omitempty"`
+	AmountSent        int64     `protobuf:"varint,7,opt,name=amount_sent,json=amountSent,proto3" json:"amount_sent,omitempty"`
+	AmountReceived    int64     `protobuf:"varint,8,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
+	// Percentage fee of Boltz in the onchain currency
+	ServiceFee int64 `protobuf:"varint,9,opt,name=service_fee,json=serviceFee,proto3" json:"service_fee,omitempty"`
+	// Miner fees Boltz charged in the onchain currency
+	BoltzMinerFee int64 `protobuf:"varint,10,opt,name=boltz_miner_fee,json=boltzMinerFee,proto3" json:"boltz_miner_fee,omitempty"`
+	// Fees of the claim and refund transactions in the onchain currency
+	MinerFee int64 `protobuf:"varint,11,opt,name=miner_fee,json=minerFee,proto3" json:"miner_fee,omitempty"`
+	// Fee of the Lightning payment of reverse swaps in millisatoshis of the Lightning currency
+	RoutingFeeMsat int64 `protobuf:"varint,12,opt,name=routing_fee_msat,json=routingFeeMsat,proto3" json:"routing_fee_msat,omitempty"`
+	CreatedAt      int64 `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      int64 `protobuf:"varint,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Value of the fees that were paid for the swap in the fiat currency. Only set when requested
+	FiatFees float64 `protobuf:"fixed64,15,opt,name=fiat_fees,json=fiatFees,proto3" json:"fiat_fees,omitempty"`
+}
+
+func (x *FeeReportEntry) Reset() {
+	*x = FeeReportEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[16]
+		mi := &file_boltzrpc_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DepositResponse) String() string {
+func (x *FeeReportEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositResponse) ProtoMessage() {}
+func (*FeeReportEntry) ProtoMessage() {}
 
-func (x *DepositResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[16]
+func (x *FeeReportEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1204,57 +1747,147 @@ func (x *DepositResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
-func (*DepositResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use FeeReportEntry.ProtoReflect.Descriptor instead.
+func (*FeeReportEntry) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DepositResponse) GetId() string {
+func (x *FeeReportEntry) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *DepositResponse) GetAddress() string {
+func (x *FeeReportEntry) GetType() SwapType {
 	if x != nil {
-		return x.Address
+		return x.Type
 	}
-	return ""
+	return SwapType_SUBMARINE
 }
 
-func (x *DepositResponse) GetTimeoutBlockHeight() uint32 {
+func (x *FeeReportEntry) GetState() SwapState {
 	if x != nil {
-		return x.TimeoutBlockHeight
+		return x.State
 	}
-	return 0
+	return SwapState_PENDING
 }
 
-type CreateSwapRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+func (x *FeeReportEntry) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
 }
 
-func (x *CreateSwapRequest) Reset() {
-	*x = CreateSwapRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *FeeReportEntry) GetOnchainCurrency() string {
+	if x != nil {
+		return x.OnchainCurrency
 	}
+	return ""
 }
 
-func (x *CreateSwapRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FeeReportEntry) GetLightningCurrency() string {
+	if x != nil {
+		return x.LightningCurrency
+	}
+	return ""
 }
 
-func (*CreateSwapRequest) ProtoMessage() {}
+func (x *FeeReportEntry) GetAmountSent() int64 {
+	if x != nil {
+		return x.AmountSent
+	}
+	return 0
+}
 
-func (x *CreateSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[17]
+func (x *FeeReportEntry) GetAmountReceived() int64 {
+	if x != nil {
+		return x.AmountReceived
+	}
+	return 0
+}
+
+func (x *FeeReportEntry) GetServiceFee() int64 {
+	if x != nil {
+		return x.ServiceFee
+	}
+	return 0
+}
+
+func (x *FeeReportEntry) GetBoltzMinerFee() int64 {
+	if x != nil {
+		return x.BoltzMinerFee
+	}
+	return 0
+}
+
+func (x *FeeReportEntry) GetMinerFee() int64 {
+	if x != nil {
+		return x.MinerFee
+	}
+	return 0
+}
+
+func (x *FeeReportEntry) GetRoutingFeeMsat() int64 {
+	if x != nil {
+		return x.RoutingFeeMsat
+	}
+	return 0
+}
+
+func (x *FeeReportEntry) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *FeeReportEntry) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *FeeReportEntry) GetFiatFees() float64 {
+	if x != nil {
+		return x.FiatFees
+	}
+	return 0
+}
+
+// Sum of the fees that were paid in a currency. Fees of Boltz are only paid for swaps that did not fail
+type FeeReportTotal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency       string  `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	ServiceFee     int64   `protobuf:"varint,2,opt,name=service_fee,json=serviceFee,proto3" json:"service_fee,omitempty"`
+	BoltzMinerFee  int64   `protobuf:"varint,3,opt,name=boltz_miner_fee,json=boltzMinerFee,proto3" json:"boltz_miner_fee,omitempty"`
+	MinerFee       int64   `protobuf:"varint,4,opt,name=miner_fee,json=minerFee,proto3" json:"miner_fee,omitempty"`
+	RoutingFeeMsat int64   `protobuf:"varint,5,opt,name=routing_fee_msat,json=routingFeeMsat,proto3" json:"routing_fee_msat,omitempty"`
+	FiatFees       float64 `protobuf:"fixed64,6,opt,name=fiat_fees,json=fiatFees,proto3" json:"fiat_fees,omitempty"`
+}
+
+func (x *FeeReportTotal) Reset() {
+	*x = FeeReportTotal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeReportTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeReportTotal) ProtoMessage() {}
+
+func (x *FeeReportTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1265,46 +1898,82 @@ func (x *CreateSwapRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSwapRequest.ProtoReflect.Descriptor instead.
-func (*CreateSwapRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use FeeReportTotal.ProtoReflect.Descriptor instead.
+func (*FeeReportTotal) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CreateSwapRequest) GetAmount() int64 {
+func (x *FeeReportTotal) GetCurrency() string {
 	if x != nil {
-		return x.Amount
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *FeeReportTotal) GetServiceFee() int64 {
+	if x != nil {
+		return x.ServiceFee
 	}
 	return 0
 }
 
-type CreateSwapResponse struct {
+func (x *FeeReportTotal) GetBoltzMinerFee() int64 {
+	if x != nil {
+		return x.BoltzMinerFee
+	}
+	return 0
+}
+
+func (x *FeeReportTotal) GetMinerFee() int64 {
+	if x != nil {
+		return x.MinerFee
+	}
+	return 0
+}
+
+func (x *FeeReportTotal) GetRoutingFeeMsat() int64 {
+	if x != nil {
+		return x.RoutingFeeMsat
+	}
+	return 0
+}
+
+func (x *FeeReportTotal) GetFiatFees() float64 {
+	if x != nil {
+		return x.FiatFees
+	}
+	return 0
+}
+
+type GetFeeReportResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address        string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	ExpectedAmount int64  `protobuf:"varint,3,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
-	Bip21          string `protobuf:"bytes,4,opt,name=bip21,proto3" json:"bip21,omitempty"`
+	Entries []*FeeReportEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Totals  []*FeeReportTotal `protobuf:"bytes,2,rep,name=totals,proto3" json:"totals,omitempty"`
+	// Only set when the fees were converted
+	FiatCurrency string  `protobuf:"bytes,3,opt,name=fiat_currency,json=fiatCurrency,proto3" json:"fiat_currency,omitempty"`
+	FiatFees     float64 `protobuf:"fixed64,4,opt,name=fiat_fees,json=fiatFees,proto3" json:"fiat_fees,omitempty"`
 }
 
-func (x *CreateSwapResponse) Reset() {
-	*x = CreateSwapResponse{}
+func (x *GetFeeReportResponse) Reset() {
+	*x = GetFeeReportResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[18]
+		mi := &file_boltzrpc_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateSwapResponse) String() string {
+func (x *GetFeeReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSwapResponse) ProtoMessage() {}
+func (*GetFeeReportResponse) ProtoMessage() {}
 
-func (x *CreateSwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[18]
+func (x *GetFeeReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1315,69 +1984,74 @@ func (x *CreateSwapResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSwapResponse.ProtoReflect.Descriptor instead.
-func (*CreateSwapResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetFeeReportResponse.ProtoReflect.Descriptor instead.
+func (*GetFeeReportResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CreateSwapResponse) GetId() string {
+func (x *GetFeeReportResponse) GetEntries() []*FeeReportEntry {
 	if x != nil {
-		return x.Id
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateSwapResponse) GetAddress() string {
+func (x *GetFeeReportResponse) GetTotals() []*FeeReportTotal {
 	if x != nil {
-		return x.Address
+		return x.Totals
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateSwapResponse) GetExpectedAmount() int64 {
+func (x *GetFeeReportResponse) GetFiatCurrency() string {
 	if x != nil {
-		return x.ExpectedAmount
+		return x.FiatCurrency
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateSwapResponse) GetBip21() string {
+func (x *GetFeeReportResponse) GetFiatFees() float64 {
 	if x != nil {
-		return x.Bip21
+		return x.FiatFees
 	}
-	return ""
+	return 0
 }
 
-type CreateChannelRequest struct {
+// Funds the lockup address of a swap with coins of the LND wallet. The `amount` is only used for deposits,
+// because the amount of all other swaps is known already. The coins are sent with `SendCoins` of LND instead of a PSBT
+// funding flow, because the supported version of LND has no wallet accounts.
+type WalletFunding struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	//
-	//Percentage of inbound liquidity the channel that is opened should have.
-	//25 by default.
-	InboundLiquidity uint32 `protobuf:"varint,2,opt,name=inbound_liquidity,json=inboundLiquidity,proto3" json:"inbound_liquidity,omitempty"`
-	Private          bool   `protobuf:"varint,3,opt,name=private,proto3" json:"private,omitempty"`
+	// Confirmation target of the funding transaction. Ignored if a fee rate is set
+	ConfTarget int32 `protobuf:"varint,1,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	// Fee rate of the funding transaction in satoshis per vbyte
+	SatPerVbyte int64 `protobuf:"varint,2,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	// Account of the LND wallet from which coins are selected. Only the "default" account is supported and requests with any other account are rejected
+	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	// Amount that should be sent to the lockup address. Only used, and required, when funding a deposit
+	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *CreateChannelRequest) Reset() {
-	*x = CreateChannelRequest{}
+func (x *WalletFunding) Reset() {
+	*x = WalletFunding{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[19]
+		mi := &file_boltzrpc_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateChannelRequest) String() string {
+func (x *WalletFunding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChannelRequest) ProtoMessage() {}
+func (*WalletFunding) ProtoMessage() {}
 
-func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[19]
+func (x *WalletFunding) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1388,505 +2062,4000 @@ func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChannelRequest.ProtoReflect.Descriptor instead.
-func (*CreateChannelRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use WalletFunding.ProtoReflect.Descriptor instead.
+func (*WalletFunding) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CreateChannelRequest) GetAmount() int64 {
+func (x *WalletFunding) GetConfTarget() int32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
+func (x *WalletFunding) GetSatPerVbyte() int64 {
+	if x != nil {
+		return x.SatPerVbyte
+	}
+	return 0
+}
+
+func (x *WalletFunding) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *WalletFunding) GetAmount() int64 {
 	if x != nil {
 		return x.Amount
 	}
 	return 0
 }
 
-func (x *CreateChannelRequest) GetInboundLiquidity() uint32 {
+// Caps for the fees Boltz charges for a swap. The amounts returned by Boltz are checked against them before the swap is
+// saved or paid. Values that are not set fall back to the ones of the [FEEPOLICY] section of the config.
+type FeePolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximal fees in percent of the invoice amount
+	MaxFeePercent float64 `protobuf:"fixed64,1,opt,name=max_fee_percent,json=maxFeePercent,proto3" json:"max_fee_percent,omitempty"`
+	// Maximal fees in satoshis of the currency of LND
+	MaxFee uint64 `protobuf:"varint,2,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
+}
+
+func (x *FeePolicy) Reset() {
+	*x = FeePolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeePolicy) ProtoMessage() {}
+
+func (x *FeePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeePolicy.ProtoReflect.Descriptor instead.
+func (*FeePolicy) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FeePolicy) GetMaxFeePercent() float64 {
 	if x != nil {
-		return x.InboundLiquidity
+		return x.MaxFeePercent
 	}
 	return 0
 }
 
-func (x *CreateChannelRequest) GetPrivate() bool {
+func (x *FeePolicy) GetMaxFee() uint64 {
 	if x != nil {
-		return x.Private
+		return x.MaxFee
 	}
-	return false
+	return 0
 }
 
-type CreateReverseSwapRequest struct {
+type DepositRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	// If no value is set, the daemon will query a new P2WKH address from LND
-	Address        string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	AcceptZeroConf bool   `protobuf:"varint,3,opt,name=accept_zero_conf,json=acceptZeroConf,proto3" json:"accept_zero_conf,omitempty"`
+	//
+	//Percentage of inbound liquidity the channel that is opened in case the invoice cannot be paid should have.
+	//25 by default.
+	InboundLiquidity uint32 `protobuf:"varint,1,opt,name=inbound_liquidity,json=inboundLiquidity,proto3" json:"inbound_liquidity,omitempty"`
+	// If set, the lockup address is funded from the LND wallet
+	FundFromWallet *WalletFunding `protobuf:"bytes,2,opt,name=fund_from_wallet,json=fundFromWallet,proto3" json:"fund_from_wallet,omitempty"`
+	// Name of the LND node with which the swap is created. The node of the [LND] section is used if not set
+	Node string `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
 }
 
-func (x *CreateReverseSwapRequest) Reset() {
-	*x = CreateReverseSwapRequest{}
+func (x *DepositRequest) Reset() {
+	*x = DepositRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[20]
+		mi := &file_boltzrpc_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateReverseSwapRequest) String() string {
+func (x *DepositRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReverseSwapRequest) ProtoMessage() {}
+func (*DepositRequest) ProtoMessage() {}
 
-func (x *CreateReverseSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[20]
+func (x *DepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateReverseSwapRequest.ProtoReflect.Descriptor instead.
-func (*CreateReverseSwapRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *CreateReverseSwapRequest) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *CreateReverseSwapRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *CreateReverseSwapRequest) GetAcceptZeroConf() bool {
-	if x != nil {
-		return x.AcceptZeroConf
-	}
-	return false
-}
-
-type CreateReverseSwapResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id                 string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	LockupAddress      string `protobuf:"bytes,2,opt,name=lockup_address,json=lockupAddress,proto3" json:"lockup_address,omitempty"`
-	RoutingFeeMilliSat uint32 `protobuf:"varint,3,opt,name=routing_fee_milli_sat,json=routingFeeMilliSat,proto3" json:"routing_fee_milli_sat,omitempty"`
-	// Only populated when 0-conf is accepted
-	ClaimTransactionId string `protobuf:"bytes,4,opt,name=claim_transaction_id,json=claimTransactionId,proto3" json:"claim_transaction_id,omitempty"`
-}
-
-func (x *CreateReverseSwapResponse) Reset() {
-	*x = CreateReverseSwapResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *CreateReverseSwapResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateReverseSwapResponse) ProtoMessage() {}
-
-func (x *CreateReverseSwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
+func (*DepositRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DepositRequest) GetInboundLiquidity() uint32 {
+	if x != nil {
+		return x.InboundLiquidity
+	}
+	return 0
+}
+
+func (x *DepositRequest) GetFundFromWallet() *WalletFunding {
+	if x != nil {
+		return x.FundFromWallet
+	}
+	return nil
+}
+
+func (x *DepositRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type DepositResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address            string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	TimeoutBlockHeight uint32 `protobuf:"varint,3,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
+	// Only set when the swap was funded from the LND wallet
+	LockupTransactionId string `protobuf:"bytes,4,opt,name=lockup_transaction_id,json=lockupTransactionId,proto3" json:"lockup_transaction_id,omitempty"`
+}
+
+func (x *DepositResponse) Reset() {
+	*x = DepositResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositResponse) ProtoMessage() {}
+
+func (x *DepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
+func (*DepositResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DepositResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DepositResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DepositResponse) GetTimeoutBlockHeight() uint32 {
+	if x != nil {
+		return x.TimeoutBlockHeight
+	}
+	return 0
+}
+
+func (x *DepositResponse) GetLockupTransactionId() string {
+	if x != nil {
+		return x.LockupTransactionId
+	}
+	return ""
+}
+
+type CreateSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Address to which the coins are refunded in case the swap fails. If not set, a new address of the LND wallet is used
+	RefundAddress string `protobuf:"bytes,2,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	// If set, the lockup address is funded from the LND wallet
+	FundFromWallet *WalletFunding `protobuf:"bytes,3,opt,name=fund_from_wallet,json=fundFromWallet,proto3" json:"fund_from_wallet,omitempty"`
+	//
+	//Pair like "LTC/BTC" of which the currency that is not the one of LND is sent onchain. A refund address is required
+	//for those cross chain swaps and they cannot be funded from the LND wallet. The pair of the chain of LND is used if
+	//not set.
+	PairId string `protobuf:"bytes,4,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// Name of the LND node with which the swap is created. The node of the [LND] section is used if not set
+	Node      string     `protobuf:"bytes,5,opt,name=node,proto3" json:"node,omitempty"`
+	FeePolicy *FeePolicy `protobuf:"bytes,6,opt,name=fee_policy,json=feePolicy,proto3" json:"fee_policy,omitempty"`
+	//
+	//Output type the lockup address has to have: "segwit" for native P2WSH or "compatibility" for nested P2SH-P2WSH.
+	//The type is requested from Boltz and swaps for which Boltz returns another address are rejected and their invoice
+	//is canceled. Both are accepted if not set. Taproot swaps are not supported and always rejected.
+	OutputType string `protobuf:"bytes,7,opt,name=output_type,json=outputType,proto3" json:"output_type,omitempty"`
+}
+
+func (x *CreateSwapRequest) Reset() {
+	*x = CreateSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSwapRequest) ProtoMessage() {}
+
+func (x *CreateSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSwapRequest.ProtoReflect.Descriptor instead.
+func (*CreateSwapRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateSwapRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateSwapRequest) GetRefundAddress() string {
+	if x != nil {
+		return x.RefundAddress
+	}
+	return ""
+}
+
+func (x *CreateSwapRequest) GetFundFromWallet() *WalletFunding {
+	if x != nil {
+		return x.FundFromWallet
+	}
+	return nil
+}
+
+func (x *CreateSwapRequest) GetPairId() string {
+	if x != nil {
+		return x.PairId
+	}
+	return ""
+}
+
+func (x *CreateSwapRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *CreateSwapRequest) GetFeePolicy() *FeePolicy {
+	if x != nil {
+		return x.FeePolicy
+	}
+	return nil
+}
+
+func (x *CreateSwapRequest) GetOutputType() string {
+	if x != nil {
+		return x.OutputType
+	}
+	return ""
+}
+
+type CreateSwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address        string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ExpectedAmount int64  `protobuf:"varint,3,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
+	Bip21          string `protobuf:"bytes,4,opt,name=bip21,proto3" json:"bip21,omitempty"`
+	// Only set when the swap was funded from the LND wallet
+	LockupTransactionId string `protobuf:"bytes,5,opt,name=lockup_transaction_id,json=lockupTransactionId,proto3" json:"lockup_transaction_id,omitempty"`
+}
+
+func (x *CreateSwapResponse) Reset() {
+	*x = CreateSwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSwapResponse) ProtoMessage() {}
+
+func (x *CreateSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSwapResponse.ProtoReflect.Descriptor instead.
+func (*CreateSwapResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateSwapResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateSwapResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateSwapResponse) GetExpectedAmount() int64 {
+	if x != nil {
+		return x.ExpectedAmount
+	}
+	return 0
+}
+
+func (x *CreateSwapResponse) GetBip21() string {
+	if x != nil {
+		return x.Bip21
+	}
+	return ""
+}
+
+func (x *CreateSwapResponse) GetLockupTransactionId() string {
+	if x != nil {
+		return x.LockupTransactionId
+	}
+	return ""
+}
+
+type CreateChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	//
+	//Percentage of inbound liquidity the channel that is opened should have.
+	//25 by default.
+	InboundLiquidity uint32 `protobuf:"varint,2,opt,name=inbound_liquidity,json=inboundLiquidity,proto3" json:"inbound_liquidity,omitempty"`
+	Private          bool   `protobuf:"varint,3,opt,name=private,proto3" json:"private,omitempty"`
+	// If set, the lockup address is funded from the LND wallet
+	FundFromWallet *WalletFunding `protobuf:"bytes,4,opt,name=fund_from_wallet,json=fundFromWallet,proto3" json:"fund_from_wallet,omitempty"`
+	// Name of the LND node with which the channel creation is created. The node of the [LND] section is used if not set
+	Node      string     `protobuf:"bytes,5,opt,name=node,proto3" json:"node,omitempty"`
+	FeePolicy *FeePolicy `protobuf:"bytes,6,opt,name=fee_policy,json=feePolicy,proto3" json:"fee_policy,omitempty"`
+}
+
+func (x *CreateChannelRequest) Reset() {
+	*x = CreateChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChannelRequest) ProtoMessage() {}
+
+func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChannelRequest.ProtoReflect.Descriptor instead.
+func (*CreateChannelRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateChannelRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateChannelRequest) GetInboundLiquidity() uint32 {
+	if x != nil {
+		return x.InboundLiquidity
+	}
+	return 0
+}
+
+func (x *CreateChannelRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *CreateChannelRequest) GetFundFromWallet() *WalletFunding {
+	if x != nil {
+		return x.FundFromWallet
+	}
+	return nil
+}
+
+func (x *CreateChannelRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *CreateChannelRequest) GetFeePolicy() *FeePolicy {
+	if x != nil {
+		return x.FeePolicy
+	}
+	return nil
+}
+
+type CreateReverseSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// If no value is set, the daemon will query a new P2WKH address from LND
+	Address        string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	AcceptZeroConf bool   `protobuf:"varint,3,opt,name=accept_zero_conf,json=acceptZeroConf,proto3" json:"accept_zero_conf,omitempty"`
+	//
+	//Pair like "LTC/BTC" of which the currency that is not the one of LND is received onchain. A claim address is
+	//required for those cross chain reverse swaps. The pair of the chain of LND is used if not set.
+	PairId string `protobuf:"bytes,4,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// Name of the LND node with which the reverse swap is created. The node of the [LND] section is used if not set
+	Node      string     `protobuf:"bytes,5,opt,name=node,proto3" json:"node,omitempty"`
+	FeePolicy *FeePolicy `protobuf:"bytes,6,opt,name=fee_policy,json=feePolicy,proto3" json:"fee_policy,omitempty"`
+	// If set, the invoice is only paid through the channel with this ID
+	ChannelId uint64 `protobuf:"varint,7,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *CreateReverseSwapRequest) Reset() {
+	*x = CreateReverseSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateReverseSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReverseSwapRequest) ProtoMessage() {}
+
+func (x *CreateReverseSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReverseSwapRequest.ProtoReflect.Descriptor instead.
+func (*CreateReverseSwapRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateReverseSwapRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateReverseSwapRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateReverseSwapRequest) GetAcceptZeroConf() bool {
+	if x != nil {
+		return x.AcceptZeroConf
+	}
+	return false
+}
+
+func (x *CreateReverseSwapRequest) GetPairId() string {
+	if x != nil {
+		return x.PairId
+	}
+	return ""
+}
+
+func (x *CreateReverseSwapRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *CreateReverseSwapRequest) GetFeePolicy() *FeePolicy {
+	if x != nil {
+		return x.FeePolicy
+	}
+	return nil
+}
+
+func (x *CreateReverseSwapRequest) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+type CreateReverseSwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LockupAddress      string `protobuf:"bytes,2,opt,name=lockup_address,json=lockupAddress,proto3" json:"lockup_address,omitempty"`
+	RoutingFeeMilliSat uint32 `protobuf:"varint,3,opt,name=routing_fee_milli_sat,json=routingFeeMilliSat,proto3" json:"routing_fee_milli_sat,omitempty"`
+	// Only populated when 0-conf is accepted
+	ClaimTransactionId string `protobuf:"bytes,4,opt,name=claim_transaction_id,json=claimTransactionId,proto3" json:"claim_transaction_id,omitempty"`
+}
+
+func (x *CreateReverseSwapResponse) Reset() {
+	*x = CreateReverseSwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateReverseSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReverseSwapResponse) ProtoMessage() {}
+
+func (x *CreateReverseSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReverseSwapResponse.ProtoReflect.Descriptor instead.
+func (*CreateReverseSwapResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateReverseSwapResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateReverseSwapResponse) GetLockupAddress() string {
+	if x != nil {
+		return x.LockupAddress
+	}
+	return ""
+}
+
+func (x *CreateReverseSwapResponse) GetRoutingFeeMilliSat() uint32 {
+	if x != nil {
+		return x.RoutingFeeMilliSat
+	}
+	return 0
+}
+
+func (x *CreateReverseSwapResponse) GetClaimTransactionId() string {
+	if x != nil {
+		return x.ClaimTransactionId
+	}
+	return ""
+}
+
+type RefundSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// If not set, the refund address of the swap or a new address of the LND wallet is used
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// If not set, the fee rate is estimated
+	SatPerVbyte int64 `protobuf:"varint,3,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+}
+
+func (x *RefundSwapRequest) Reset() {
+	*x = RefundSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefundSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundSwapRequest) ProtoMessage() {}
+
+func (x *RefundSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundSwapRequest.ProtoReflect.Descriptor instead.
+func (*RefundSwapRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RefundSwapRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RefundSwapRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RefundSwapRequest) GetSatPerVbyte() int64 {
+	if x != nil {
+		return x.SatPerVbyte
+	}
+	return 0
+}
+
+type RefundSwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefundTransactionId string `protobuf:"bytes,1,opt,name=refund_transaction_id,json=refundTransactionId,proto3" json:"refund_transaction_id,omitempty"`
+}
+
+func (x *RefundSwapResponse) Reset() {
+	*x = RefundSwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefundSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundSwapResponse) ProtoMessage() {}
+
+func (x *RefundSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundSwapResponse.ProtoReflect.Descriptor instead.
+func (*RefundSwapResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RefundSwapResponse) GetRefundTransactionId() string {
+	if x != nil {
+		return x.RefundTransactionId
+	}
+	return ""
+}
+
+type BumpFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the unconfirmed claim or refund transaction
+	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// If not set, the fee rate is estimated
+	SatPerVbyte int64 `protobuf:"varint,2,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+}
+
+func (x *BumpFeeRequest) Reset() {
+	*x = BumpFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpFeeRequest) ProtoMessage() {}
+
+func (x *BumpFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpFeeRequest.ProtoReflect.Descriptor instead.
+func (*BumpFeeRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BumpFeeRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *BumpFeeRequest) GetSatPerVbyte() int64 {
+	if x != nil {
+		return x.SatPerVbyte
+	}
+	return 0
+}
+
+type BumpFeeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the transaction that replaces the unconfirmed one
+	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+}
+
+func (x *BumpFeeResponse) Reset() {
+	*x = BumpFeeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpFeeResponse) ProtoMessage() {}
+
+func (x *BumpFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpFeeResponse.ProtoReflect.Descriptor instead.
+func (*BumpFeeResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BumpFeeResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type SubscribeSwapEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, only events of the swap with this ID are streamed
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// If set, only events of swaps with one of these types are streamed
+	Types []SwapType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=boltzrpc.SwapType" json:"types,omitempty"`
+}
+
+func (x *SubscribeSwapEventsRequest) Reset() {
+	*x = SubscribeSwapEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeSwapEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeSwapEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeSwapEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeSwapEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeSwapEventsRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SubscribeSwapEventsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SubscribeSwapEventsRequest) GetTypes() []SwapType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type SwapEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type SwapType `protobuf:"varint,1,opt,name=type,proto3,enum=boltzrpc.SwapType" json:"type,omitempty"`
+	Id   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Set for swaps and channel creations
+	Swap *SwapInfo `protobuf:"bytes,3,opt,name=swap,proto3" json:"swap,omitempty"`
+	// Only set for channel creations
+	ChannelCreation *ChannelCreationInfo `protobuf:"bytes,4,opt,name=channel_creation,json=channelCreation,proto3" json:"channel_creation,omitempty"`
+	// Only set for reverse swaps
+	ReverseSwap *ReverseSwapInfo `protobuf:"bytes,5,opt,name=reverse_swap,json=reverseSwap,proto3" json:"reverse_swap,omitempty"`
+}
+
+func (x *SwapEvent) Reset() {
+	*x = SwapEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapEvent) ProtoMessage() {}
+
+func (x *SwapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapEvent.ProtoReflect.Descriptor instead.
+func (*SwapEvent) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SwapEvent) GetType() SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SwapType_SUBMARINE
+}
+
+func (x *SwapEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetSwap() *SwapInfo {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+func (x *SwapEvent) GetChannelCreation() *ChannelCreationInfo {
+	if x != nil {
+		return x.ChannelCreation
+	}
+	return nil
+}
+
+func (x *SwapEvent) GetReverseSwap() *ReverseSwapInfo {
+	if x != nil {
+		return x.ReverseSwap
+	}
+	return nil
+}
+
+type AutoSwapConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Only log the swaps that would be created instead of creating them
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Interval in seconds in which the channel balances are checked
+	Interval uint32 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	//
+	//Whether the balance thresholds apply to every channel instead of the sum of all channels. Reverse swaps are paid
+	//through the channel they are recommended for. Swaps are not recommended for single channels, because Boltz chooses
+	//the route of the payment to the node.
+	PerChannel bool `protobuf:"varint,4,opt,name=per_channel,json=perChannel,proto3" json:"per_channel,omitempty"`
+	// Percentage of local balance below which a swap is created
+	MinLocalBalancePercent uint32 `protobuf:"varint,5,opt,name=min_local_balance_percent,json=minLocalBalancePercent,proto3" json:"min_local_balance_percent,omitempty"`
+	// Percentage of local balance above which a reverse swap is created
+	MaxLocalBalancePercent uint32 `protobuf:"varint,6,opt,name=max_local_balance_percent,json=maxLocalBalancePercent,proto3" json:"max_local_balance_percent,omitempty"`
+	// Maximal percentage of the swap amount that can be spent on fees
+	MaxFeePercent float64 `protobuf:"fixed64,7,opt,name=max_fee_percent,json=maxFeePercent,proto3" json:"max_fee_percent,omitempty"`
+	// Maximal amount of satoshis that can be spent on fees in a budget interval
+	Budget uint64 `protobuf:"varint,8,opt,name=budget,proto3" json:"budget,omitempty"`
+	// Length of the budget interval in seconds
+	BudgetInterval uint64 `protobuf:"varint,9,opt,name=budget_interval,json=budgetInterval,proto3" json:"budget_interval,omitempty"`
+	// Maximal number of swaps created by autoswap that can be pending at the same time
+	MaxInFlight uint32 `protobuf:"varint,10,opt,name=max_in_flight,json=maxInFlight,proto3" json:"max_in_flight,omitempty"`
+	// Name of the LND node of which the channels are rebalanced. The node of the [LND] section is used if not set
+	Node string `protobuf:"bytes,11,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *AutoSwapConfig) Reset() {
+	*x = AutoSwapConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoSwapConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoSwapConfig) ProtoMessage() {}
+
+func (x *AutoSwapConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoSwapConfig.ProtoReflect.Descriptor instead.
+func (*AutoSwapConfig) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AutoSwapConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AutoSwapConfig) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *AutoSwapConfig) GetInterval() uint32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *AutoSwapConfig) GetPerChannel() bool {
+	if x != nil {
+		return x.PerChannel
+	}
+	return false
+}
+
+func (x *AutoSwapConfig) GetMinLocalBalancePercent() uint32 {
+	if x != nil {
+		return x.MinLocalBalancePercent
+	}
+	return 0
+}
+
+func (x *AutoSwapConfig) GetMaxLocalBalancePercent() uint32 {
+	if x != nil {
+		return x.MaxLocalBalancePercent
+	}
+	return 0
+}
+
+func (x *AutoSwapConfig) GetMaxFeePercent() float64 {
+	if x != nil {
+		return x.MaxFeePercent
+	}
+	return 0
+}
+
+func (x *AutoSwapConfig) GetBudget() uint64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *AutoSwapConfig) GetBudgetInterval() uint64 {
+	if x != nil {
+		return x.BudgetInterval
+	}
+	return 0
+}
+
+func (x *AutoSwapConfig) GetMaxInFlight() uint32 {
+	if x != nil {
+		return x.MaxInFlight
+	}
+	return 0
+}
+
+func (x *AutoSwapConfig) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type GetAutoSwapConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetAutoSwapConfigRequest) Reset() {
+	*x = GetAutoSwapConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAutoSwapConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAutoSwapConfigRequest) ProtoMessage() {}
+
+func (x *GetAutoSwapConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAutoSwapConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetAutoSwapConfigRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{38}
+}
+
+type GetAutoSwapConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *AutoSwapConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *GetAutoSwapConfigResponse) Reset() {
+	*x = GetAutoSwapConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAutoSwapConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAutoSwapConfigResponse) ProtoMessage() {}
+
+func (x *GetAutoSwapConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAutoSwapConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetAutoSwapConfigResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetAutoSwapConfigResponse) GetConfig() *AutoSwapConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type SetAutoSwapConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *AutoSwapConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *SetAutoSwapConfigRequest) Reset() {
+	*x = SetAutoSwapConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAutoSwapConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAutoSwapConfigRequest) ProtoMessage() {}
+
+func (x *SetAutoSwapConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAutoSwapConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetAutoSwapConfigRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetAutoSwapConfigRequest) GetConfig() *AutoSwapConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type SetAutoSwapConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *AutoSwapConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *SetAutoSwapConfigResponse) Reset() {
+	*x = SetAutoSwapConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAutoSwapConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAutoSwapConfigResponse) ProtoMessage() {}
+
+func (x *SetAutoSwapConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAutoSwapConfigResponse.ProtoReflect.Descriptor instead.
+func (*SetAutoSwapConfigResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetAutoSwapConfigResponse) GetConfig() *AutoSwapConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type AutoSwapRecommendation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   SwapType `protobuf:"varint,1,opt,name=type,proto3,enum=boltzrpc.SwapType" json:"type,omitempty"`
+	Amount uint64   `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Not set when the recommendation is for the sum of all channels
+	ChannelId     uint64 `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	LocalBalance  int64  `protobuf:"varint,4,opt,name=local_balance,json=localBalance,proto3" json:"local_balance,omitempty"`
+	Capacity      int64  `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	FeeEstimation uint64 `protobuf:"varint,6,opt,name=fee_estimation,json=feeEstimation,proto3" json:"fee_estimation,omitempty"`
+	// Set when autoswap would not create this swap
+	DismissedReason string `protobuf:"bytes,7,opt,name=dismissed_reason,json=dismissedReason,proto3" json:"dismissed_reason,omitempty"`
+}
+
+func (x *AutoSwapRecommendation) Reset() {
+	*x = AutoSwapRecommendation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoSwapRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoSwapRecommendation) ProtoMessage() {}
+
+func (x *AutoSwapRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoSwapRecommendation.ProtoReflect.Descriptor instead.
+func (*AutoSwapRecommendation) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AutoSwapRecommendation) GetType() SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SwapType_SUBMARINE
+}
+
+func (x *AutoSwapRecommendation) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AutoSwapRecommendation) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *AutoSwapRecommendation) GetLocalBalance() int64 {
+	if x != nil {
+		return x.LocalBalance
+	}
+	return 0
+}
+
+func (x *AutoSwapRecommendation) GetCapacity() int64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *AutoSwapRecommendation) GetFeeEstimation() uint64 {
+	if x != nil {
+		return x.FeeEstimation
+	}
+	return 0
+}
+
+func (x *AutoSwapRecommendation) GetDismissedReason() string {
+	if x != nil {
+		return x.DismissedReason
+	}
+	return ""
+}
+
+type GetAutoSwapRecommendationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetAutoSwapRecommendationsRequest) Reset() {
+	*x = GetAutoSwapRecommendationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAutoSwapRecommendationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAutoSwapRecommendationsRequest) ProtoMessage() {}
+
+func (x *GetAutoSwapRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAutoSwapRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*GetAutoSwapRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{43}
+}
+
+type GetAutoSwapRecommendationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recommendations []*AutoSwapRecommendation `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+}
+
+func (x *GetAutoSwapRecommendationsResponse) Reset() {
+	*x = GetAutoSwapRecommendationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAutoSwapRecommendationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAutoSwapRecommendationsResponse) ProtoMessage() {}
+
+func (x *GetAutoSwapRecommendationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAutoSwapRecommendationsResponse.ProtoReflect.Descriptor instead.
+func (*GetAutoSwapRecommendationsResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetAutoSwapRecommendationsResponse) GetRecommendations() []*AutoSwapRecommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+type UnlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (x *UnlockRequest) Reset() {
+	*x = UnlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockRequest) ProtoMessage() {}
+
+func (x *UnlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockRequest.ProtoReflect.Descriptor instead.
+func (*UnlockRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UnlockRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+type UnlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnlockResponse) Reset() {
+	*x = UnlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockResponse) ProtoMessage() {}
+
+func (x *UnlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockResponse.ProtoReflect.Descriptor instead.
+func (*UnlockResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{46}
+}
+
+type SetPassphraseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (x *SetPassphraseRequest) Reset() {
+	*x = SetPassphraseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPassphraseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPassphraseRequest) ProtoMessage() {}
+
+func (x *SetPassphraseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPassphraseRequest.ProtoReflect.Descriptor instead.
+func (*SetPassphraseRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetPassphraseRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+type SetPassphraseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetPassphraseResponse) Reset() {
+	*x = SetPassphraseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPassphraseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPassphraseResponse) ProtoMessage() {}
+
+func (x *SetPassphraseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPassphraseResponse.ProtoReflect.Descriptor instead.
+func (*SetPassphraseResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{48}
+}
+
+type LostSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Redeem script of the lockup address as returned by Boltz when the swap was created
+	RedeemScript string `protobuf:"bytes,2,opt,name=redeem_script,json=redeemScript,proto3" json:"redeem_script,omitempty"`
+	// Pair like "LTC/BTC" of cross chain swaps. The pair of the chain of LND is used if not set
+	PairId string `protobuf:"bytes,3,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	//
+	//Address to which a swap is refunded or a reverse swap is claimed. Required for cross chain swaps. A new address
+	//of the LND wallet is used for the other ones if not set.
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *LostSwap) Reset() {
+	*x = LostSwap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LostSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LostSwap) ProtoMessage() {}
+
+func (x *LostSwap) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LostSwap.ProtoReflect.Descriptor instead.
+func (*LostSwap) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *LostSwap) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LostSwap) GetRedeemScript() string {
+	if x != nil {
+		return x.RedeemScript
+	}
+	return ""
+}
+
+func (x *LostSwap) GetPairId() string {
+	if x != nil {
+		return x.PairId
+	}
+	return ""
+}
+
+func (x *LostSwap) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type RecoverSwapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swaps []*LostSwap `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+	// First index of the range in which the keys of the swaps are searched
+	FromIndex uint32 `protobuf:"varint,2,opt,name=from_index,json=fromIndex,proto3" json:"from_index,omitempty"`
+	// Last index of the range in which the keys of the swaps are searched
+	ToIndex uint32 `protobuf:"varint,3,opt,name=to_index,json=toIndex,proto3" json:"to_index,omitempty"`
+	// Name of the LND node with which the swaps were created. The node of the [LND] section is used if not set
+	Node string `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *RecoverSwapsRequest) Reset() {
+	*x = RecoverSwapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoverSwapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverSwapsRequest) ProtoMessage() {}
+
+func (x *RecoverSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverSwapsRequest.ProtoReflect.Descriptor instead.
+func (*RecoverSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RecoverSwapsRequest) GetSwaps() []*LostSwap {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+func (x *RecoverSwapsRequest) GetFromIndex() uint32 {
+	if x != nil {
+		return x.FromIndex
+	}
+	return 0
+}
+
+func (x *RecoverSwapsRequest) GetToIndex() uint32 {
+	if x != nil {
+		return x.ToIndex
+	}
+	return 0
+}
+
+func (x *RecoverSwapsRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type RecoveredSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type     SwapType `protobuf:"varint,2,opt,name=type,proto3,enum=boltzrpc.SwapType" json:"type,omitempty"`
+	KeyIndex uint32   `protobuf:"varint,3,opt,name=key_index,json=keyIndex,proto3" json:"key_index,omitempty"`
+	// Status of the swap at Boltz
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// Why the swap could not be recovered. Empty if it was recovered
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *RecoveredSwap) Reset() {
+	*x = RecoveredSwap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveredSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveredSwap) ProtoMessage() {}
+
+func (x *RecoveredSwap) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveredSwap.ProtoReflect.Descriptor instead.
+func (*RecoveredSwap) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RecoveredSwap) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RecoveredSwap) GetType() SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SwapType_SUBMARINE
+}
+
+func (x *RecoveredSwap) GetKeyIndex() uint32 {
+	if x != nil {
+		return x.KeyIndex
+	}
+	return 0
+}
+
+func (x *RecoveredSwap) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RecoveredSwap) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RecoverSwapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swaps []*RecoveredSwap `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+}
+
+func (x *RecoverSwapsResponse) Reset() {
+	*x = RecoverSwapsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoverSwapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverSwapsResponse) ProtoMessage() {}
+
+func (x *RecoverSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverSwapsResponse.ProtoReflect.Descriptor instead.
+func (*RecoverSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RecoverSwapsResponse) GetSwaps() []*RecoveredSwap {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+// Fields are named like the ones of the refund files of the Boltz web app, so that those can be imported
+type RescueSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type SwapType `protobuf:"varint,2,opt,name=type,proto3,enum=boltzrpc.SwapType" json:"type,omitempty"`
+	// Symbol of the onchain currency
+	Currency   string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	PrivateKey string `protobuf:"bytes,4,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// Not set for swaps whose invoice was not created by the daemon
+	Preimage     string `protobuf:"bytes,5,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	RedeemScript string `protobuf:"bytes,6,opt,name=redeem_script,json=redeemScript,proto3" json:"redeem_script,omitempty"`
+	// Not set for reverse swaps on Liquid
+	LockupAddress      string `protobuf:"bytes,7,opt,name=lockup_address,json=lockupAddress,proto3" json:"lockup_address,omitempty"`
+	TimeoutBlockHeight uint32 `protobuf:"varint,8,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
+	// Only set for swaps on Liquid
+	BlindingKey string `protobuf:"bytes,9,opt,name=blinding_key,json=blindingKey,proto3" json:"blinding_key,omitempty"`
+	//
+	//Address to which the swap is refunded or the reverse swap is claimed. Swaps without one are refunded to a new
+	//address of the LND wallet.
+	Address string `protobuf:"bytes,10,opt,name=address,proto3" json:"address,omitempty"`
+	// Name of the LND node with which the swap was created
+	Node string `protobuf:"bytes,11,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *RescueSwap) Reset() {
+	*x = RescueSwap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescueSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescueSwap) ProtoMessage() {}
+
+func (x *RescueSwap) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescueSwap.ProtoReflect.Descriptor instead.
+func (*RescueSwap) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RescueSwap) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RescueSwap) GetType() SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SwapType_SUBMARINE
+}
+
+func (x *RescueSwap) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RescueSwap) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *RescueSwap) GetPreimage() string {
+	if x != nil {
+		return x.Preimage
+	}
+	return ""
+}
+
+func (x *RescueSwap) GetRedeemScript() string {
+	if x != nil {
+		return x.RedeemScript
+	}
+	return ""
+}
+
+func (x *RescueSwap) GetLockupAddress() string {
+	if x != nil {
+		return x.LockupAddress
+	}
+	return ""
+}
+
+func (x *RescueSwap) GetTimeoutBlockHeight() uint32 {
+	if x != nil {
+		return x.TimeoutBlockHeight
+	}
+	return 0
+}
+
+func (x *RescueSwap) GetBlindingKey() string {
+	if x != nil {
+		return x.BlindingKey
+	}
+	return ""
+}
+
+func (x *RescueSwap) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RescueSwap) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type ExportRescueFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IDs of the swaps and reverse swaps to export. All pending ones are exported if not set
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *ExportRescueFileRequest) Reset() {
+	*x = ExportRescueFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRescueFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRescueFileRequest) ProtoMessage() {}
+
+func (x *ExportRescueFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRescueFileRequest.ProtoReflect.Descriptor instead.
+func (*ExportRescueFileRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ExportRescueFileRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ExportRescueFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swaps []*RescueSwap `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+}
+
+func (x *ExportRescueFileResponse) Reset() {
+	*x = ExportRescueFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRescueFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRescueFileResponse) ProtoMessage() {}
+
+func (x *ExportRescueFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRescueFileResponse.ProtoReflect.Descriptor instead.
+func (*ExportRescueFileResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ExportRescueFileResponse) GetSwaps() []*RescueSwap {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+type ExportSeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExportSeedRequest) Reset() {
+	*x = ExportSeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportSeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSeedRequest) ProtoMessage() {}
+
+func (x *ExportSeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSeedRequest.ProtoReflect.Descriptor instead.
+func (*ExportSeedRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{56}
+}
+
+type ExportSeedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mnemonic string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+}
+
+func (x *ExportSeedResponse) Reset() {
+	*x = ExportSeedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportSeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSeedResponse) ProtoMessage() {}
+
+func (x *ExportSeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSeedResponse.ProtoReflect.Descriptor instead.
+func (*ExportSeedResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ExportSeedResponse) GetMnemonic() string {
+	if x != nil {
+		return x.Mnemonic
+	}
+	return ""
+}
+
+type ImportSwapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swaps []*RescueSwap `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+	//
+	//Name of the LND node through which the swaps are refunded or claimed. The node of the swap is used if not set and
+	//the node of the [LND] section if the swap has none either.
+	Node string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *ImportSwapsRequest) Reset() {
+	*x = ImportSwapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSwapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSwapsRequest) ProtoMessage() {}
+
+func (x *ImportSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSwapsRequest.ProtoReflect.Descriptor instead.
+func (*ImportSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ImportSwapsRequest) GetSwaps() []*RescueSwap {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+func (x *ImportSwapsRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type ImportSwapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key index is not set, because imported keys are not derived from the seed
+	Swaps []*RecoveredSwap `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+}
+
+func (x *ImportSwapsResponse) Reset() {
+	*x = ImportSwapsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSwapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSwapsResponse) ProtoMessage() {}
+
+func (x *ImportSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSwapsResponse.ProtoReflect.Descriptor instead.
+func (*ImportSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ImportSwapsResponse) GetSwaps() []*RecoveredSwap {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+type ListDanglingChannelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only channels of the LND node with this name are returned if set
+	Node string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *ListDanglingChannelsRequest) Reset() {
+	*x = ListDanglingChannelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDanglingChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDanglingChannelsRequest) ProtoMessage() {}
+
+func (x *ListDanglingChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDanglingChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListDanglingChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListDanglingChannelsRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type DanglingChannel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the failed channel creation for which the channel was opened
+	SwapId       string `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty"`
+	ChannelPoint string `protobuf:"bytes,2,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	Node         string `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	// Block height at which the daemon found the channel
+	DetectionHeight uint32 `protobuf:"varint,4,opt,name=detection_height,json=detectionHeight,proto3" json:"detection_height,omitempty"`
+	// Block height at which the channel is closed. Not set if dangling channels are not closed automatically
+	CloseHeight uint32 `protobuf:"varint,5,opt,name=close_height,json=closeHeight,proto3" json:"close_height,omitempty"`
+	// Not set until the channel is closed
+	ClosingTransactionId string `protobuf:"bytes,6,opt,name=closing_transaction_id,json=closingTransactionId,proto3" json:"closing_transaction_id,omitempty"`
+	ForceClosed          bool   `protobuf:"varint,7,opt,name=force_closed,json=forceClosed,proto3" json:"force_closed,omitempty"`
+}
+
+func (x *DanglingChannel) Reset() {
+	*x = DanglingChannel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DanglingChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DanglingChannel) ProtoMessage() {}
+
+func (x *DanglingChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DanglingChannel.ProtoReflect.Descriptor instead.
+func (*DanglingChannel) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DanglingChannel) GetSwapId() string {
+	if x != nil {
+		return x.SwapId
+	}
+	return ""
+}
+
+func (x *DanglingChannel) GetChannelPoint() string {
+	if x != nil {
+		return x.ChannelPoint
+	}
+	return ""
+}
+
+func (x *DanglingChannel) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *DanglingChannel) GetDetectionHeight() uint32 {
+	if x != nil {
+		return x.DetectionHeight
+	}
+	return 0
+}
+
+func (x *DanglingChannel) GetCloseHeight() uint32 {
+	if x != nil {
+		return x.CloseHeight
+	}
+	return 0
+}
+
+func (x *DanglingChannel) GetClosingTransactionId() string {
+	if x != nil {
+		return x.ClosingTransactionId
+	}
+	return ""
+}
+
+func (x *DanglingChannel) GetForceClosed() bool {
+	if x != nil {
+		return x.ForceClosed
+	}
+	return false
+}
+
+type ListDanglingChannelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channels []*DanglingChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (x *ListDanglingChannelsResponse) Reset() {
+	*x = ListDanglingChannelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDanglingChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDanglingChannelsResponse) ProtoMessage() {}
+
+func (x *ListDanglingChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDanglingChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListDanglingChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListDanglingChannelsResponse) GetChannels() []*DanglingChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+var File_boltzrpc_proto protoreflect.FileDescriptor
+
+var file_boltzrpc_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x08, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x22, 0xf4, 0x04, 0x0a, 0x08, 0x53,
+	0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32,
+	0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c,
+	0x6f, 0x63, 0x6b, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6c,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x22, 0xfd, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x77, 0x61,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x77, 0x61, 0x70,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x12, 0x34, 0x0a, 0x16, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x14, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x66, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76,
+	0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x66, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x75,
+	0x74, 0x22, 0x8b, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a,
+	0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x04, 0x73, 0x77, 0x61, 0x70, 0x12, 0x48, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xad, 0x04, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77,
+	0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x64,
+	0x65, 0x65, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22,
+	0x92, 0x01, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6e, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x24, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x88, 0x02, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6e, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x6e,
+	0x6f, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x3d, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65,
+	0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x04, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x05,
+	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73,
+	0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x61, 0x78, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61,
+	0x78, 0x69, 0x6d, 0x61, 0x6c, 0x22, 0x44, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x61, 0x69, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x7a, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x46,
+	0x65, 0x65, 0x73, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x69, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x22, 0xcb, 0x02, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a,
+	0x14, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x12,
+	0x26, 0x0a, 0x0f, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66,
+	0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x4d,
+	0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x46, 0x65, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x61, 0x74,
+	0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x26,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0xcd, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05,
+	0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x12, 0x4e, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d,
+	0x62, 0x69, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x77, 0x61, 0x70,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61,
+	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc5, 0x01, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77,
+	0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x12, 0x48, 0x0a, 0x10,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53,
+	0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x53, 0x77, 0x61, 0x70, 0x22, 0x61, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x66, 0x69, 0x61, 0x74, 0x22, 0x96, 0x04, 0x0a, 0x0e, 0x46, 0x65, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x6e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2d, 0x0a, 0x12,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e,
+	0x69, 0x6e, 0x67, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x66, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x5f,
+	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x72,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65,
+	0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x61, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x73,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x66, 0x69, 0x61, 0x74, 0x46, 0x65, 0x65, 0x73,
+	0x22, 0xd9, 0x01, 0x0a, 0x0e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65,
+	0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f,
+	0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x6e,
+	0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x66, 0x69, 0x61, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x66, 0x69, 0x61, 0x74, 0x46, 0x65, 0x65, 0x73, 0x22, 0xbe, 0x01, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x52, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66,
+	0x69, 0x61, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x66, 0x69, 0x61, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x61, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x66, 0x69, 0x61, 0x74, 0x46, 0x65, 0x65, 0x73, 0x22, 0x86, 0x01,
+	0x0a, 0x0d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x22, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56,
+	0x62, 0x79, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4c, 0x0a, 0x09, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x61,
+	0x78, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6d,
+	0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x61,
+	0x78, 0x46, 0x65, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x10, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x12, 0x41, 0x0a, 0x10, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x66, 0x75, 0x6e, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x0f,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6c,
+	0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x6b,
+	0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
+	0x97, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x41, 0x0a, 0x10, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x66, 0x75, 0x6e, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x69, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x69, 0x72, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x66,
+	0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xb1, 0x01, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x69, 0x70, 0x32, 0x31, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x62, 0x69, 0x70, 0x32, 0x31, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x6f, 0x63,
+	0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x80, 0x02,
+	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b,
+	0x0a, 0x11, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x69, 0x6e, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x66, 0x75, 0x6e, 0x64, 0x46, 0x72,
+	0x6f, 0x6d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x0a,
+	0x66, 0x65, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x22, 0xf6, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x28, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x5a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x69,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x69, 0x72,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x09, 0x66, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0xb7, 0x01, 0x0a, 0x19, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x75,
+	0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x31,
+	0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x69,
+	0x6c, 0x6c, 0x69, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x72,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53, 0x61,
+	0x74, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x53, 0x77, 0x61,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62,
+	0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65,
+	0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x22, 0x48, 0x0a, 0x12, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x15,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0x5b, 0x0a, 0x0e, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x61, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x22, 0x38, 0x0a,
+	0x0f, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22,
+	0xf3, 0x01, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x12, 0x48, 0x0a,
+	0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x53, 0x77, 0x61, 0x70, 0x22, 0x97, 0x03, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x77,
+	0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x65,
+	0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x6d, 0x69, 0x6e,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x26,
+	0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x69,
+	0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x6d, 0x61, 0x78, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22,
+	0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4d, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4c, 0x0a, 0x18, 0x53, 0x65,
+	0x74, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4d, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x41,
+	0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x8a, 0x02, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x6f,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x69, 0x73,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x23, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x70, 0x0a, 0x22, 0x47, 0x65, 0x74,
+	0x41, 0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4a, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2f, 0x0a, 0x0d, 0x55,
+	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0x10, 0x0a, 0x0e,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36,
+	0x0a, 0x14, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68,
+	0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73,
+	0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x72, 0x0a, 0x08, 0x4c, 0x6f, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x61, 0x69, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x53,
+	0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x73,
+	0x77, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x05,
+	0x73, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x6f, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65,
+	0x64, 0x53, 0x77, 0x61, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x6b, 0x65, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x45, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x22,
+	0xec, 0x02, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x63, 0x75, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x2b,
+	0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x63, 0x75, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x46, 0x0a, 0x18, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x63, 0x75, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x75, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x05, 0x73, 0x77,
+	0x61, 0x70, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x30, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x22, 0x54, 0x0a, 0x12, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2a, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x75,
+	0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x22, 0x44, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x52,
+	0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x22, 0x31, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61,
+	0x6e, 0x67, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x8a, 0x02, 0x0a, 0x0f, 0x44, 0x61,
+	0x6e, 0x67, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x17, 0x0a,
+	0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x34, 0x0a,
+	0x16, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63,
+	0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x22, 0x55, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61,
+	0x6e, 0x67, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x6e, 0x67, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2a, 0x62, 0x0a,
+	0x09, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x55, 0x43, 0x43, 0x45,
+	0x53, 0x53, 0x46, 0x55, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x42, 0x41, 0x4e, 0x44, 0x4f, 0x4e, 0x45, 0x44, 0x10,
+	0x05, 0x2a, 0x46, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a,
+	0x09, 0x53, 0x55, 0x42, 0x4d, 0x41, 0x52, 0x49, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
+	0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x41, 0x52, 0x49, 0x4e,
+	0x45, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x2a, 0x27, 0x0a, 0x0e, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x53,
+	0x45, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45,
+	0x10, 0x01, 0x32, 0xbd, 0x0e, 0x0a, 0x05, 0x42, 0x6f, 0x6c, 0x74, 0x7a, 0x12, 0x3e, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x19, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70,
+	0x73, 0x12, 0x1a, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61,
+	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x12, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x77, 0x61, 0x70, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d,
+	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x1e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a,
+	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77,
+	0x61, 0x70, 0x12, 0x22, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x53, 0x77, 0x61, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x12,
+	0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61,
+	0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41,
+	0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x22, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f,
+	0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74,
+	0x6f, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x22, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x77,
+	0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75,
+	0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75,
+	0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x06, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x53, 0x65,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68,
+	0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68,
+	0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1d, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x53,
+	0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x63, 0x75, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x21, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x63, 0x75, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x63, 0x75, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x65, 0x65, 0x64, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4a, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1c,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x61, 0x6e, 0x67, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x12, 0x25, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x61, 0x6e, 0x67, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x6e, 0x67, 0x6c, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x42, 0x6f, 0x6c, 0x74, 0x7a, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2f, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x2d, 0x6c, 0x6e, 0x64, 0x2f, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_boltzrpc_proto_rawDescOnce sync.Once
+	file_boltzrpc_proto_rawDescData = file_boltzrpc_proto_rawDesc
+)
+
+func file_boltzrpc_proto_rawDescGZIP() []byte {
+	file_boltzrpc_proto_rawDescOnce.Do(func() {
+		file_boltzrpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_boltzrpc_proto_rawDescData)
+	})
+	return file_boltzrpc_proto_rawDescData
+}
+
+var file_boltzrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_boltzrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
+var file_boltzrpc_proto_goTypes = []interface{}{
+	(SwapState)(0),                             // 0: boltzrpc.SwapState
+	(SwapType)(0),                              // 1: boltzrpc.SwapType
+	(QuoteDirection)(0),                        // 2: boltzrpc.QuoteDirection
+	(*SwapInfo)(nil),                           // 3: boltzrpc.SwapInfo
+	(*ChannelCreationInfo)(nil),                // 4: boltzrpc.ChannelCreationInfo
+	(*CombinedChannelSwapInfo)(nil),            // 5: boltzrpc.CombinedChannelSwapInfo
+	(*ReverseSwapInfo)(nil),                    // 6: boltzrpc.ReverseSwapInfo
+	(*NodeInfo)(nil),                           // 7: boltzrpc.NodeInfo
+	(*GetInfoRequest)(nil),                     // 8: boltzrpc.GetInfoRequest
+	(*GetInfoResponse)(nil),                    // 9: boltzrpc.GetInfoResponse
+	(*MinerFees)(nil),                          // 10: boltzrpc.MinerFees
+	(*Fees)(nil),                               // 11: boltzrpc.Fees
+	(*Limits)(nil),                             // 12: boltzrpc.Limits
+	(*GetServiceInfoRequest)(nil),              // 13: boltzrpc.GetServiceInfoRequest
+	(*GetServiceInfoResponse)(nil),             // 14: boltzrpc.GetServiceInfoResponse
+	(*GetQuoteRequest)(nil),                    // 15: boltzrpc.GetQuoteRequest
+	(*GetQuoteResponse)(nil),                   // 16: boltzrpc.GetQuoteResponse
+	(*ListSwapsRequest)(nil),                   // 17: boltzrpc.ListSwapsRequest
+	(*ListSwapsResponse)(nil),                  // 18: boltzrpc.ListSwapsResponse
+	(*GetSwapInfoRequest)(nil),                 // 19: boltzrpc.GetSwapInfoRequest
+	(*GetSwapInfoResponse)(nil),                // 20: boltzrpc.GetSwapInfoResponse
+	(*GetFeeReportRequest)(nil),                // 21: boltzrpc.GetFeeReportRequest
+	(*FeeReportEntry)(nil),                     // 22: boltzrpc.FeeReportEntry
+	(*FeeReportTotal)(nil),                     // 23: boltzrpc.FeeReportTotal
+	(*GetFeeReportResponse)(nil),               // 24: boltzrpc.GetFeeReportResponse
+	(*WalletFunding)(nil),                      // 25: boltzrpc.WalletFunding
+	(*FeePolicy)(nil),                          // 26: boltzrpc.FeePolicy
+	(*DepositRequest)(nil),                     // 27: boltzrpc.DepositRequest
+	(*DepositResponse)(nil),                    // 28: boltzrpc.DepositResponse
+	(*CreateSwapRequest)(nil),                  // 29: boltzrpc.CreateSwapRequest
+	(*CreateSwapResponse)(nil),                 // 30: boltzrpc.CreateSwapResponse
+	(*CreateChannelRequest)(nil),               // 31: boltzrpc.CreateChannelRequest
+	(*CreateReverseSwapRequest)(nil),           // 32: boltzrpc.CreateReverseSwapRequest
+	(*CreateReverseSwapResponse)(nil),          // 33: boltzrpc.CreateReverseSwapResponse
+	(*RefundSwapRequest)(nil),                  // 34: boltzrpc.RefundSwapRequest
+	(*RefundSwapResponse)(nil),                 // 35: boltzrpc.RefundSwapResponse
+	(*BumpFeeRequest)(nil),                     // 36: boltzrpc.BumpFeeRequest
+	(*BumpFeeResponse)(nil),                    // 37: boltzrpc.BumpFeeResponse
+	(*SubscribeSwapEventsRequest)(nil),         // 38: boltzrpc.SubscribeSwapEventsRequest
+	(*SwapEvent)(nil),                          // 39: boltzrpc.SwapEvent
+	(*AutoSwapConfig)(nil),                     // 40: boltzrpc.AutoSwapConfig
+	(*GetAutoSwapConfigRequest)(nil),           // 41: boltzrpc.GetAutoSwapConfigRequest
+	(*GetAutoSwapConfigResponse)(nil),          // 42: boltzrpc.GetAutoSwapConfigResponse
+	(*SetAutoSwapConfigRequest)(nil),           // 43: boltzrpc.SetAutoSwapConfigRequest
+	(*SetAutoSwapConfigResponse)(nil),          // 44: boltzrpc.SetAutoSwapConfigResponse
+	(*AutoSwapRecommendation)(nil),             // 45: boltzrpc.AutoSwapRecommendation
+	(*GetAutoSwapRecommendationsRequest)(nil),  // 46: boltzrpc.GetAutoSwapRecommendationsRequest
+	(*GetAutoSwapRecommendationsResponse)(nil), // 47: boltzrpc.GetAutoSwapRecommendationsResponse
+	(*UnlockRequest)(nil),                      // 48: boltzrpc.UnlockRequest
+	(*UnlockResponse)(nil),                     // 49: boltzrpc.UnlockResponse
+	(*SetPassphraseRequest)(nil),               // 50: boltzrpc.SetPassphraseRequest
+	(*SetPassphraseResponse)(nil),              // 51: boltzrpc.SetPassphraseResponse
+	(*LostSwap)(nil),                           // 52: boltzrpc.LostSwap
+	(*RecoverSwapsRequest)(nil),                // 53: boltzrpc.RecoverSwapsRequest
+	(*RecoveredSwap)(nil),                      // 54: boltzrpc.RecoveredSwap
+	(*RecoverSwapsResponse)(nil),               // 55: boltzrpc.RecoverSwapsResponse
+	(*RescueSwap)(nil),                         // 56: boltzrpc.RescueSwap
+	(*ExportRescueFileRequest)(nil),            // 57: boltzrpc.ExportRescueFileRequest
+	(*ExportRescueFileResponse)(nil),           // 58: boltzrpc.ExportRescueFileResponse
+	(*ExportSeedRequest)(nil),                  // 59: boltzrpc.ExportSeedRequest
+	(*ExportSeedResponse)(nil),                 // 60: boltzrpc.ExportSeedResponse
+	(*ImportSwapsRequest)(nil),                 // 61: boltzrpc.ImportSwapsRequest
+	(*ImportSwapsResponse)(nil),                // 62: boltzrpc.ImportSwapsResponse
+	(*ListDanglingChannelsRequest)(nil),        // 63: boltzrpc.ListDanglingChannelsRequest
+	(*DanglingChannel)(nil),                    // 64: boltzrpc.DanglingChannel
+	(*ListDanglingChannelsResponse)(nil),       // 65: boltzrpc.ListDanglingChannelsResponse
+}
+var file_boltzrpc_proto_depIdxs = []int32{
+	0,  // 0: boltzrpc.SwapInfo.state:type_name -> boltzrpc.SwapState
+	3,  // 1: boltzrpc.CombinedChannelSwapInfo.swap:type_name -> boltzrpc.SwapInfo
+	4,  // 2: boltzrpc.CombinedChannelSwapInfo.channel_creation:type_name -> boltzrpc.ChannelCreationInfo
+	0,  // 3: boltzrpc.ReverseSwapInfo.state:type_name -> boltzrpc.SwapState
+	7,  // 4: boltzrpc.GetInfoResponse.nodes:type_name -> boltzrpc.NodeInfo
+	10, // 5: boltzrpc.Fees.miner:type_name -> boltzrpc.MinerFees
+	11, // 6: boltzrpc.GetServiceInfoResponse.fees:type_name -> boltzrpc.Fees
+	12, // 7: boltzrpc.GetServiceInfoResponse.limits:type_name -> boltzrpc.Limits
+	1,  // 8: boltzrpc.GetQuoteRequest.type:type_name -> boltzrpc.SwapType
+	2,  // 9: boltzrpc.GetQuoteRequest.direction:type_name -> boltzrpc.QuoteDirection
+	12, // 10: boltzrpc.GetQuoteResponse.limits:type_name -> boltzrpc.Limits
+	3,  // 11: boltzrpc.ListSwapsResponse.swaps:type_name -> boltzrpc.SwapInfo
+	5,  // 12: boltzrpc.ListSwapsResponse.channel_creations:type_name -> boltzrpc.CombinedChannelSwapInfo
+	6,  // 13: boltzrpc.ListSwapsResponse.reverse_swaps:type_name -> boltzrpc.ReverseSwapInfo
+	3,  // 14: boltzrpc.GetSwapInfoResponse.swap:type_name -> boltzrpc.SwapInfo
+	4,  // 15: boltzrpc.GetSwapInfoResponse.channel_creation:type_name -> boltzrpc.ChannelCreationInfo
+	6,  // 16: boltzrpc.GetSwapInfoResponse.reverse_swap:type_name -> boltzrpc.ReverseSwapInfo
+	1,  // 17: boltzrpc.FeeReportEntry.type:type_name -> boltzrpc.SwapType
+	0,  // 18: boltzrpc.FeeReportEntry.state:type_name -> boltzrpc.SwapState
+	22, // 19: boltzrpc.GetFeeReportResponse.entries:type_name -> boltzrpc.FeeReportEntry
+	23, // 20: boltzrpc.GetFeeReportResponse.totals:type_name -> boltzrpc.FeeReportTotal
+	25, // 21: boltzrpc.DepositRequest.fund_from_wallet:type_name -> boltzrpc.WalletFunding
+	25, // 22: boltzrpc.CreateSwapRequest.fund_from_wallet:type_name -> boltzrpc.WalletFunding
+	26, // 23: boltzrpc.CreateSwapRequest.fee_policy:type_name -> boltzrpc.FeePolicy
+	25, // 24: boltzrpc.CreateChannelRequest.fund_from_wallet:type_name -> boltzrpc.WalletFunding
+	26, // 25: boltzrpc.CreateChannelRequest.fee_policy:type_name -> boltzrpc.FeePolicy
+	26, // 26: boltzrpc.CreateReverseSwapRequest.fee_policy:type_name -> boltzrpc.FeePolicy
+	1,  // 27: boltzrpc.SubscribeSwapEventsRequest.types:type_name -> boltzrpc.SwapType
+	1,  // 28: boltzrpc.SwapEvent.type:type_name -> boltzrpc.SwapType
+	3,  // 29: boltzrpc.SwapEvent.swap:type_name -> boltzrpc.SwapInfo
+	4,  // 30: boltzrpc.SwapEvent.channel_creation:type_name -> boltzrpc.ChannelCreationInfo
+	6,  // 31: boltzrpc.SwapEvent.reverse_swap:type_name -> boltzrpc.ReverseSwapInfo
+	40, // 32: boltzrpc.GetAutoSwapConfigResponse.config:type_name -> boltzrpc.AutoSwapConfig
+	40, // 33: boltzrpc.SetAutoSwapConfigRequest.config:type_name -> boltzrpc.AutoSwapConfig
+	40, // 34: boltzrpc.SetAutoSwapConfigResponse.config:type_name -> boltzrpc.AutoSwapConfig
+	1,  // 35: boltzrpc.AutoSwapRecommendation.type:type_name -> boltzrpc.SwapType
+	45, // 36: boltzrpc.GetAutoSwapRecommendationsResponse.recommendations:type_name -> boltzrpc.AutoSwapRecommendation
+	52, // 37: boltzrpc.RecoverSwapsRequest.swaps:type_name -> boltzrpc.LostSwap
+	1,  // 38: boltzrpc.RecoveredSwap.type:type_name -> boltzrpc.SwapType
+	54, // 39: boltzrpc.RecoverSwapsResponse.swaps:type_name -> boltzrpc.RecoveredSwap
+	1,  // 40: boltzrpc.RescueSwap.type:type_name -> boltzrpc.SwapType
+	56, // 41: boltzrpc.ExportRescueFileResponse.swaps:type_name -> boltzrpc.RescueSwap
+	56, // 42: boltzrpc.ImportSwapsRequest.swaps:type_name -> boltzrpc.RescueSwap
+	54, // 43: boltzrpc.ImportSwapsResponse.swaps:type_name -> boltzrpc.RecoveredSwap
+	64, // 44: boltzrpc.ListDanglingChannelsResponse.channels:type_name -> boltzrpc.DanglingChannel
+	8,  // 45: boltzrpc.Boltz.GetInfo:input_type -> boltzrpc.GetInfoRequest
+	13, // 46: boltzrpc.Boltz.GetServiceInfo:input_type -> boltzrpc.GetServiceInfoRequest
+	15, // 47: boltzrpc.Boltz.GetQuote:input_type -> boltzrpc.GetQuoteRequest
+	17, // 48: boltzrpc.Boltz.ListSwaps:input_type -> boltzrpc.ListSwapsRequest
+	19, // 49: boltzrpc.Boltz.GetSwapInfo:input_type -> boltzrpc.GetSwapInfoRequest
+	21, // 50: boltzrpc.Boltz.GetFeeReport:input_type -> boltzrpc.GetFeeReportRequest
+	27, // 51: boltzrpc.Boltz.Deposit:input_type -> boltzrpc.DepositRequest
+	29, // 52: boltzrpc.Boltz.CreateSwap:input_type -> boltzrpc.CreateSwapRequest
+	31, // 53: boltzrpc.Boltz.CreateChannel:input_type -> boltzrpc.CreateChannelRequest
+	32, // 54: boltzrpc.Boltz.CreateReverseSwap:input_type -> boltzrpc.CreateReverseSwapRequest
+	34, // 55: boltzrpc.Boltz.RefundSwap:input_type -> boltzrpc.RefundSwapRequest
+	36, // 56: boltzrpc.Boltz.BumpFee:input_type -> boltzrpc.BumpFeeRequest
+	38, // 57: boltzrpc.Boltz.SubscribeSwapEvents:input_type -> boltzrpc.SubscribeSwapEventsRequest
+	41, // 58: boltzrpc.Boltz.GetAutoSwapConfig:input_type -> boltzrpc.GetAutoSwapConfigRequest
+	43, // 59: boltzrpc.Boltz.SetAutoSwapConfig:input_type -> boltzrpc.SetAutoSwapConfigRequest
+	46, // 60: boltzrpc.Boltz.GetAutoSwapRecommendations:input_type -> boltzrpc.GetAutoSwapRecommendationsRequest
+	48, // 61: boltzrpc.Boltz.Unlock:input_type -> boltzrpc.UnlockRequest
+	50, // 62: boltzrpc.Boltz.SetPassphrase:input_type -> boltzrpc.SetPassphraseRequest
+	53, // 63: boltzrpc.Boltz.RecoverSwaps:input_type -> boltzrpc.RecoverSwapsRequest
+	57, // 64: boltzrpc.Boltz.ExportRescueFile:input_type -> boltzrpc.ExportRescueFileRequest
+	59, // 65: boltzrpc.Boltz.ExportSeed:input_type -> boltzrpc.ExportSeedRequest
+	61, // 66: boltzrpc.Boltz.ImportSwaps:input_type -> boltzrpc.ImportSwapsRequest
+	63, // 67: boltzrpc.Boltz.ListDanglingChannels:input_type -> boltzrpc.ListDanglingChannelsRequest
+	9,  // 68: boltzrpc.Boltz.GetInfo:output_type -> boltzrpc.GetInfoResponse
+	14, // 69: boltzrpc.Boltz.GetServiceInfo:output_type -> boltzrpc.GetServiceInfoResponse
+	16, // 70: boltzrpc.Boltz.GetQuote:output_type -> boltzrpc.GetQuoteResponse
+	18, // 71: boltzrpc.Boltz.ListSwaps:output_type -> boltzrpc.ListSwapsResponse
+	20, // 72: boltzrpc.Boltz.GetSwapInfo:output_type -> boltzrpc.GetSwapInfoResponse
+	24, // 73: boltzrpc.Boltz.GetFeeReport:output_type -> boltzrpc.GetFeeReportResponse
+	28, // 74: boltzrpc.Boltz.Deposit:output_type -> boltzrpc.DepositResponse
+	30, // 75: boltzrpc.Boltz.CreateSwap:output_type -> boltzrpc.CreateSwapResponse
+	30, // 76: boltzrpc.Boltz.CreateChannel:output_type -> boltzrpc.CreateSwapResponse
+	33, // 77: boltzrpc.Boltz.CreateReverseSwap:output_type -> boltzrpc.CreateReverseSwapResponse
+	35, // 78: boltzrpc.Boltz.RefundSwap:output_type -> boltzrpc.RefundSwapResponse
+	37, // 79: boltzrpc.Boltz.BumpFee:output_type -> boltzrpc.BumpFeeResponse
+	39, // 80: boltzrpc.Boltz.SubscribeSwapEvents:output_type -> boltzrpc.SwapEvent
+	42, // 81: boltzrpc.Boltz.GetAutoSwapConfig:output_type -> boltzrpc.GetAutoSwapConfigResponse
+	44, // 82: boltzrpc.Boltz.SetAutoSwapConfig:output_type -> boltzrpc.SetAutoSwapConfigResponse
+	47, // 83: boltzrpc.Boltz.GetAutoSwapRecommendations:output_type -> boltzrpc.GetAutoSwapRecommendationsResponse
+	49, // 84: boltzrpc.Boltz.Unlock:output_type -> boltzrpc.UnlockResponse
+	51, // 85: boltzrpc.Boltz.SetPassphrase:output_type -> boltzrpc.SetPassphraseResponse
+	55, // 86: boltzrpc.Boltz.RecoverSwaps:output_type -> boltzrpc.RecoverSwapsResponse
+	58, // 87: boltzrpc.Boltz.ExportRescueFile:output_type -> boltzrpc.ExportRescueFileResponse
+	60, // 88: boltzrpc.Boltz.ExportSeed:output_type -> boltzrpc.ExportSeedResponse
+	62, // 89: boltzrpc.Boltz.ImportSwaps:output_type -> boltzrpc.ImportSwapsResponse
+	65, // 90: boltzrpc.Boltz.ListDanglingChannels:output_type -> boltzrpc.ListDanglingChannelsResponse
+	68, // [68:91] is the sub-list for method output_type
+	45, // [45:68] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
+}
+
+func init() { file_boltzrpc_proto_init() }
+func file_boltzrpc_proto_init() {
+	if File_boltzrpc_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_boltzrpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelCreationInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CombinedChannelSwapInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseSwapInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinerFees); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fees); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Limits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSwapsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSwapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSwapInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSwapInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFeeReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeReportEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeReportTotal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFeeReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletFunding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeePolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReverseSwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReverseSwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefundSwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefundSwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSwapEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoSwapConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateReverseSwapResponse.ProtoReflect.Descriptor instead.
-func (*CreateReverseSwapResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *CreateReverseSwapResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CreateReverseSwapResponse) GetLockupAddress() string {
-	if x != nil {
-		return x.LockupAddress
-	}
-	return ""
-}
-
-func (x *CreateReverseSwapResponse) GetRoutingFeeMilliSat() uint32 {
-	if x != nil {
-		return x.RoutingFeeMilliSat
-	}
-	return 0
-}
-
-func (x *CreateReverseSwapResponse) GetClaimTransactionId() string {
-	if x != nil {
-		return x.ClaimTransactionId
-	}
-	return ""
-}
-
-var File_boltzrpc_proto protoreflect.FileDescriptor
-
-var file_boltzrpc_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x08, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x22, 0xd9, 0x03, 0x0a, 0x08, 0x53,
-	0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
-	0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65,
-	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e,
-	0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78,
-	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14,
-	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32,
-	0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c,
-	0x6f, 0x63, 0x6b, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x13, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xfd, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17,
-	0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69,
-	0x64, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x69, 0x6e, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07,
-	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18,
-	0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16,
-	0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x56, 0x6f, 0x75, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x62, 0x69,
-	0x6e, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x26, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x12, 0x48, 0x0a, 0x10, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xda, 0x03, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
-	0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
-	0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b,
-	0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x23,
-	0x0a, 0x0d, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x53, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6f, 0x6e, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6c,
-	0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x6b,
-	0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
-	0x30, 0x0a, 0x14, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63,
-	0x6c, 0x61, 0x69, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0xde, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
-	0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6e, 0x64,
-	0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c,
-	0x6e, 0x64, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x73,
-	0x12, 0x32, 0x0a, 0x15, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53,
-	0x77, 0x61, 0x70, 0x73, 0x22, 0x3d, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76,
-	0x65, 0x72, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x04, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
-	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x6d,
-	0x69, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x52,
-	0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61,
-	0x78, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78,
-	0x69, 0x6d, 0x61, 0x6c, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x66, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
-	0x2e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61,
-	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xcd, 0x01, 0x0a, 0x11, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x28, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x12, 0x4e, 0x0a, 0x11, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
-	0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53,
-	0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x72, 0x65, 0x76,
-	0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x72, 0x65, 0x76,
-	0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0xc5, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
-	0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x12,
-	0x48, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
-	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x65, 0x76,
-	0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x22, 0x3d, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x62,
-	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x69, 0x71,
-	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x22, 0x6d, 0x0a, 0x0f, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x2b, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x7d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62,
-	0x69, 0x70, 0x32, 0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x69, 0x70, 0x32,
-	0x31, 0x22, 0x75, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x71,
-	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x69, 0x6e,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0x76, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
-	0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66,
-	0x22, 0xb7, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25,
-	0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
-	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65,
-	0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6c, 0x61, 0x69,
-	0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x2a, 0x62, 0x0a, 0x09, 0x53, 0x77,
-	0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49,
-	0x4e, 0x47, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x46,
-	0x55, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12,
-	0x10, 0x0a, 0x0c, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
-	0x03, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12,
-	0x0d, 0x0a, 0x09, 0x41, 0x42, 0x41, 0x4e, 0x44, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x05, 0x32, 0xe4,
-	0x04, 0x0a, 0x05, 0x42, 0x6f, 0x6c, 0x74, 0x7a, 0x12, 0x3e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47,
-	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
-	0x09, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1a, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3e, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x18, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x47, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1b, 0x2e,
-	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1e, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
-	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
-	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x22, 0x2e, 0x62,
-	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x23, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x6f, 0x6c, 0x74, 0x7a, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x2f, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x2d, 0x6c, 0x6e, 0x64, 0x2f, 0x62, 0x6f, 0x6c, 0x74,
-	0x7a, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_boltzrpc_proto_rawDescOnce sync.Once
-	file_boltzrpc_proto_rawDescData = file_boltzrpc_proto_rawDesc
-)
-
-func file_boltzrpc_proto_rawDescGZIP() []byte {
-	file_boltzrpc_proto_rawDescOnce.Do(func() {
-		file_boltzrpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_boltzrpc_proto_rawDescData)
-	})
-	return file_boltzrpc_proto_rawDescData
-}
-
-var file_boltzrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_boltzrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
-var file_boltzrpc_proto_goTypes = []interface{}{
-	(SwapState)(0),                    // 0: boltzrpc.SwapState
-	(*SwapInfo)(nil),                  // 1: boltzrpc.SwapInfo
-	(*ChannelCreationInfo)(nil),       // 2: boltzrpc.ChannelCreationInfo
-	(*CombinedChannelSwapInfo)(nil),   // 3: boltzrpc.CombinedChannelSwapInfo
-	(*ReverseSwapInfo)(nil),           // 4: boltzrpc.ReverseSwapInfo
-	(*GetInfoRequest)(nil),            // 5: boltzrpc.GetInfoRequest
-	(*GetInfoResponse)(nil),           // 6: boltzrpc.GetInfoResponse
-	(*MinerFees)(nil),                 // 7: boltzrpc.MinerFees
-	(*Fees)(nil),                      // 8: boltzrpc.Fees
-	(*Limits)(nil),                    // 9: boltzrpc.Limits
-	(*GetServiceInfoRequest)(nil),     // 10: boltzrpc.GetServiceInfoRequest
-	(*GetServiceInfoResponse)(nil),    // 11: boltzrpc.GetServiceInfoResponse
-	(*ListSwapsRequest)(nil),          // 12: boltzrpc.ListSwapsRequest
-	(*ListSwapsResponse)(nil),         // 13: boltzrpc.ListSwapsResponse
-	(*GetSwapInfoRequest)(nil),        // 14: boltzrpc.GetSwapInfoRequest
-	(*GetSwapInfoResponse)(nil),       // 15: boltzrpc.GetSwapInfoResponse
-	(*DepositRequest)(nil),            // 16: boltzrpc.DepositRequest
-	(*DepositResponse)(nil),           // 17: boltzrpc.DepositResponse
-	(*CreateSwapRequest)(nil),         // 18: boltzrpc.CreateSwapRequest
-	(*CreateSwapResponse)(nil),        // 19: boltzrpc.CreateSwapResponse
-	(*CreateChannelRequest)(nil),      // 20: boltzrpc.CreateChannelRequest
-	(*CreateReverseSwapRequest)(nil),  // 21: boltzrpc.CreateReverseSwapRequest
-	(*CreateReverseSwapResponse)(nil), // 22: boltzrpc.CreateReverseSwapResponse
-}
-var file_boltzrpc_proto_depIdxs = []int32{
-	0,  // 0: boltzrpc.SwapInfo.state:type_name -> boltzrpc.SwapState
-	1,  // 1: boltzrpc.CombinedChannelSwapInfo.swap:type_name -> boltzrpc.SwapInfo
-	2,  // 2: boltzrpc.CombinedChannelSwapInfo.channel_creation:type_name -> boltzrpc.ChannelCreationInfo
-	0,  // 3: boltzrpc.ReverseSwapInfo.state:type_name -> boltzrpc.SwapState
-	7,  // 4: boltzrpc.Fees.miner:type_name -> boltzrpc.MinerFees
-	8,  // 5: boltzrpc.GetServiceInfoResponse.fees:type_name -> boltzrpc.Fees
-	9,  // 6: boltzrpc.GetServiceInfoResponse.limits:type_name -> boltzrpc.Limits
-	1,  // 7: boltzrpc.ListSwapsResponse.swaps:type_name -> boltzrpc.SwapInfo
-	3,  // 8: boltzrpc.ListSwapsResponse.channel_creations:type_name -> boltzrpc.CombinedChannelSwapInfo
-	4,  // 9: boltzrpc.ListSwapsResponse.reverse_swaps:type_name -> boltzrpc.ReverseSwapInfo
-	1,  // 10: boltzrpc.GetSwapInfoResponse.swap:type_name -> boltzrpc.SwapInfo
-	2,  // 11: boltzrpc.GetSwapInfoResponse.channel_creation:type_name -> boltzrpc.ChannelCreationInfo
-	4,  // 12: boltzrpc.GetSwapInfoResponse.reverse_swap:type_name -> boltzrpc.ReverseSwapInfo
-	5,  // 13: boltzrpc.Boltz.GetInfo:input_type -> boltzrpc.GetInfoRequest
-	10, // 14: boltzrpc.Boltz.GetServiceInfo:input_type -> boltzrpc.GetServiceInfoRequest
-	12, // 15: boltzrpc.Boltz.ListSwaps:input_type -> boltzrpc.ListSwapsRequest
-	14, // 16: boltzrpc.Boltz.GetSwapInfo:input_type -> boltzrpc.GetSwapInfoRequest
-	16, // 17: boltzrpc.Boltz.Deposit:input_type -> boltzrpc.DepositRequest
-	18, // 18: boltzrpc.Boltz.CreateSwap:input_type -> boltzrpc.CreateSwapRequest
-	20, // 19: boltzrpc.Boltz.CreateChannel:input_type -> boltzrpc.CreateChannelRequest
-	21, // 20: boltzrpc.Boltz.CreateReverseSwap:input_type -> boltzrpc.CreateReverseSwapRequest
-	6,  // 21: boltzrpc.Boltz.GetInfo:output_type -> boltzrpc.GetInfoResponse
-	11, // 22: boltzrpc.Boltz.GetServiceInfo:output_type -> boltzrpc.GetServiceInfoResponse
-	13, // 23: boltzrpc.Boltz.ListSwaps:output_type -> boltzrpc.ListSwapsResponse
-	15, // 24: boltzrpc.Boltz.GetSwapInfo:output_type -> boltzrpc.GetSwapInfoResponse
-	17, // 25: boltzrpc.Boltz.Deposit:output_type -> boltzrpc.DepositResponse
-	19, // 26: boltzrpc.Boltz.CreateSwap:output_type -> boltzrpc.CreateSwapResponse
-	19, // 27: boltzrpc.Boltz.CreateChannel:output_type -> boltzrpc.CreateSwapResponse
-	22, // 28: boltzrpc.Boltz.CreateReverseSwap:output_type -> boltzrpc.CreateReverseSwapResponse
-	21, // [21:29] is the sub-list for method output_type
-	13, // [13:21] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
-}
-
-func init() { file_boltzrpc_proto_init() }
-func file_boltzrpc_proto_init() {
-	if File_boltzrpc_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_boltzrpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwapInfo); i {
+		file_boltzrpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAutoSwapConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1897,8 +6066,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelCreationInfo); i {
+		file_boltzrpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAutoSwapConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1909,8 +6078,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CombinedChannelSwapInfo); i {
+		file_boltzrpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAutoSwapConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1921,8 +6090,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReverseSwapInfo); i {
+		file_boltzrpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAutoSwapConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1933,8 +6102,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInfoRequest); i {
+		file_boltzrpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoSwapRecommendation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1945,8 +6114,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInfoResponse); i {
+		file_boltzrpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAutoSwapRecommendationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1957,8 +6126,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MinerFees); i {
+		file_boltzrpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAutoSwapRecommendationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1969,8 +6138,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Fees); i {
+		file_boltzrpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1981,8 +6150,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Limits); i {
+		file_boltzrpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1993,8 +6162,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServiceInfoRequest); i {
+		file_boltzrpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPassphraseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2005,8 +6174,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServiceInfoResponse); i {
+		file_boltzrpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPassphraseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2017,8 +6186,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSwapsRequest); i {
+		file_boltzrpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LostSwap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2029,8 +6198,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSwapsResponse); i {
+		file_boltzrpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverSwapsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2041,8 +6210,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSwapInfoRequest); i {
+		file_boltzrpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveredSwap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2053,8 +6222,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSwapInfoResponse); i {
+		file_boltzrpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverSwapsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2065,8 +6234,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositRequest); i {
+		file_boltzrpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescueSwap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2077,8 +6246,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositResponse); i {
+		file_boltzrpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportRescueFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2089,8 +6258,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSwapRequest); i {
+		file_boltzrpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportRescueFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2101,8 +6270,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSwapResponse); i {
+		file_boltzrpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportSeedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2113,8 +6282,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateChannelRequest); i {
+		file_boltzrpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportSeedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2125,8 +6294,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateReverseSwapRequest); i {
+		file_boltzrpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSwapsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2137,8 +6306,44 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateReverseSwapResponse); i {
+		file_boltzrpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSwapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDanglingChannelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DanglingChannel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDanglingChannelsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2155,8 +6360,8 @@ func file_boltzrpc_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_boltzrpc_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   22,
+			NumEnums:      3,
+			NumMessages:   63,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -18,19 +18,28 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type BoltzClient interface {
 	//
-	//Gets general information about the daemon like the chain of the LND node it is connected to
-	//and the IDs of pending swaps.
+	//Gets general information about the daemon like the chains of the LND nodes it is connected to
+	//and the IDs of pending swaps. The swaps can be filtered by the node they were created with.
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
 	//
 	//Fetches the latest limits and fees from the Boltz backend API it is connected to.
 	GetServiceInfo(ctx context.Context, in *GetServiceInfoRequest, opts ...grpc.CallOption) (*GetServiceInfoResponse, error)
 	//
-	//Returns a list of all swaps, reverse swaps and channel creations in the database.
+	//Calculates the fees and the exact amounts that are sent and received for a swap or reverse swap of an amount
+	//without creating it.
+	GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error)
+	//
+	//Returns a list of all swaps, reverse swaps and channel creations in the database. They can be filtered by the node
+	//they were created with.
 	ListSwaps(ctx context.Context, in *ListSwapsRequest, opts ...grpc.CallOption) (*ListSwapsResponse, error)
 	//
 	//Gets all available information about a swap from the database.
 	GetSwapInfo(ctx context.Context, in *GetSwapInfoRequest, opts ...grpc.CallOption) (*GetSwapInfoResponse, error)
 	//
+	//Returns what the swaps that were created in a time range cost. The amounts and fees are recorded in a ledger when
+	//the swaps are created and when their claim, refund and Lightning payments are sent.
+	GetFeeReport(ctx context.Context, in *GetFeeReportRequest, opts ...grpc.CallOption) (*GetFeeReportResponse, error)
+	//
 	//This is a wrapper for channel creation swaps. The daemon only returns the ID, timeout block height and lockup address.
 	//The Boltz backend takes care of the rest. When an amount of onchain coins that is in the limits is sent to the address
 	//before the timeout block height, the daemon creates a new lightning invoice, sends it to the Boltz backend which
@@ -47,6 +56,62 @@ type BoltzClient interface {
 	//Creates a new reverse swap from lightning to onchain. If `accept_zero_conf` is set to true in the request, the daemon
 	//will not wait until the lockup transaction from Boltz is confirmed in a block, but will claim it instantly.
 	CreateReverseSwap(ctx context.Context, in *CreateReverseSwapRequest, opts ...grpc.CallOption) (*CreateReverseSwapResponse, error)
+	//
+	//Refunds the coins locked up for a swap after its timeout block height was reached. Swaps that have not been
+	//refunded yet are refunded automatically by the daemon, but this call allows to choose the refund address
+	//and fee rate or to retry failed refunds.
+	RefundSwap(ctx context.Context, in *RefundSwapRequest, opts ...grpc.CallOption) (*RefundSwapResponse, error)
+	//
+	//Replaces an unconfirmed claim or refund transaction with one that pays a higher fee. The daemon bumps the fees
+	//of transactions that are unconfirmed for too long automatically, but this call allows to choose the fee rate.
+	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	//
+	//Streams an event every time the status or state of a swap, reverse swap or channel creation changes.
+	//Events can be filtered by the ID and the type of the swap.
+	SubscribeSwapEvents(ctx context.Context, in *SubscribeSwapEventsRequest, opts ...grpc.CallOption) (Boltz_SubscribeSwapEventsClient, error)
+	//
+	//Returns the config of autoswap, the subsystem that creates swaps automatically to rebalance the channels.
+	GetAutoSwapConfig(ctx context.Context, in *GetAutoSwapConfigRequest, opts ...grpc.CallOption) (*GetAutoSwapConfigResponse, error)
+	//
+	//Replaces the config of autoswap until the daemon is restarted.
+	SetAutoSwapConfig(ctx context.Context, in *SetAutoSwapConfigRequest, opts ...grpc.CallOption) (*SetAutoSwapConfigResponse, error)
+	//
+	//Returns the swaps autoswap would create with its current config and the reasons why some of them would not be created.
+	GetAutoSwapRecommendations(ctx context.Context, in *GetAutoSwapRecommendationsRequest, opts ...grpc.CallOption) (*GetAutoSwapRecommendationsResponse, error)
+	//
+	//Unlocks a daemon whose database is encrypted. When encryption is enabled, the daemon starts locked and serves only
+	//this call and SetPassphrase, without Macaroon authentication, until the passphrase is provided. The passphrase is
+	//verified against the one that was set with SetPassphrase.
+	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
+	//
+	//Sets the passphrase of a database that is not encrypted yet, encrypts the existing private keys, preimages and
+	//Macaroon root keys and unlocks the daemon. Because the call is not authenticated, it is only served to clients that
+	//connect from localhost and fails once a passphrase was set.
+	SetPassphrase(ctx context.Context, in *SetPassphraseRequest, opts ...grpc.CallOption) (*SetPassphraseResponse, error)
+	//
+	//Rebuilds swaps and reverse swaps that were lost with the database from the seed of the daemon. The keys of the
+	//indexes in the range are derived again and matched against the redeem scripts of the swaps, which have to be
+	//provided because the Boltz API cannot return them. Recovered swaps are refunded after their timeout and recovered
+	//reverse swaps are claimed once their lockup transaction confirms. Indexes up to the end of the range are not
+	//used for new swaps afterwards.
+	RecoverSwaps(ctx context.Context, in *RecoverSwapsRequest, opts ...grpc.CallOption) (*RecoverSwapsResponse, error)
+	//
+	//Returns the private keys, preimages and redeem scripts of the requested swaps and reverse swaps, or of all pending
+	//ones if no IDs are provided. They are what is needed to refund or claim the swaps without the database of the daemon.
+	ExportRescueFile(ctx context.Context, in *ExportRescueFileRequest, opts ...grpc.CallOption) (*ExportRescueFileResponse, error)
+	//
+	//Returns the mnemonic of the seed from which the keys and preimages of new swaps are derived. It is needed to recover
+	//swaps with RecoverSwaps if the database is lost. When the database is encrypted, the mnemonic of a new seed is not
+	//written to the mnemonic file and this call is the only way to back it up.
+	ExportSeed(ctx context.Context, in *ExportSeedRequest, opts ...grpc.CallOption) (*ExportSeedResponse, error)
+	//
+	//Imports swaps and reverse swaps of a rescue file into the database, so that the daemon refunds them after their
+	//timeout or claims them once their lockup transaction confirms. Refund files of the Boltz web app can be imported too.
+	ImportSwaps(ctx context.Context, in *ImportSwapsRequest, opts ...grpc.CallOption) (*ImportSwapsResponse, error)
+	//
+	//Returns the channels Boltz opened for channel creations that failed afterwards. The daemon closes them
+	//cooperatively, or forcefully if Boltz does not cooperate, once the configured grace period is over.
+	ListDanglingChannels(ctx context.Context, in *ListDanglingChannelsRequest, opts ...grpc.CallOption) (*ListDanglingChannelsResponse, error)
 }
 
 type boltzClient struct {
@@ -75,6 +140,15 @@ func (c *boltzClient) GetServiceInfo(ctx context.Context, in *GetServiceInfoRequ
 	return out, nil
 }
 
+func (c *boltzClient) GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error) {
+	out := new(GetQuoteResponse)
+	err := c.cc.Invoke(ctx, "/boltzrpc.Boltz/GetQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boltzClient) ListSwaps(ctx context.Context, in *ListSwapsRequest, opts ...grpc.CallOption) (*ListSwapsResponse, error) {
 	out := new(ListSwapsResponse)
 	err := c.cc.Invoke(ctx, "/boltzrpc.Boltz/ListSwaps", in, out, opts...)
@@ -93,6 +167,15 @@ func (c *boltzClient) GetSwapInfo(ctx context.Context, in *GetSwapInfoRequest, o
 	return out, nil
 }
 
+func (c *boltzClient) GetFeeReport(ctx context.Context, in *GetFeeReportRequest, opts ...grpc.CallOption) (*GetFeeReportResponse, error) {
+	out := new(GetFeeReportResponse)
+	err := c.cc.Invoke(ctx, "/boltzrpc.Boltz/GetFeeReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boltzClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error) {
 	out := new(DepositResponse)
 	err := c.cc.Invoke(ctx, "/boltzrpc.Boltz/Deposit", in, out, opts...)
@@ -129,24 +212,173 @@ func (c *boltzClient) CreateReverseSwap(ctx context.Context, in *CreateReverseSw
 	return out, nil
 }
 
+func (c *boltzClient) RefundSwap(ctx context.Context, in *RefundSwapRequest, opts ...grpc.CallOption) (*RefundSwapResponse, error) {
+	out := new(RefundSwapResponse)
+	err := c.cc.Invoke(ctx, "/boltzrpc.Boltz/RefundSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
+	out := new(BumpFeeResponse)
+	err := c.cc.Invoke(ctx, "/boltzrpc.Boltz/BumpFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) SubscribeSwapEvents(ctx context.Context, in *SubscribeSwapEventsRequest, opts ...grpc.CallOption) (Boltz_SubscribeSwapEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Boltz_serviceDesc.Streams[0], "/boltzrpc.Boltz/SubscribeSwapEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &boltzSubscribeSwapEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Boltz_SubscribeSwapEventsClient interface {
+	Recv() (*SwapEvent, error)
+	grpc.ClientStream
+}
+
+type boltzSubscribeSwapEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *boltzSubscribeSwapEventsClient) Recv() (*SwapEvent, error) {
+	m := new(SwapEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *boltzClient) GetAutoSwapConfig(ctx context.Context, in *GetAutoSwapConfigRequest, opts ...grpc.CallOption) (*GetAutoSwapConfigResponse, error) {
+	out := new(GetAutoSwapConfigResponse)
+	err := c.cc.Invoke(ctx, "/boltzrpc.Boltz/GetAutoSwapConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) SetAutoSwapConfig(ctx context.Context, in *SetAutoSwapConfigRequest, opts ...grpc.CallOption) (*SetAutoSwapConfigResponse, error) {
+	out := new(SetAutoSwapConfigResponse)
+	err := c.cc.Invoke(ctx, "/boltzrpc.Boltz/SetAutoSwapConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) GetAutoSwapRecommendations(ctx context.Context, in *GetAutoSwapRecommendationsRequest, opts ...grpc.CallOption) (*GetAutoSwapRecommendationsResponse, error) {
+	out := new(GetAutoSwapRecommendationsResponse)
+	err := c.cc.Invoke(ctx, "/boltzrpc.Boltz/GetAutoSwapRecommendations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
+	out := new(UnlockResponse)
+	err := c.cc.Invoke(ctx, "/boltzrpc.Boltz/Unlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) SetPassphrase(ctx context.Context, in *SetPassphraseRequest, opts ...grpc.CallOption) (*SetPassphraseResponse, error) {
+	out := new(SetPassphraseResponse)
+	err := c.cc.Invoke(ctx, "/boltzrpc.Boltz/SetPassphrase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) RecoverSwaps(ctx context.Context, in *RecoverSwapsRequest, opts ...grpc.CallOption) (*RecoverSwapsResponse, error) {
+	out := new(RecoverSwapsResponse)
+	err := c.cc.Invoke(ctx, "/boltzrpc.Boltz/RecoverSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) ExportRescueFile(ctx context.Context, in *ExportRescueFileRequest, opts ...grpc.CallOption) (*ExportRescueFileResponse, error) {
+	out := new(ExportRescueFileResponse)
+	err := c.cc.Invoke(ctx, "/boltzrpc.Boltz/ExportRescueFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) ExportSeed(ctx context.Context, in *ExportSeedRequest, opts ...grpc.CallOption) (*ExportSeedResponse, error) {
+	out := new(ExportSeedResponse)
+	err := c.cc.Invoke(ctx, "/boltzrpc.Boltz/ExportSeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) ImportSwaps(ctx context.Context, in *ImportSwapsRequest, opts ...grpc.CallOption) (*ImportSwapsResponse, error) {
+	out := new(ImportSwapsResponse)
+	err := c.cc.Invoke(ctx, "/boltzrpc.Boltz/ImportSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) ListDanglingChannels(ctx context.Context, in *ListDanglingChannelsRequest, opts ...grpc.CallOption) (*ListDanglingChannelsResponse, error) {
+	out := new(ListDanglingChannelsResponse)
+	err := c.cc.Invoke(ctx, "/boltzrpc.Boltz/ListDanglingChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BoltzServer is the server API for Boltz service.
 // All implementations must embed UnimplementedBoltzServer
 // for forward compatibility
 type BoltzServer interface {
 	//
-	//Gets general information about the daemon like the chain of the LND node it is connected to
-	//and the IDs of pending swaps.
+	//Gets general information about the daemon like the chains of the LND nodes it is connected to
+	//and the IDs of pending swaps. The swaps can be filtered by the node they were created with.
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
 	//
 	//Fetches the latest limits and fees from the Boltz backend API it is connected to.
 	GetServiceInfo(context.Context, *GetServiceInfoRequest) (*GetServiceInfoResponse, error)
 	//
-	//Returns a list of all swaps, reverse swaps and channel creations in the database.
+	//Calculates the fees and the exact amounts that are sent and received for a swap or reverse swap of an amount
+	//without creating it.
+	GetQuote(context.Context, *GetQuoteRequest) (*GetQuoteResponse, error)
+	//
+	//Returns a list of all swaps, reverse swaps and channel creations in the database. They can be filtered by the node
+	//they were created with.
 	ListSwaps(context.Context, *ListSwapsRequest) (*ListSwapsResponse, error)
 	//
 	//Gets all available information about a swap from the database.
 	GetSwapInfo(context.Context, *GetSwapInfoRequest) (*GetSwapInfoResponse, error)
 	//
+	//Returns what the swaps that were created in a time range cost. The amounts and fees are recorded in a ledger when
+	//the swaps are created and when their claim, refund and Lightning payments are sent.
+	GetFeeReport(context.Context, *GetFeeReportRequest) (*GetFeeReportResponse, error)
+	//
 	//This is a wrapper for channel creation swaps. The daemon only returns the ID, timeout block height and lockup address.
 	//The Boltz backend takes care of the rest. When an amount of onchain coins that is in the limits is sent to the address
 	//before the timeout block height, the daemon creates a new lightning invoice, sends it to the Boltz backend which
@@ -163,6 +395,62 @@ type BoltzServer interface {
 	//Creates a new reverse swap from lightning to onchain. If `accept_zero_conf` is set to true in the request, the daemon
 	//will not wait until the lockup transaction from Boltz is confirmed in a block, but will claim it instantly.
 	CreateReverseSwap(context.Context, *CreateReverseSwapRequest) (*CreateReverseSwapResponse, error)
+	//
+	//Refunds the coins locked up for a swap after its timeout block height was reached. Swaps that have not been
+	//refunded yet are refunded automatically by the daemon, but this call allows to choose the refund address
+	//and fee rate or to retry failed refunds.
+	RefundSwap(context.Context, *RefundSwapRequest) (*RefundSwapResponse, error)
+	//
+	//Replaces an unconfirmed claim or refund transaction with one that pays a higher fee. The daemon bumps the fees
+	//of transactions that are unconfirmed for too long automatically, but this call allows to choose the fee rate.
+	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
+	//
+	//Streams an event every time the status or state of a swap, reverse swap or channel creation changes.
+	//Events can be filtered by the ID and the type of the swap.
+	SubscribeSwapEvents(*SubscribeSwapEventsRequest, Boltz_SubscribeSwapEventsServer) error
+	//
+	//Returns the config of autoswap, the subsystem that creates swaps automatically to rebalance the channels.
+	GetAutoSwapConfig(context.Context, *GetAutoSwapConfigRequest) (*GetAutoSwapConfigResponse, error)
+	//
+	//Replaces the config of autoswap until the daemon is restarted.
+	SetAutoSwapConfig(context.Context, *SetAutoSwapConfigRequest) (*SetAutoSwapConfigResponse, error)
+	//
+	//Returns the swaps autoswap would create with its current config and the reasons why some of them would not be created.
+	GetAutoSwapRecommendations(context.Context, *GetAutoSwapRecommendationsRequest) (*GetAutoSwapRecommendationsResponse, error)
+	//
+	//Unlocks a daemon whose database is encrypted. When encryption is enabled, the daemon starts locked and serves only
+	//this call and SetPassphrase, without Macaroon authentication, until the passphrase is provided. The passphrase is
+	//verified against the one that was set with SetPassphrase.
+	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
+	//
+	//Sets the passphrase of a database that is not encrypted yet, encrypts the existing private keys, preimages and
+	//Macaroon root keys and unlocks the daemon. Because the call is not authenticated, it is only served to clients that
+	//connect from localhost and fails once a passphrase was set.
+	SetPassphrase(context.Context, *SetPassphraseRequest) (*SetPassphraseResponse, error)
+	//
+	//Rebuilds swaps and reverse swaps that were lost with the database from the seed of the daemon. The keys of the
+	//indexes in the range are derived again and matched against the redeem scripts of the swaps, which have to be
+	//provided because the Boltz API cannot return them. Recovered swaps are refunded after their timeout and recovered
+	//reverse swaps are claimed once their lockup transaction confirms. Indexes up to the end of the range are not
+	//used for new swaps afterwards.
+	RecoverSwaps(context.Context, *RecoverSwapsRequest) (*RecoverSwapsResponse, error)
+	//
+	//Returns the private keys, preimages and redeem scripts of the requested swaps and reverse swaps, or of all pending
+	//ones if no IDs are provided. They are what is needed to refund or claim the swaps without the database of the daemon.
+	ExportRescueFile(context.Context, *ExportRescueFileRequest) (*ExportRescueFileResponse, error)
+	//
+	//Returns the mnemonic of the seed from which the keys and preimages of new swaps are derived. It is needed to recover
+	//swaps with RecoverSwaps if the database is lost. When the database is encrypted, the mnemonic of a new seed is not
+	//written to the mnemonic file and this call is the only way to back it up.
+	ExportSeed(context.Context, *ExportSeedRequest) (*ExportSeedResponse, error)
+	//
+	//Imports swaps and reverse swaps of a rescue file into the database, so that the daemon refunds them after their
+	//timeout or claims them once their lockup transaction confirms. Refund files of the Boltz web app can be imported too.
+	ImportSwaps(context.Context, *ImportSwapsRequest) (*ImportSwapsResponse, error)
+	//
+	//Returns the channels Boltz opened for channel creations that failed afterwards. The daemon closes them
+	//cooperatively, or forcefully if Boltz does not cooperate, once the configured grace period is over.
+	ListDanglingChannels(context.Context, *ListDanglingChannelsRequest) (*ListDanglingChannelsResponse, error)
 	mustEmbedUnimplementedBoltzServer()
 }
 
@@ -176,12 +464,18 @@ func (UnimplementedBoltzServer) GetInfo(context.Context, *GetInfoRequest) (*GetI
 func (UnimplementedBoltzServer) GetServiceInfo(context.Context, *GetServiceInfoRequest) (*GetServiceInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetServiceInfo not implemented")
 }
+func (UnimplementedBoltzServer) GetQuote(context.Context, *GetQuoteRequest) (*GetQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuote not implemented")
+}
 func (UnimplementedBoltzServer) ListSwaps(context.Context, *ListSwapsRequest) (*ListSwapsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSwaps not implemented")
 }
 func (UnimplementedBoltzServer) GetSwapInfo(context.Context, *GetSwapInfoRequest) (*GetSwapInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSwapInfo not implemented")
 }
+func (UnimplementedBoltzServer) GetFeeReport(context.Context, *GetFeeReportRequest) (*GetFeeReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeeReport not implemented")
+}
 func (UnimplementedBoltzServer) Deposit(context.Context, *DepositRequest) (*DepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
@@ -194,6 +488,45 @@ func (UnimplementedBoltzServer) CreateChannel(context.Context, *CreateChannelReq
 func (UnimplementedBoltzServer) CreateReverseSwap(context.Context, *CreateReverseSwapRequest) (*CreateReverseSwapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateReverseSwap not implemented")
 }
+func (UnimplementedBoltzServer) RefundSwap(context.Context, *RefundSwapRequest) (*RefundSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundSwap not implemented")
+}
+func (UnimplementedBoltzServer) BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpFee not implemented")
+}
+func (UnimplementedBoltzServer) SubscribeSwapEvents(*SubscribeSwapEventsRequest, Boltz_SubscribeSwapEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeSwapEvents not implemented")
+}
+func (UnimplementedBoltzServer) GetAutoSwapConfig(context.Context, *GetAutoSwapConfigRequest) (*GetAutoSwapConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAutoSwapConfig not implemented")
+}
+func (UnimplementedBoltzServer) SetAutoSwapConfig(context.Context, *SetAutoSwapConfigRequest) (*SetAutoSwapConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoSwapConfig not implemented")
+}
+func (UnimplementedBoltzServer) GetAutoSwapRecommendations(context.Context, *GetAutoSwapRecommendationsRequest) (*GetAutoSwapRecommendationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAutoSwapRecommendations not implemented")
+}
+func (UnimplementedBoltzServer) Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (UnimplementedBoltzServer) SetPassphrase(context.Context, *SetPassphraseRequest) (*SetPassphraseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPassphrase not implemented")
+}
+func (UnimplementedBoltzServer) RecoverSwaps(context.Context, *RecoverSwapsRequest) (*RecoverSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverSwaps not implemented")
+}
+func (UnimplementedBoltzServer) ExportRescueFile(context.Context, *ExportRescueFileRequest) (*ExportRescueFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportRescueFile not implemented")
+}
+func (UnimplementedBoltzServer) ExportSeed(context.Context, *ExportSeedRequest) (*ExportSeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSeed not implemented")
+}
+func (UnimplementedBoltzServer) ImportSwaps(context.Context, *ImportSwapsRequest) (*ImportSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSwaps not implemented")
+}
+func (UnimplementedBoltzServer) ListDanglingChannels(context.Context, *ListDanglingChannelsRequest) (*ListDanglingChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDanglingChannels not implemented")
+}
 func (UnimplementedBoltzServer) mustEmbedUnimplementedBoltzServer() {}
 
 // UnsafeBoltzServer may be embedded to opt out of forward compatibility for this service.
@@ -243,6 +576,24 @@ func _Boltz_GetServiceInfo_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Boltz_GetQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).GetQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boltzrpc.Boltz/GetQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).GetQuote(ctx, req.(*GetQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Boltz_ListSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSwapsRequest)
 	if err := dec(in); err != nil {
@@ -279,6 +630,24 @@ func _Boltz_GetSwapInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Boltz_GetFeeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeeReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).GetFeeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boltzrpc.Boltz/GetFeeReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).GetFeeReport(ctx, req.(*GetFeeReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Boltz_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DepositRequest)
 	if err := dec(in); err != nil {
@@ -351,6 +720,243 @@ func _Boltz_CreateReverseSwap_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Boltz_RefundSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).RefundSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boltzrpc.Boltz/RefundSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).RefundSwap(ctx, req.(*RefundSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).BumpFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boltzrpc.Boltz/BumpFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).BumpFee(ctx, req.(*BumpFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_SubscribeSwapEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeSwapEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BoltzServer).SubscribeSwapEvents(m, &boltzSubscribeSwapEventsServer{stream})
+}
+
+type Boltz_SubscribeSwapEventsServer interface {
+	Send(*SwapEvent) error
+	grpc.ServerStream
+}
+
+type boltzSubscribeSwapEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *boltzSubscribeSwapEventsServer) Send(m *SwapEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Boltz_GetAutoSwapConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAutoSwapConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).GetAutoSwapConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boltzrpc.Boltz/GetAutoSwapConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).GetAutoSwapConfig(ctx, req.(*GetAutoSwapConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_SetAutoSwapConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAutoSwapConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).SetAutoSwapConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boltzrpc.Boltz/SetAutoSwapConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).SetAutoSwapConfig(ctx, req.(*SetAutoSwapConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_GetAutoSwapRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAutoSwapRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).GetAutoSwapRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boltzrpc.Boltz/GetAutoSwapRecommendations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).GetAutoSwapRecommendations(ctx, req.(*GetAutoSwapRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boltzrpc.Boltz/Unlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).Unlock(ctx, req.(*UnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_SetPassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPassphraseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).SetPassphrase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boltzrpc.Boltz/SetPassphrase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).SetPassphrase(ctx, req.(*SetPassphraseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_RecoverSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverSwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).RecoverSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boltzrpc.Boltz/RecoverSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).RecoverSwaps(ctx, req.(*RecoverSwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_ExportRescueFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRescueFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).ExportRescueFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boltzrpc.Boltz/ExportRescueFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).ExportRescueFile(ctx, req.(*ExportRescueFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_ExportSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).ExportSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boltzrpc.Boltz/ExportSeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).ExportSeed(ctx, req.(*ExportSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_ImportSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).ImportSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boltzrpc.Boltz/ImportSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).ImportSwaps(ctx, req.(*ImportSwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_ListDanglingChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDanglingChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).ListDanglingChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boltzrpc.Boltz/ListDanglingChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).ListDanglingChannels(ctx, req.(*ListDanglingChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Boltz_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "boltzrpc.Boltz",
 	HandlerType: (*BoltzServer)(nil),
@@ -363,6 +969,10 @@ var _Boltz_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetServiceInfo",
 			Handler:    _Boltz_GetServiceInfo_Handler,
 		},
+		{
+			MethodName: "GetQuote",
+			Handler:    _Boltz_GetQuote_Handler,
+		},
 		{
 			MethodName: "ListSwaps",
 			Handler:    _Boltz_ListSwaps_Handler,
@@ -371,6 +981,10 @@ var _Boltz_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSwapInfo",
 			Handler:    _Boltz_GetSwapInfo_Handler,
 		},
+		{
+			MethodName: "GetFeeReport",
+			Handler:    _Boltz_GetFeeReport_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _Boltz_Deposit_Handler,
@@ -387,7 +1001,61 @@ var _Boltz_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateReverseSwap",
 			Handler:    _Boltz_CreateReverseSwap_Handler,
 		},
+		{
+			MethodName: "RefundSwap",
+			Handler:    _Boltz_RefundSwap_Handler,
+		},
+		{
+			MethodName: "BumpFee",
+			Handler:    _Boltz_BumpFee_Handler,
+		},
+		{
+			MethodName: "GetAutoSwapConfig",
+			Handler:    _Boltz_GetAutoSwapConfig_Handler,
+		},
+		{
+			MethodName: "SetAutoSwapConfig",
+			Handler:    _Boltz_SetAutoSwapConfig_Handler,
+		},
+		{
+			MethodName: "GetAutoSwapRecommendations",
+			Handler:    _Boltz_GetAutoSwapRecommendations_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _Boltz_Unlock_Handler,
+		},
+		{
+			MethodName: "SetPassphrase",
+			Handler:    _Boltz_SetPassphrase_Handler,
+		},
+		{
+			MethodName: "RecoverSwaps",
+			Handler:    _Boltz_RecoverSwaps_Handler,
+		},
+		{
+			MethodName: "ExportRescueFile",
+			Handler:    _Boltz_ExportRescueFile_Handler,
+		},
+		{
+			MethodName: "ExportSeed",
+			Handler:    _Boltz_ExportSeed_Handler,
+		},
+		{
+			MethodName: "ImportSwaps",
+			Handler:    _Boltz_ImportSwaps_Handler,
+		},
+		{
+			MethodName: "ListDanglingChannels",
+			Handler:    _Boltz_ListDanglingChannels_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeSwapEvents",
+			Handler:       _Boltz_SubscribeSwapEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "boltzrpc.proto",
 }
@@ -0,0 +1,109 @@
+package chain
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/logger"
+)
+
+// Backend abstracts the interactions with the chain so that swaps can be refunded and claimed without having to trust
+// the Boltz API for chain data
+type Backend interface {
+	// GetTransaction returns the raw transaction in hex
+	GetTransaction(transactionId string) (string, error)
+
+	// BroadcastTransaction broadcasts a raw transaction and returns its id
+	BroadcastTransaction(transactionHex string) (string, error)
+
+	// IsSpent checks whether an outpoint was spent already
+	IsSpent(transactionId string, vout uint32) (bool, error)
+
+	// IsConfirmed checks whether a transaction was included in a block
+	IsConfirmed(transactionId string) (bool, error)
+
+	// EstimateFee returns the fee in satoshis per vbyte needed for a transaction to confirm within the target
+	EstimateFee(confTarget int32) (float64, error)
+
+	// GetBlockHeight returns the height of the best block of the chain
+	GetBlockHeight() (uint32, error)
+
+	// CanQueryChain returns whether IsSpent, IsConfirmed and GetBlockHeight are supported. Features that depend on
+	// them have to check this when they are started
+	CanQueryChain() bool
+
+	Name() string
+}
+
+type Config struct {
+	Backend string `long:"chain.backend" description:"Backend used to query and broadcast transactions. Options: boltz, bitcoind, electrum, esplora"`
+
+	BitcoindHost     string `long:"chain.bitcoind.host" description:"Host of the bitcoind JSON-RPC interface"`
+	BitcoindPort     int    `long:"chain.bitcoind.port" description:"Port of the bitcoind JSON-RPC interface"`
+	BitcoindUser     string `long:"chain.bitcoind.user" description:"User of the bitcoind JSON-RPC interface"`
+	BitcoindPassword string `long:"chain.bitcoind.password" description:"Password of the bitcoind JSON-RPC interface" json:"-"`
+
+	ElectrumServer string `long:"chain.electrum.server" description:"Host and port of the Electrum server"`
+	ElectrumTls    bool   `long:"chain.electrum.tls" description:"Whether TLS should be used for the connection to the Electrum server"`
+
+	EsploraUrl string `long:"chain.esplora.url" description:"URL of the Esplora REST API"`
+}
+
+// Requests to backends with an HTTP API must not block the nursery forever
+var httpClient = &http.Client{
+	Timeout: 30 * time.Second,
+}
+
+const (
+	BoltzBackend    = "boltz"
+	BitcoindBackend = "bitcoind"
+	ElectrumBackend = "electrum"
+	EsploraBackend  = "esplora"
+)
+
+func (config *Config) Init(boltzApi *boltz.Boltz) (Backend, error) {
+	var backend Backend
+
+	switch config.Backend {
+	case BoltzBackend, "":
+		backend = &Boltz{
+			boltz: boltzApi,
+		}
+
+	case BitcoindBackend:
+		backend = &Bitcoind{
+			Host:     config.BitcoindHost,
+			Port:     config.BitcoindPort,
+			User:     config.BitcoindUser,
+			Password: config.BitcoindPassword,
+		}
+
+	case ElectrumBackend:
+		if config.ElectrumServer == "" {
+			return nil, errors.New("no Electrum server configured")
+		}
+
+		backend = &Electrum{
+			Server: config.ElectrumServer,
+			Tls:    config.ElectrumTls,
+		}
+
+	case EsploraBackend:
+		if config.EsploraUrl == "" {
+			return nil, errors.New("no Esplora URL configured")
+		}
+
+		backend = &Esplora{
+			URL: config.EsploraUrl,
+		}
+
+	default:
+		return nil, errors.New("unknown chain backend: " + config.Backend)
+	}
+
+	logger.Info("Using chain backend: " + backend.Name())
+
+	return backend, nil
+}
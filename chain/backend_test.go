@@ -0,0 +1,237 @@
+package chain
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/assert"
+)
+
+const transactionId = "2f3bd2c5e2f9c0d79b3d4b5b6e8ef3f8f5e1a0b4c9d8e7f6a5b4c3d2e1f0a9b8"
+
+func TestConfigInit(t *testing.T) {
+	backend, err := (&Config{Backend: ""}).Init(nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Boltz API", backend.Name())
+	assert.False(t, backend.CanQueryChain())
+
+	backend, err = (&Config{Backend: EsploraBackend, EsploraUrl: "http://127.0.0.1"}).Init(nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Esplora", backend.Name())
+	assert.True(t, backend.CanQueryChain())
+
+	_, err = (&Config{Backend: ElectrumBackend}).Init(nil)
+	assert.Equal(t, "no Electrum server configured", err.Error())
+
+	_, err = (&Config{Backend: "notabackend"}).Init(nil)
+	assert.Equal(t, "unknown chain backend: notabackend", err.Error())
+}
+
+func TestConfigInitCurrency(t *testing.T) {
+	currency, err := (&Config{Backend: ""}).InitCurrency("LTC", &chaincfg.RegressionNetParams)
+
+	assert.Nil(t, err)
+	assert.Nil(t, currency)
+
+	_, err = (&Config{Backend: BoltzBackend}).InitCurrency("LTC", &chaincfg.RegressionNetParams)
+	assert.Equal(t, "the Boltz API cannot be used as chain backend of LTC", err.Error())
+
+	currency, err = (&Config{Backend: EsploraBackend, EsploraUrl: "http://127.0.0.1"}).InitCurrency("LTC", &chaincfg.RegressionNetParams)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "LTC", currency.Symbol)
+	assert.Equal(t, &chaincfg.RegressionNetParams, currency.Params)
+	assert.Equal(t, "Esplora", currency.Backend.Name())
+}
+
+func TestEsplora(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.URL.Path {
+		case "/tx/" + transactionId + "/hex":
+			_, _ = writer.Write([]byte("00"))
+
+		case "/tx":
+			body, _ := ioutil.ReadAll(request.Body)
+			assert.Equal(t, "00", string(body))
+
+			_, _ = writer.Write([]byte(transactionId))
+
+		case "/tx/" + transactionId + "/outspend/1":
+			_, _ = writer.Write([]byte("{\"spent\":true}"))
+
+		case "/tx/" + transactionId + "/status":
+			_, _ = writer.Write([]byte("{\"confirmed\":true,\"block_height\":100}"))
+
+		case "/fee-estimates":
+			_, _ = writer.Write([]byte("{\"1\":20.5,\"2\":12.1,\"6\":5.3}"))
+
+		case "/blocks/tip/height":
+			_, _ = writer.Write([]byte("671234"))
+
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	esplora := &Esplora{URL: server.URL + "/"}
+
+	transactionHex, err := esplora.GetTransaction(transactionId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "00", transactionHex)
+
+	broadcastId, err := esplora.BroadcastTransaction("00")
+
+	assert.Nil(t, err)
+	assert.Equal(t, transactionId, broadcastId)
+
+	isSpent, err := esplora.IsSpent(transactionId, 1)
+
+	assert.Nil(t, err)
+	assert.True(t, isSpent)
+
+	isConfirmed, err := esplora.IsConfirmed(transactionId)
+
+	assert.Nil(t, err)
+	assert.True(t, isConfirmed)
+
+	fee, err := esplora.EstimateFee(2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 12.1, fee)
+
+	fee, err = esplora.EstimateFee(4)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 12.1, fee)
+
+	blockHeight, err := esplora.GetBlockHeight()
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(671234), blockHeight)
+
+	_, err = esplora.IsSpent(transactionId, 0)
+	assert.NotNil(t, err)
+}
+
+func TestConfigJson(t *testing.T) {
+	formattedConfig, err := json.Marshal(&Config{
+		Backend:          BitcoindBackend,
+		BitcoindPassword: "secret",
+	})
+
+	assert.Nil(t, err)
+	assert.NotContains(t, string(formattedConfig), "secret")
+}
+
+func TestHttpTimeout(t *testing.T) {
+	stop := make(chan bool)
+
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		<-stop
+	}))
+	defer server.Close()
+	defer close(stop)
+
+	timeout := httpClient.Timeout
+	httpClient.Timeout = 50 * time.Millisecond
+	defer func() {
+		httpClient.Timeout = timeout
+	}()
+
+	esplora := &Esplora{URL: server.URL}
+	_, err := esplora.GetBlockHeight()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Client.Timeout exceeded")
+}
+
+func TestBitcoind(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		user, password, _ := request.BasicAuth()
+
+		assert.Equal(t, "boltz", user)
+		assert.Equal(t, "password", password)
+
+		var rpcRequest bitcoindRequest
+		_ = json.NewDecoder(request.Body).Decode(&rpcRequest)
+
+		switch rpcRequest.Method {
+		case "getrawtransaction":
+			if len(rpcRequest.Params) == 2 {
+				_, _ = writer.Write([]byte("{\"result\":{\"txid\":\"" + transactionId + "\",\"confirmations\":0},\"error\":null}"))
+				break
+			}
+
+			_, _ = writer.Write([]byte("{\"result\":\"00\",\"error\":null}"))
+
+		case "gettxout":
+			_, _ = writer.Write([]byte("{\"result\":null,\"error\":null}"))
+
+		case "estimatesmartfee":
+			_, _ = writer.Write([]byte("{\"result\":{\"feerate\":0.00012,\"blocks\":2},\"error\":null}"))
+
+		case "getblockcount":
+			_, _ = writer.Write([]byte("{\"result\":671234,\"error\":null}"))
+
+		default:
+			_, _ = writer.Write([]byte("{\"result\":null,\"error\":{\"code\":-32601,\"message\":\"Method not found\"}}"))
+		}
+	}))
+	defer server.Close()
+
+	host, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	parsedPort, _ := strconv.Atoi(port)
+
+	bitcoind := &Bitcoind{
+		Host:     host,
+		Port:     parsedPort,
+		User:     "boltz",
+		Password: "password",
+	}
+
+	transactionHex, err := bitcoind.GetTransaction(transactionId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "00", transactionHex)
+
+	isSpent, err := bitcoind.IsSpent(transactionId, 0)
+
+	assert.Nil(t, err)
+	assert.True(t, isSpent)
+
+	isConfirmed, err := bitcoind.IsConfirmed(transactionId)
+
+	assert.Nil(t, err)
+	assert.False(t, isConfirmed)
+
+	fee, err := bitcoind.EstimateFee(2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, float64(12), fee)
+
+	blockHeight, err := bitcoind.GetBlockHeight()
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(671234), blockHeight)
+
+	_, err = bitcoind.BroadcastTransaction("00")
+	assert.Equal(t, "Method not found", err.Error())
+}
+
+func TestScriptHash(t *testing.T) {
+	outputScript, _ := hex.DecodeString("76a91462e907b15cbf27d5425399ebf6f0fb50ebb88f1888ac")
+
+	assert.Equal(t, "8b01df4e368ea28f8dc0423bcf7a4923e3a12d307c875e47a0cfbf90b5c39161", scriptHash(outputScript))
+}
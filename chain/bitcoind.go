@@ -0,0 +1,162 @@
+package chain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strconv"
+)
+
+// Bitcoind talks to the JSON-RPC interface of bitcoind or a compatible daemon like elementsd
+type Bitcoind struct {
+	Host     string
+	Port     int
+	User     string
+	Password string
+}
+
+type bitcoindRequest struct {
+	JsonRpc string        `json:"jsonrpc"`
+	Id      string        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type bitcoindResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+type verboseTransactionResponse struct {
+	Confirmations uint32 `json:"confirmations"`
+}
+
+type estimateSmartFeeResponse struct {
+	FeeRate float64  `json:"feerate"`
+	Errors  []string `json:"errors"`
+}
+
+func (backend *Bitcoind) Name() string {
+	return "bitcoind"
+}
+
+func (backend *Bitcoind) CanQueryChain() bool {
+	return true
+}
+
+func (backend *Bitcoind) GetTransaction(transactionId string) (string, error) {
+	var transactionHex string
+	err := backend.sendRequest("getrawtransaction", []interface{}{transactionId}, &transactionHex)
+
+	return transactionHex, err
+}
+
+func (backend *Bitcoind) BroadcastTransaction(transactionHex string) (string, error) {
+	var transactionId string
+	err := backend.sendRequest("sendrawtransaction", []interface{}{transactionHex}, &transactionId)
+
+	return transactionId, err
+}
+
+func (backend *Bitcoind) IsSpent(transactionId string, vout uint32) (bool, error) {
+	var output *json.RawMessage
+	err := backend.sendRequest("gettxout", []interface{}{transactionId, vout, true}, &output)
+
+	if err != nil {
+		return false, err
+	}
+
+	// "gettxout" returns null for outputs that are spent
+	return output == nil, nil
+}
+
+// IsConfirmed uses the verbose mode of "getrawtransaction" which requires the "txindex" for confirmed transactions that
+// are not in the wallet of the node
+func (backend *Bitcoind) IsConfirmed(transactionId string) (bool, error) {
+	var response verboseTransactionResponse
+	err := backend.sendRequest("getrawtransaction", []interface{}{transactionId, true}, &response)
+
+	if err != nil {
+		return false, err
+	}
+
+	return response.Confirmations > 0, nil
+}
+
+func (backend *Bitcoind) EstimateFee(confTarget int32) (float64, error) {
+	var response estimateSmartFeeResponse
+	err := backend.sendRequest("estimatesmartfee", []interface{}{confTarget}, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(response.Errors) != 0 {
+		return 0, errors.New(response.Errors[0])
+	}
+
+	// Convert from BTC/kvbyte to satoshis per vbyte
+	return math.Round(response.FeeRate * 100000), nil
+}
+
+func (backend *Bitcoind) GetBlockHeight() (uint32, error) {
+	var blockHeight uint32
+	err := backend.sendRequest("getblockcount", []interface{}{}, &blockHeight)
+
+	return blockHeight, err
+}
+
+func (backend *Bitcoind) sendRequest(method string, params []interface{}, result interface{}) error {
+	rawBody, err := json.Marshal(bitcoindRequest{
+		JsonRpc: "1.0",
+		Id:      "boltz-lnd",
+		Method:  method,
+		Params:  params,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest("POST", "http://"+backend.Host+":"+strconv.Itoa(backend.Port), bytes.NewBuffer(rawBody))
+
+	if err != nil {
+		return err
+	}
+
+	request.SetBasicAuth(backend.User, backend.Password)
+	request.Header.Set("Content-Type", "application/json")
+
+	res, err := httpClient.Do(request)
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+
+	if err != nil {
+		return err
+	}
+
+	var response bitcoindResponse
+	err = json.Unmarshal(body, &response)
+
+	if err != nil {
+		return errors.New("could not parse response of bitcoind: " + res.Status)
+	}
+
+	if response.Error != nil {
+		return errors.New(response.Error.Message)
+	}
+
+	return json.Unmarshal(response.Result, result)
+}
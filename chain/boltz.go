@@ -0,0 +1,69 @@
+package chain
+
+import (
+	"errors"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+)
+
+// Boltz uses the Boltz API as chain backend which means that all chain data is trusted from Boltz
+type Boltz struct {
+	boltz *boltz.Boltz
+}
+
+func (backend *Boltz) Name() string {
+	return "Boltz API"
+}
+
+// CanQueryChain is false because the Boltz API only relays transactions and fee estimations
+func (backend *Boltz) CanQueryChain() bool {
+	return false
+}
+
+func (backend *Boltz) GetTransaction(transactionId string) (string, error) {
+	response, err := backend.boltz.GetTransaction(transactionId)
+
+	if err != nil {
+		return "", err
+	}
+
+	return response.TransactionHex, nil
+}
+
+func (backend *Boltz) BroadcastTransaction(transactionHex string) (string, error) {
+	response, err := backend.boltz.BroadcastTransaction(transactionHex)
+
+	if err != nil {
+		return "", err
+	}
+
+	return response.TransactionId, nil
+}
+
+func (backend *Boltz) IsSpent(_ string, _ uint32) (bool, error) {
+	return false, errors.New("the Boltz API does not support querying outpoints")
+}
+
+func (backend *Boltz) IsConfirmed(_ string) (bool, error) {
+	return false, errors.New("the Boltz API does not support querying confirmations")
+}
+
+func (backend *Boltz) EstimateFee(_ int32) (float64, error) {
+	feeEstimations, err := backend.boltz.GetFeeEstimation()
+
+	if err != nil {
+		return 0, err
+	}
+
+	feeEstimation, hasEstimation := (*feeEstimations)[backend.boltz.Symbol()]
+
+	if !hasEstimation {
+		return 0, errors.New("Boltz API has no fee estimation for " + backend.boltz.Symbol())
+	}
+
+	return feeEstimation, nil
+}
+
+func (backend *Boltz) GetBlockHeight() (uint32, error) {
+	return 0, errors.New("the Boltz API does not support querying the block height")
+}
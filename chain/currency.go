@@ -0,0 +1,55 @@
+package chain
+
+import (
+	"errors"
+
+	"github.com/BoltzExchange/boltz-lnd/utils"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/vulpemventures/go-elements/network"
+)
+
+// Currency is an onchain currency of swaps with the params and backend of its chain
+type Currency struct {
+	Symbol  string
+	Params  *chaincfg.Params
+	Backend Backend
+
+	// Only set for L-BTC, whose transactions and confidential addresses have to be handled with the Liquid network
+	LiquidNetwork *network.Network
+}
+
+// InitCurrency initializes the backend of a currency that is not on the chain of the LND node and returns nil when no
+// backend is configured. The Boltz API cannot be used for those currencies because it does not provide block heights
+func (config *Config) InitCurrency(symbol string, params *chaincfg.Params) (*Currency, error) {
+	if config.Backend == "" {
+		return nil, nil
+	}
+
+	if config.Backend == BoltzBackend {
+		return nil, errors.New("the Boltz API cannot be used as chain backend of " + symbol)
+	}
+
+	var liquidNetwork *network.Network
+
+	if symbol == utils.LiquidSymbol {
+		// Electrum servers decode the transactions of the outpoints they are asked about
+		if config.Backend == ElectrumBackend {
+			return nil, errors.New("Electrum cannot be used as chain backend of " + symbol)
+		}
+
+		liquidNetwork = utils.GetLiquidNetwork(params)
+	}
+
+	backend, err := config.Init(nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Currency{
+		Symbol:        symbol,
+		Params:        params,
+		Backend:       backend,
+		LiquidNetwork: liquidNetwork,
+	}, nil
+}
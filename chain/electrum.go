@@ -0,0 +1,269 @@
+package chain
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math"
+	"net"
+
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+const electrumTimeout = 30 * time.Second
+
+// Electrum talks the Electrum protocol with a server like electrs or ElectrumX
+type Electrum struct {
+	Server string
+	Tls    bool
+
+	requestId     uint64
+	requestIdLock sync.Mutex
+}
+
+type electrumRequest struct {
+	Id     uint64        `json:"id"`
+	Method string        `json:"method"`
+	Params []interface{} `json:"params"`
+}
+
+type electrumResponse struct {
+	Id     uint64          `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+type electrumUnspent struct {
+	TransactionHash string `json:"tx_hash"`
+	Vout            uint32 `json:"tx_pos"`
+}
+
+type electrumHeader struct {
+	Height uint32 `json:"height"`
+}
+
+type electrumHistory struct {
+	TransactionHash string `json:"tx_hash"`
+	// Zero or negative for transactions in the mempool
+	Height int64 `json:"height"`
+}
+
+func (backend *Electrum) Name() string {
+	return "Electrum"
+}
+
+func (backend *Electrum) CanQueryChain() bool {
+	return true
+}
+
+func (backend *Electrum) GetTransaction(transactionId string) (string, error) {
+	var transactionHex string
+	err := backend.sendRequest("blockchain.transaction.get", []interface{}{transactionId}, &transactionHex)
+
+	return transactionHex, err
+}
+
+func (backend *Electrum) BroadcastTransaction(transactionHex string) (string, error) {
+	var transactionId string
+	err := backend.sendRequest("blockchain.transaction.broadcast", []interface{}{transactionHex}, &transactionId)
+
+	return transactionId, err
+}
+
+// IsSpent checks whether the outpoint is still in the list of unspent outputs of its output script. Only transactions
+// that can be decoded as Bitcoin transactions are supported
+func (backend *Electrum) IsSpent(transactionId string, vout uint32) (bool, error) {
+	outputScript, err := backend.getOutputScript(transactionId, vout)
+
+	if err != nil {
+		return false, err
+	}
+
+	var unspentOutputs []electrumUnspent
+	err = backend.sendRequest(
+		"blockchain.scripthash.listunspent",
+		[]interface{}{scriptHash(outputScript)},
+		&unspentOutputs,
+	)
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, unspentOutput := range unspentOutputs {
+		if unspentOutput.TransactionHash == transactionId && unspentOutput.Vout == vout {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// IsConfirmed looks for the transaction in the history of the output script of its first output. Only transactions
+// that can be decoded as Bitcoin transactions are supported
+func (backend *Electrum) IsConfirmed(transactionId string) (bool, error) {
+	outputScript, err := backend.getOutputScript(transactionId, 0)
+
+	if err != nil {
+		return false, err
+	}
+
+	var history []electrumHistory
+	err = backend.sendRequest(
+		"blockchain.scripthash.get_history",
+		[]interface{}{scriptHash(outputScript)},
+		&history,
+	)
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, entry := range history {
+		if entry.TransactionHash == transactionId {
+			return entry.Height > 0, nil
+		}
+	}
+
+	return false, errors.New("could not find transaction " + transactionId)
+}
+
+func (backend *Electrum) EstimateFee(confTarget int32) (float64, error) {
+	var feeRate float64
+	err := backend.sendRequest("blockchain.estimatefee", []interface{}{confTarget}, &feeRate)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if feeRate < 0 {
+		return 0, errors.New("Electrum server could not estimate fee")
+	}
+
+	// Convert from BTC/kvbyte to satoshis per vbyte
+	return math.Round(feeRate * 100000), nil
+}
+
+func (backend *Electrum) GetBlockHeight() (uint32, error) {
+	var header electrumHeader
+	err := backend.sendRequest("blockchain.headers.subscribe", []interface{}{}, &header)
+
+	return header.Height, err
+}
+
+func (backend *Electrum) getOutputScript(transactionId string, vout uint32) ([]byte, error) {
+	transactionHex, err := backend.GetTransaction(transactionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rawTransaction, err := hex.DecodeString(transactionHex)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var transaction wire.MsgTx
+	err = transaction.Deserialize(bytes.NewReader(rawTransaction))
+
+	if err != nil {
+		return nil, errors.New("could not decode transaction: " + err.Error())
+	}
+
+	if int(vout) >= len(transaction.TxOut) {
+		return nil, errors.New("transaction has no output " + transactionId)
+	}
+
+	return transaction.TxOut[vout].PkScript, nil
+}
+
+func (backend *Electrum) sendRequest(method string, params []interface{}, result interface{}) error {
+	connection, err := backend.connect()
+
+	if err != nil {
+		return err
+	}
+
+	defer connection.Close()
+
+	err = connection.SetDeadline(time.Now().Add(electrumTimeout))
+
+	if err != nil {
+		return err
+	}
+
+	backend.requestIdLock.Lock()
+	backend.requestId += 1
+	requestId := backend.requestId
+	backend.requestIdLock.Unlock()
+
+	rawRequest, err := json.Marshal(electrumRequest{
+		Id:     requestId,
+		Method: method,
+		Params: params,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// Requests and responses of the Electrum protocol are delimited by new lines
+	_, err = connection.Write(append(rawRequest, '\n'))
+
+	if err != nil {
+		return err
+	}
+
+	rawResponse, err := bufio.NewReader(connection).ReadBytes('\n')
+
+	if err != nil {
+		return err
+	}
+
+	var response electrumResponse
+	err = json.Unmarshal(rawResponse, &response)
+
+	if err != nil {
+		return err
+	}
+
+	if response.Error != nil {
+		return errors.New(response.Error.Message)
+	}
+
+	return json.Unmarshal(response.Result, result)
+}
+
+func (backend *Electrum) connect() (net.Conn, error) {
+	dialer := &net.Dialer{
+		Timeout: electrumTimeout,
+	}
+
+	if backend.Tls {
+		return tls.DialWithDialer(dialer, "tcp", backend.Server, &tls.Config{})
+	}
+
+	return dialer.Dial("tcp", backend.Server)
+}
+
+// Electrum servers index outputs by the reversed SHA256 hash of their output script
+func scriptHash(outputScript []byte) string {
+	hash := sha256.Sum256(outputScript)
+
+	for i, j := 0, len(hash)-1; i < j; i, j = i+1, j-1 {
+		hash[i], hash[j] = hash[j], hash[i]
+	}
+
+	return hex.EncodeToString(hash[:])
+}
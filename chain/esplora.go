@@ -0,0 +1,143 @@
+package chain
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Esplora uses the REST API of an Esplora instance like the one of blockstream.info
+type Esplora struct {
+	URL string
+}
+
+type esploraOutspend struct {
+	Spent bool `json:"spent"`
+}
+
+type esploraTransactionStatus struct {
+	Confirmed bool `json:"confirmed"`
+}
+
+func (backend *Esplora) Name() string {
+	return "Esplora"
+}
+
+func (backend *Esplora) CanQueryChain() bool {
+	return true
+}
+
+func (backend *Esplora) GetTransaction(transactionId string) (string, error) {
+	return backend.sendRequest("GET", "/tx/"+transactionId+"/hex", "")
+}
+
+func (backend *Esplora) BroadcastTransaction(transactionHex string) (string, error) {
+	return backend.sendRequest("POST", "/tx", transactionHex)
+}
+
+func (backend *Esplora) IsSpent(transactionId string, vout uint32) (bool, error) {
+	response, err := backend.sendRequest("GET", "/tx/"+transactionId+"/outspend/"+strconv.FormatUint(uint64(vout), 10), "")
+
+	if err != nil {
+		return false, err
+	}
+
+	var outspend esploraOutspend
+	err = json.Unmarshal([]byte(response), &outspend)
+
+	return outspend.Spent, err
+}
+
+func (backend *Esplora) IsConfirmed(transactionId string) (bool, error) {
+	response, err := backend.sendRequest("GET", "/tx/"+transactionId+"/status", "")
+
+	if err != nil {
+		return false, err
+	}
+
+	var status esploraTransactionStatus
+	err = json.Unmarshal([]byte(response), &status)
+
+	return status.Confirmed, err
+}
+
+func (backend *Esplora) EstimateFee(confTarget int32) (float64, error) {
+	response, err := backend.sendRequest("GET", "/fee-estimates", "")
+
+	if err != nil {
+		return 0, err
+	}
+
+	var feeEstimates map[string]float64
+	err = json.Unmarshal([]byte(response), &feeEstimates)
+
+	if err != nil {
+		return 0, err
+	}
+
+	// Use the estimation of the closest target that is not slower than the requested one
+	var feeEstimation float64
+	closestTarget := int64(0)
+
+	for target, estimation := range feeEstimates {
+		parsedTarget, err := strconv.ParseInt(target, 10, 32)
+
+		if err != nil || parsedTarget > int64(confTarget) {
+			continue
+		}
+
+		if parsedTarget > closestTarget {
+			closestTarget = parsedTarget
+			feeEstimation = estimation
+		}
+	}
+
+	if closestTarget == 0 {
+		return 0, errors.New("Esplora has no fee estimation for a target of " + strconv.Itoa(int(confTarget)) + " blocks")
+	}
+
+	return feeEstimation, nil
+}
+
+func (backend *Esplora) GetBlockHeight() (uint32, error) {
+	response, err := backend.sendRequest("GET", "/blocks/tip/height", "")
+
+	if err != nil {
+		return 0, err
+	}
+
+	blockHeight, err := strconv.ParseUint(strings.TrimSpace(response), 10, 32)
+
+	return uint32(blockHeight), err
+}
+
+func (backend *Esplora) sendRequest(method string, endpoint string, body string) (string, error) {
+	request, err := http.NewRequest(method, strings.TrimSuffix(backend.URL, "/")+endpoint, strings.NewReader(body))
+
+	if err != nil {
+		return "", err
+	}
+
+	res, err := httpClient.Do(request)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer res.Body.Close()
+
+	response, err := ioutil.ReadAll(res.Body)
+
+	if err != nil {
+		return "", err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return "", errors.New("Esplora returned " + res.Status + ": " + string(response))
+	}
+
+	return string(response), nil
+}
@@ -51,19 +51,37 @@ func main() {
 			Value: "",
 			Usage: "Path to a gRPC Macaroon of Boltz",
 		},
+		cli.StringFlag{
+			Name:  "node",
+			Value: "",
+			Usage: "Name of the LND node with which Swaps are created and to which info and lists are limited",
+		},
 	}
 	app.Commands = []cli.Command{
 		getInfoCommand,
 		getSwapCommand,
 		listSwapsCommand,
+		listDanglingChannelsCommand,
+		quoteCommand,
+		exportCsvCommand,
+		watchCommand,
 
 		depositCommand,
 		withdrawCommand,
+		autoSwapCommand,
 
 		createSwapCommand,
+		refundSwapCommand,
+		bumpFeeCommand,
 		createReverseSwapCommand,
 		createChannelCreationCommand,
+		recoverSwapsCommand,
+		exportRescueFileCommand,
+		exportSeedCommand,
+		importSwapsCommand,
 
+		unlockCommand,
+		setPassphraseCommand,
 		formatMacaroonCommand,
 	}
 
@@ -74,6 +92,15 @@ func main() {
 }
 
 func getClient(ctx *cli.Context) boltz {
+	return newClient(ctx, ctx.GlobalBool("no-macaroons"))
+}
+
+// The daemon serves the unlock and set passphrase calls without Macaroon authentication because its root keys are encrypted
+func getUnlockClient(ctx *cli.Context) boltz {
+	return newClient(ctx, true)
+}
+
+func newClient(ctx *cli.Context, noMacaroons bool) boltz {
 	dataDir := ctx.GlobalString("datadir")
 	macaroonDir := path.Join(dataDir, "macaroons")
 
@@ -89,8 +116,10 @@ func getClient(ctx *cli.Context) boltz {
 
 		TlsCertPath: tlsCert,
 
-		NoMacaroons:  ctx.GlobalBool("no-macaroons"),
+		NoMacaroons:  noMacaroons,
 		MacaroonPath: macaroon,
+
+		Node: ctx.GlobalString("node"),
 	}
 
 	err := boltz.Connect()
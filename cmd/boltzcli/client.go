@@ -22,6 +22,9 @@ type boltz struct {
 	NoMacaroons  bool
 	MacaroonPath string
 
+	// Name of the LND node that is set in the requests. The daemon uses the node of its [LND] section if empty
+	Node string
+
 	ctx    context.Context
 	client boltzrpc.BoltzClient
 }
@@ -60,15 +63,38 @@ func (boltz *boltz) Connect() error {
 }
 
 func (boltz *boltz) GetInfo() (*boltzrpc.GetInfoResponse, error) {
-	return boltz.client.GetInfo(boltz.ctx, &boltzrpc.GetInfoRequest{})
+	return boltz.client.GetInfo(boltz.ctx, &boltzrpc.GetInfoRequest{
+		Node: boltz.Node,
+	})
+}
+
+func (boltz *boltz) GetServiceInfo(pairId string) (*boltzrpc.GetServiceInfoResponse, error) {
+	return boltz.client.GetServiceInfo(boltz.ctx, &boltzrpc.GetServiceInfoRequest{
+		PairId: pairId,
+		Node:   boltz.Node,
+	})
 }
 
-func (boltz *boltz) GetServiceInfo() (*boltzrpc.GetServiceInfoResponse, error) {
-	return boltz.client.GetServiceInfo(boltz.ctx, &boltzrpc.GetServiceInfoRequest{})
+func (boltz *boltz) GetQuote(swapType boltzrpc.SwapType, amount int64, direction boltzrpc.QuoteDirection, pairId string) (*boltzrpc.GetQuoteResponse, error) {
+	return boltz.client.GetQuote(boltz.ctx, &boltzrpc.GetQuoteRequest{
+		Type:      swapType,
+		Amount:    amount,
+		Direction: direction,
+		PairId:    pairId,
+		Node:      boltz.Node,
+	})
 }
 
 func (boltz *boltz) ListSwaps() (*boltzrpc.ListSwapsResponse, error) {
-	return boltz.client.ListSwaps(boltz.ctx, &boltzrpc.ListSwapsRequest{})
+	return boltz.client.ListSwaps(boltz.ctx, &boltzrpc.ListSwapsRequest{
+		Node: boltz.Node,
+	})
+}
+
+func (boltz *boltz) ListDanglingChannels() (*boltzrpc.ListDanglingChannelsResponse, error) {
+	return boltz.client.ListDanglingChannels(boltz.ctx, &boltzrpc.ListDanglingChannelsRequest{
+		Node: boltz.Node,
+	})
 }
 
 func (boltz *boltz) GetSwapInfo(id string) (*boltzrpc.GetSwapInfoResponse, error) {
@@ -77,30 +103,128 @@ func (boltz *boltz) GetSwapInfo(id string) (*boltzrpc.GetSwapInfoResponse, error
 	})
 }
 
-func (boltz *boltz) Deposit(inboundLiquidity uint) (*boltzrpc.DepositResponse, error) {
+func (boltz *boltz) GetFeeReport(from int64, to int64, fiat bool) (*boltzrpc.GetFeeReportResponse, error) {
+	return boltz.client.GetFeeReport(boltz.ctx, &boltzrpc.GetFeeReportRequest{
+		From: from,
+		To:   to,
+		Node: boltz.Node,
+		Fiat: fiat,
+	})
+}
+
+func (boltz *boltz) Deposit(inboundLiquidity uint, funding *boltzrpc.WalletFunding) (*boltzrpc.DepositResponse, error) {
 	return boltz.client.Deposit(boltz.ctx, &boltzrpc.DepositRequest{
 		InboundLiquidity: uint32(inboundLiquidity),
+		FundFromWallet:   funding,
+		Node:             boltz.Node,
 	})
 }
 
-func (boltz *boltz) CreateSwap(amount int64) (*boltzrpc.CreateSwapResponse, error) {
+func (boltz *boltz) CreateSwap(amount int64, refundAddress string, funding *boltzrpc.WalletFunding, pairId string, outputType string, feePolicy *boltzrpc.FeePolicy) (*boltzrpc.CreateSwapResponse, error) {
 	return boltz.client.CreateSwap(boltz.ctx, &boltzrpc.CreateSwapRequest{
-		Amount: amount,
+		Amount:         amount,
+		RefundAddress:  refundAddress,
+		FundFromWallet: funding,
+		PairId:         pairId,
+		Node:           boltz.Node,
+		FeePolicy:      feePolicy,
+		OutputType:     outputType,
 	})
 }
 
-func (boltz *boltz) CreateChannelCreation(amount int64, inboundLiquidity uint32, private bool) (*boltzrpc.CreateSwapResponse, error) {
+func (boltz *boltz) CreateChannelCreation(amount int64, inboundLiquidity uint32, private bool, funding *boltzrpc.WalletFunding, feePolicy *boltzrpc.FeePolicy) (*boltzrpc.CreateSwapResponse, error) {
 	return boltz.client.CreateChannel(boltz.ctx, &boltzrpc.CreateChannelRequest{
 		Amount:           amount,
 		InboundLiquidity: inboundLiquidity,
 		Private:          private,
+		FundFromWallet:   funding,
+		Node:             boltz.Node,
+		FeePolicy:        feePolicy,
 	})
 }
 
-func (boltz *boltz) CreateReverseSwap(amount int64, address string, acceptZeroConf bool) (*boltzrpc.CreateReverseSwapResponse, error) {
+func (boltz *boltz) CreateReverseSwap(amount int64, address string, acceptZeroConf bool, pairId string, channelId uint64, feePolicy *boltzrpc.FeePolicy) (*boltzrpc.CreateReverseSwapResponse, error) {
 	return boltz.client.CreateReverseSwap(boltz.ctx, &boltzrpc.CreateReverseSwapRequest{
 		Address:        address,
 		Amount:         amount,
 		AcceptZeroConf: acceptZeroConf,
+		PairId:         pairId,
+		Node:           boltz.Node,
+		FeePolicy:      feePolicy,
+		ChannelId:      channelId,
+	})
+}
+
+func (boltz *boltz) RefundSwap(id string, address string, satPerVbyte int64) (*boltzrpc.RefundSwapResponse, error) {
+	return boltz.client.RefundSwap(boltz.ctx, &boltzrpc.RefundSwapRequest{
+		Id:          id,
+		Address:     address,
+		SatPerVbyte: satPerVbyte,
+	})
+}
+
+func (boltz *boltz) BumpFee(transactionId string, satPerVbyte int64) (*boltzrpc.BumpFeeResponse, error) {
+	return boltz.client.BumpFee(boltz.ctx, &boltzrpc.BumpFeeRequest{
+		TransactionId: transactionId,
+		SatPerVbyte:   satPerVbyte,
+	})
+}
+
+func (boltz *boltz) SubscribeSwapEvents(id string, types []boltzrpc.SwapType) (boltzrpc.Boltz_SubscribeSwapEventsClient, error) {
+	return boltz.client.SubscribeSwapEvents(boltz.ctx, &boltzrpc.SubscribeSwapEventsRequest{
+		Id:    id,
+		Types: types,
+	})
+}
+
+func (boltz *boltz) GetAutoSwapConfig() (*boltzrpc.GetAutoSwapConfigResponse, error) {
+	return boltz.client.GetAutoSwapConfig(boltz.ctx, &boltzrpc.GetAutoSwapConfigRequest{})
+}
+
+func (boltz *boltz) SetAutoSwapConfig(config *boltzrpc.AutoSwapConfig) (*boltzrpc.SetAutoSwapConfigResponse, error) {
+	return boltz.client.SetAutoSwapConfig(boltz.ctx, &boltzrpc.SetAutoSwapConfigRequest{
+		Config: config,
+	})
+}
+
+func (boltz *boltz) GetAutoSwapRecommendations() (*boltzrpc.GetAutoSwapRecommendationsResponse, error) {
+	return boltz.client.GetAutoSwapRecommendations(boltz.ctx, &boltzrpc.GetAutoSwapRecommendationsRequest{})
+}
+
+func (boltz *boltz) SetPassphrase(passphrase string) (*boltzrpc.SetPassphraseResponse, error) {
+	return boltz.client.SetPassphrase(boltz.ctx, &boltzrpc.SetPassphraseRequest{
+		Passphrase: passphrase,
+	})
+}
+
+func (boltz *boltz) Unlock(passphrase string) (*boltzrpc.UnlockResponse, error) {
+	return boltz.client.Unlock(boltz.ctx, &boltzrpc.UnlockRequest{
+		Passphrase: passphrase,
+	})
+}
+
+func (boltz *boltz) RecoverSwaps(swaps []*boltzrpc.LostSwap, fromIndex uint32, toIndex uint32) (*boltzrpc.RecoverSwapsResponse, error) {
+	return boltz.client.RecoverSwaps(boltz.ctx, &boltzrpc.RecoverSwapsRequest{
+		Swaps:     swaps,
+		FromIndex: fromIndex,
+		ToIndex:   toIndex,
+		Node:      boltz.Node,
+	})
+}
+
+func (boltz *boltz) ExportRescueFile(ids []string) (*boltzrpc.ExportRescueFileResponse, error) {
+	return boltz.client.ExportRescueFile(boltz.ctx, &boltzrpc.ExportRescueFileRequest{
+		Ids: ids,
+	})
+}
+
+func (boltz *boltz) ExportSeed() (*boltzrpc.ExportSeedResponse, error) {
+	return boltz.client.ExportSeed(boltz.ctx, &boltzrpc.ExportSeedRequest{})
+}
+
+func (boltz *boltz) ImportSwaps(swaps []*boltzrpc.RescueSwap) (*boltzrpc.ImportSwapsResponse, error) {
+	return boltz.client.ImportSwaps(boltz.ctx, &boltzrpc.ImportSwapsRequest{
+		Swaps: swaps,
+		Node:  boltz.Node,
 	})
 }
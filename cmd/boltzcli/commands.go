@@ -1,14 +1,22 @@
 package main
 
 import (
+	"encoding/csv"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
 	"github.com/BoltzExchange/boltz-lnd/utils"
 	"github.com/urfave/cli"
+	"google.golang.org/protobuf/encoding/protojson"
+	"io"
 	"io/ioutil"
+	"os"
 	"path"
 	"strconv"
+	"strings"
+	"time"
 )
 
 var getInfoCommand = cli.Command{
@@ -51,6 +59,26 @@ func listSwaps(ctx *cli.Context) error {
 	return nil
 }
 
+var listDanglingChannelsCommand = cli.Command{
+	Name:     "listdangling",
+	Category: "Info",
+	Usage:    "Lists the channels Boltz opened for Channel Creations that failed",
+	Action:   listDanglingChannels,
+}
+
+func listDanglingChannels(ctx *cli.Context) error {
+	client := getClient(ctx)
+	list, err := client.ListDanglingChannels()
+
+	if err != nil {
+		return err
+	}
+
+	printJson(list)
+
+	return nil
+}
+
 var getSwapCommand = cli.Command{
 	Name:     "swapinfo",
 	Category: "Info",
@@ -71,23 +99,263 @@ func swapInfo(ctx *cli.Context) error {
 	return nil
 }
 
+var quoteCommand = cli.Command{
+	Name:      "quote",
+	Category:  "Info",
+	Usage:     "Previews the fees and amounts of a Swap or Reverse Swap",
+	ArgsUsage: "type amount",
+	Description: "Type is either \"submarine\" or \"reverse\". By default the amount is what will be sent: " +
+		"onchain for Swaps and over Lightning for Reverse Swaps",
+	Flags: []cli.Flag{
+		pairFlag,
+		cli.BoolFlag{
+			Name:  "receive",
+			Usage: "Whether the amount is the one that should be received instead of sent",
+		},
+	},
+	Action: quote,
+}
+
+func quote(ctx *cli.Context) error {
+	var swapType boltzrpc.SwapType
+
+	switch strings.ToLower(ctx.Args().First()) {
+	case "submarine":
+		swapType = boltzrpc.SwapType_SUBMARINE
+	case "reverse":
+		swapType = boltzrpc.SwapType_REVERSE_SUBMARINE
+	default:
+		return errors.New("unknown Swap type: " + ctx.Args().First())
+	}
+
+	direction := boltzrpc.QuoteDirection_SEND
+
+	if ctx.Bool("receive") {
+		direction = boltzrpc.QuoteDirection_RECEIVE
+	}
+
+	client := getClient(ctx)
+	response, err := client.GetQuote(
+		swapType,
+		parseInt64(ctx.Args().Get(1), "amount"),
+		direction,
+		ctx.String("pair"),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	printJson(response)
+
+	return nil
+}
+
+var watchCommand = cli.Command{
+	Name:      "watch",
+	Category:  "Info",
+	Usage:     "Prints status and state updates of Swaps as they happen",
+	ArgsUsage: "[id]",
+	Action:    watch,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "type",
+			Usage: "Only print updates of Swaps of this type. Options: submarine, reverse, channel",
+		},
+	},
+}
+
+func watch(ctx *cli.Context) error {
+	var types []boltzrpc.SwapType
+
+	for _, swapType := range ctx.StringSlice("type") {
+		switch strings.ToLower(swapType) {
+		case "submarine":
+			types = append(types, boltzrpc.SwapType_SUBMARINE)
+		case "reverse":
+			types = append(types, boltzrpc.SwapType_REVERSE_SUBMARINE)
+		case "channel":
+			types = append(types, boltzrpc.SwapType_CHANNEL_CREATION)
+		default:
+			return errors.New("unknown Swap type: " + swapType)
+		}
+	}
+
+	client := getClient(ctx)
+	stream, err := client.SubscribeSwapEvents(ctx.Args().First(), types)
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		printJson(event)
+	}
+}
+
+var exportCsvCommand = cli.Command{
+	Name:      "exportcsv",
+	Category:  "Info",
+	Usage:     "Exports the amounts and fees of swaps as CSV",
+	ArgsUsage: "[file]",
+	Description: "Writes one line per swap that was created in the time range to the file or to stdout if no file is set. " +
+		"Onchain amounts and fees are in satoshis of the onchain currency and routing fees in millisatoshis",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "from",
+			Usage: "Date in the format YYYY-MM-DD from which on swaps are exported",
+		},
+		cli.StringFlag{
+			Name:  "to",
+			Usage: "Date in the format YYYY-MM-DD before which swaps are exported",
+		},
+		cli.BoolFlag{
+			Name:  "fiat",
+			Usage: "Whether the fees should be converted to the fiat currency configured in the daemon",
+		},
+	},
+	Action: exportCsv,
+}
+
+func exportCsv(ctx *cli.Context) error {
+	from, err := parseDate(ctx.String("from"))
+
+	if err != nil {
+		return err
+	}
+
+	to, err := parseDate(ctx.String("to"))
+
+	if err != nil {
+		return err
+	}
+
+	client := getClient(ctx)
+	report, err := client.GetFeeReport(from, to, ctx.Bool("fiat"))
+
+	if err != nil {
+		return err
+	}
+
+	output := os.Stdout
+
+	if ctx.Args().First() != "" {
+		output, err = os.Create(ctx.Args().First())
+
+		if err != nil {
+			return err
+		}
+
+		defer output.Close()
+	}
+
+	return writeFeeReportCsv(output, report)
+}
+
+func writeFeeReportCsv(writer io.Writer, report *boltzrpc.GetFeeReportResponse) error {
+	csvWriter := csv.NewWriter(writer)
+
+	header := []string{
+		"id", "type", "state", "node", "created_at", "updated_at", "onchain_currency", "lightning_currency",
+		"amount_sent", "amount_received", "service_fee", "boltz_miner_fee", "miner_fee", "routing_fee_msat",
+	}
+
+	if report.FiatCurrency != "" {
+		header = append(header, "fees_"+strings.ToLower(report.FiatCurrency))
+	}
+
+	err := csvWriter.Write(header)
+
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range report.Entries {
+		record := []string{
+			entry.Id,
+			entry.Type.String(),
+			entry.State.String(),
+			entry.Node,
+			time.Unix(entry.CreatedAt, 0).UTC().Format(time.RFC3339),
+			time.Unix(entry.UpdatedAt, 0).UTC().Format(time.RFC3339),
+			entry.OnchainCurrency,
+			entry.LightningCurrency,
+			strconv.FormatInt(entry.AmountSent, 10),
+			strconv.FormatInt(entry.AmountReceived, 10),
+			strconv.FormatInt(entry.ServiceFee, 10),
+			strconv.FormatInt(entry.BoltzMinerFee, 10),
+			strconv.FormatInt(entry.MinerFee, 10),
+			strconv.FormatInt(entry.RoutingFeeMsat, 10),
+		}
+
+		if report.FiatCurrency != "" {
+			record = append(record, strconv.FormatFloat(entry.FiatFees, 'f', 2, 64))
+		}
+
+		err = csvWriter.Write(record)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+
+	return csvWriter.Error()
+}
+
+// Dates are parsed in UTC. An empty date is 0 which means that the daemon uses its default
+func parseDate(date string) (int64, error) {
+	if date == "" {
+		return 0, nil
+	}
+
+	parsed, err := time.Parse("2006-01-02", date)
+
+	if err != nil {
+		return 0, errors.New("could not parse date " + date + ": " + err.Error())
+	}
+
+	return parsed.Unix(), nil
+}
+
 var depositCommand = cli.Command{
 	Name:     "deposit",
 	Category: "Auto",
 	Usage:    "Deposits into your lightning node",
 	Action:   deposit,
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		cli.UintFlag{
 			Name:  "inbound",
 			Value: 25,
 			Usage: "Amount of inbound liquidity in percent in case a channel gets created for the Swap",
 		},
-	},
+		cli.Int64Flag{
+			Name:  "amount",
+			Usage: "Amount that should be sent from the LND wallet if --fund is set",
+		},
+	}, walletFundingFlags...),
 }
 
 func deposit(ctx *cli.Context) error {
+	funding := parseWalletFunding(ctx)
+
+	if funding != nil {
+		funding.Amount = ctx.Int64("amount")
+	}
+
 	client := getClient(ctx)
-	response, err := client.Deposit(ctx.Uint("inbound"))
+	response, err := client.Deposit(ctx.Uint("inbound"), funding)
 
 	if err != nil {
 		return err
@@ -99,7 +367,7 @@ func deposit(ctx *cli.Context) error {
 		return err
 	}
 
-	serviceInfo, err := client.GetServiceInfo()
+	serviceInfo, err := client.GetServiceInfo("")
 
 	if err != nil {
 		return err
@@ -113,6 +381,12 @@ func deposit(ctx *cli.Context) error {
 	fmt.Println("  - Service fee: " + formatPercentageFee(serviceInfo.Fees.Percentage) + "%")
 	fmt.Println("  - Miner fee: " + strconv.Itoa(int(serviceInfo.Fees.Miner.Normal)) + " " + smallestUnitName)
 	fmt.Println()
+
+	if response.LockupTransactionId != "" {
+		fmt.Println("Funded the deposit from the LND wallet with transaction " + response.LockupTransactionId)
+		return nil
+	}
+
 	fmt.Println(
 		"Please deposit between " + strconv.Itoa(int(serviceInfo.Limits.Minimal)) + " and " + strconv.Itoa(int(serviceInfo.Limits.Maximal)) +
 			" " + smallestUnitName + " into " + response.Address + " in the next ~" + timeoutHours + " hours " +
@@ -148,7 +422,7 @@ func withdraw(ctx *cli.Context) error {
 		return err
 	}
 
-	serviceInfo, err := client.GetServiceInfo()
+	serviceInfo, err := client.GetServiceInfo("")
 
 	if err != nil {
 		return err
@@ -168,7 +442,7 @@ func withdraw(ctx *cli.Context) error {
 
 	fmt.Println("Withdrawing...")
 
-	response, err := client.CreateReverseSwap(amount, address, true)
+	response, err := client.CreateReverseSwap(amount, address, true, "", 0, nil)
 
 	if err != nil {
 		return err
@@ -185,14 +459,26 @@ var createSwapCommand = cli.Command{
 	Name:      "createswap",
 	Category:  "Manual",
 	Usage:     "Creates a new Swap",
-	ArgsUsage: "amount",
-	Action:    createSwap,
+	ArgsUsage: "amount [refund address]",
+	Flags: append(append([]cli.Flag{
+		pairFlag,
+		cli.StringFlag{
+			Name:  "outputtype",
+			Usage: "Output type the lockup address has to have: \"segwit\" or \"compatibility\"",
+		},
+	}, walletFundingFlags...), feePolicyFlags...),
+	Action: createSwap,
 }
 
 func createSwap(ctx *cli.Context) error {
 	client := getClient(ctx)
 	swap, err := client.CreateSwap(
 		parseInt64(ctx.Args().First(), "amount"),
+		ctx.Args().Get(1),
+		parseWalletFunding(ctx),
+		ctx.String("pair"),
+		ctx.String("outputtype"),
+		parseFeePolicy(ctx),
 	)
 
 	if err != nil {
@@ -204,17 +490,83 @@ func createSwap(ctx *cli.Context) error {
 	return nil
 }
 
+var refundSwapCommand = cli.Command{
+	Name:      "refundswap",
+	Category:  "Manual",
+	Usage:     "Refunds a Swap after its timeout block height was reached",
+	ArgsUsage: "id [address]",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name:  "fee",
+			Usage: "Fee rate of the refund transaction in satoshis per vbyte. Estimated if not set",
+		},
+	},
+	Action: refundSwap,
+}
+
+func refundSwap(ctx *cli.Context) error {
+	id := ctx.Args().First()
+
+	if id == "" {
+		return errors.New("no Swap ID was specified")
+	}
+
+	client := getClient(ctx)
+	response, err := client.RefundSwap(id, ctx.Args().Get(1), ctx.Int64("fee"))
+
+	if err != nil {
+		return err
+	}
+
+	printJson(response)
+
+	return nil
+}
+
+var bumpFeeCommand = cli.Command{
+	Name:      "bumpfee",
+	Category:  "Manual",
+	Usage:     "Replaces an unconfirmed claim or refund transaction with one that pays a higher fee",
+	ArgsUsage: "transaction id",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name:  "fee",
+			Usage: "Fee rate of the new transaction in satoshis per vbyte. Estimated if not set",
+		},
+	},
+	Action: bumpFee,
+}
+
+func bumpFee(ctx *cli.Context) error {
+	transactionId := ctx.Args().First()
+
+	if transactionId == "" {
+		return errors.New("no transaction ID was specified")
+	}
+
+	client := getClient(ctx)
+	response, err := client.BumpFee(transactionId, ctx.Int64("fee"))
+
+	if err != nil {
+		return err
+	}
+
+	printJson(response)
+
+	return nil
+}
+
 var createChannelCreationCommand = cli.Command{
 	Name:      "createchannel",
 	Category:  "Manual",
 	Usage:     "Creates a new Channel Creation",
 	ArgsUsage: "amount inbound",
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		cli.BoolFlag{
 			Name:  "private",
 			Usage: "Whether the channel should be private",
 		},
-	},
+	}, append(walletFundingFlags, feePolicyFlags...)...),
 	Action: createChannelCreation,
 }
 
@@ -226,6 +578,8 @@ func createChannelCreation(ctx *cli.Context) error {
 		parseInt64(ctx.Args().First(), "amount"),
 		uint32(parseInt64(ctx.Args().Get(1), "inbound liquidity")),
 		private,
+		parseWalletFunding(ctx),
+		parseFeePolicy(ctx),
 	)
 
 	if err != nil {
@@ -237,13 +591,80 @@ func createChannelCreation(ctx *cli.Context) error {
 	return nil
 }
 
+// Cross chain swaps require an address because the LND wallet is on a different chain
+var pairFlag = cli.StringFlag{
+	Name:  "pair",
+	Usage: "Pair like \"LTC/BTC\" to swap with an onchain currency other than the one of LND",
+}
+
+var walletFundingFlags = []cli.Flag{
+	cli.BoolFlag{
+		Name:  "fund",
+		Usage: "Whether the lockup transaction should be sent from the LND wallet",
+	},
+	cli.IntFlag{
+		Name:  "conf-target",
+		Usage: "Confirmation target of the lockup transaction",
+	},
+	cli.Int64Flag{
+		Name:  "sat-per-vbyte",
+		Usage: "Fee rate of the lockup transaction in satoshis per vbyte",
+	},
+	cli.StringFlag{
+		Name:  "account",
+		Usage: "Account of the LND wallet from which the lockup transaction should be funded. Only \"default\" is supported",
+	},
+}
+
+func parseWalletFunding(ctx *cli.Context) *boltzrpc.WalletFunding {
+	if !ctx.Bool("fund") {
+		return nil
+	}
+
+	return &boltzrpc.WalletFunding{
+		ConfTarget:  int32(ctx.Int("conf-target")),
+		SatPerVbyte: ctx.Int64("sat-per-vbyte"),
+		Account:     ctx.String("account"),
+	}
+}
+
+// Override the fee policy of the daemon for a single swap
+var feePolicyFlags = []cli.Flag{
+	cli.Float64Flag{
+		Name:  "max-fee-percent",
+		Usage: "Maximal percentage of the amount Boltz can charge in fees",
+	},
+	cli.Uint64Flag{
+		Name:  "max-fee",
+		Usage: "Maximal amount of satoshis Boltz can charge in fees",
+	},
+}
+
+func parseFeePolicy(ctx *cli.Context) *boltzrpc.FeePolicy {
+	if !ctx.IsSet("max-fee-percent") && !ctx.IsSet("max-fee") {
+		return nil
+	}
+
+	return &boltzrpc.FeePolicy{
+		MaxFeePercent: ctx.Float64("max-fee-percent"),
+		MaxFee:        ctx.Uint64("max-fee"),
+	}
+}
+
 // TODO: allow zero conf via cli argument
 var createReverseSwapCommand = cli.Command{
 	Name:      "createreverseswap",
 	Category:  "Manual",
 	Usage:     "Creates a new Reverse Swap",
 	ArgsUsage: "amount [address]",
-	Action:    createReverseSwap,
+	Flags: append([]cli.Flag{
+		pairFlag,
+		cli.Uint64Flag{
+			Name:  "channel",
+			Usage: "ID of the channel through which the invoice is paid",
+		},
+	}, feePolicyFlags...),
+	Action: createReverseSwap,
 }
 
 func createReverseSwap(ctx *cli.Context) error {
@@ -252,6 +673,9 @@ func createReverseSwap(ctx *cli.Context) error {
 		parseInt64(ctx.Args().First(), "amount"),
 		ctx.Args().Get(1),
 		false,
+		ctx.String("pair"),
+		ctx.Uint64("channel"),
+		parseFeePolicy(ctx),
 	)
 
 	if err != nil {
@@ -263,6 +687,372 @@ func createReverseSwap(ctx *cli.Context) error {
 	return nil
 }
 
+var autoSwapCommand = cli.Command{
+	Name:     "autoswap",
+	Category: "Auto",
+	Usage:    "Manages the automatic rebalancing of channels with swaps",
+	Subcommands: []cli.Command{
+		{
+			Name:   "config",
+			Usage:  "Shows the current autoswap config",
+			Action: getAutoSwapConfig,
+		},
+		{
+			Name:      "setconfig",
+			Usage:     "Sets a value of the autoswap config until the daemon is restarted",
+			ArgsUsage: "key value",
+			Action:    setAutoSwapConfig,
+		},
+		{
+			Name:   "recommendations",
+			Usage:  "Shows the swaps autoswap would create right now",
+			Action: getAutoSwapRecommendations,
+		},
+	},
+}
+
+func getAutoSwapConfig(ctx *cli.Context) error {
+	client := getClient(ctx)
+	response, err := client.GetAutoSwapConfig()
+
+	if err != nil {
+		return err
+	}
+
+	printJson(response.Config)
+
+	return nil
+}
+
+func setAutoSwapConfig(ctx *cli.Context) error {
+	key := ctx.Args().First()
+	value := ctx.Args().Get(1)
+
+	if key == "" || value == "" {
+		return errors.New("key and value of the config entry have to be specified")
+	}
+
+	client := getClient(ctx)
+	response, err := client.GetAutoSwapConfig()
+
+	if err != nil {
+		return err
+	}
+
+	// Set the value in the JSON representation of the config to be able to address the entries by name
+	rawConfig, err := protojson.Marshal(response.Config)
+
+	if err != nil {
+		return err
+	}
+
+	config := make(map[string]interface{})
+	err = json.Unmarshal(rawConfig, &config)
+
+	if err != nil {
+		return err
+	}
+
+	var parsedValue interface{}
+
+	if json.Unmarshal([]byte(value), &parsedValue) != nil {
+		parsedValue = value
+	}
+
+	config[key] = parsedValue
+
+	rawConfig, err = json.Marshal(config)
+
+	if err != nil {
+		return err
+	}
+
+	var newConfig boltzrpc.AutoSwapConfig
+	err = protojson.Unmarshal(rawConfig, &newConfig)
+
+	if err != nil {
+		return errors.New("invalid config entry: " + err.Error())
+	}
+
+	setResponse, err := client.SetAutoSwapConfig(&newConfig)
+
+	if err != nil {
+		return err
+	}
+
+	printJson(setResponse.Config)
+
+	return nil
+}
+
+func getAutoSwapRecommendations(ctx *cli.Context) error {
+	client := getClient(ctx)
+	response, err := client.GetAutoSwapRecommendations()
+
+	if err != nil {
+		return err
+	}
+
+	printJson(response)
+
+	return nil
+}
+
+var recoverSwapsCommand = cli.Command{
+	Name:      "recoverswaps",
+	Category:  "Manual",
+	Usage:     "Recovers swaps that were lost with the database from the seed of the daemon",
+	ArgsUsage: "file",
+	Description: "Reads the swaps from a JSON file like {\"swaps\": [{\"id\": \"...\", \"redeemScript\": \"...\"}]}, which can " +
+		"also set the \"pairId\" and \"address\" of every swap. The keys of the indexes in the range are derived from the " +
+		"seed and matched against the redeem scripts.",
+	Flags: []cli.Flag{
+		cli.UintFlag{
+			Name:  "from",
+			Usage: "First index of the range in which the keys of the swaps are searched",
+		},
+		cli.UintFlag{
+			Name:  "to",
+			Value: 1000,
+			Usage: "Last index of the range in which the keys of the swaps are searched",
+		},
+	},
+	Action: recoverSwaps,
+}
+
+func recoverSwaps(ctx *cli.Context) error {
+	if ctx.Args().First() == "" {
+		return errors.New("no file with swaps was specified")
+	}
+
+	file, err := ioutil.ReadFile(ctx.Args().First())
+
+	if err != nil {
+		return err
+	}
+
+	var request boltzrpc.RecoverSwapsRequest
+	err = protojson.Unmarshal(file, &request)
+
+	if err != nil {
+		return errors.New("could not parse file: " + err.Error())
+	}
+
+	client := getClient(ctx)
+	response, err := client.RecoverSwaps(request.Swaps, uint32(ctx.Uint("from")), uint32(ctx.Uint("to")))
+
+	if err != nil {
+		return err
+	}
+
+	printJson(response)
+
+	return nil
+}
+
+var exportRescueFileCommand = cli.Command{
+	Name:      "exportrescue",
+	Category:  "Manual",
+	Usage:     "Writes what is needed to refund or claim swaps without the database of the daemon to a file",
+	ArgsUsage: "file [id...]",
+	Description: "Exports the private keys, preimages, redeem scripts, lockup addresses and timeout block heights of the " +
+		"swaps and reverse swaps with the IDs, or of all pending ones if no IDs are specified. The file can only be read " +
+		"by the current user and every swap in it has the format of the refund files of the Boltz web app.",
+	Action: exportRescueFile,
+}
+
+func exportRescueFile(ctx *cli.Context) error {
+	filePath := ctx.Args().First()
+
+	if filePath == "" {
+		return errors.New("no file was specified")
+	}
+
+	client := getClient(ctx)
+	response, err := client.ExportRescueFile(ctx.Args().Tail())
+
+	if err != nil {
+		return err
+	}
+
+	rawSwaps := make([]json.RawMessage, 0, len(response.Swaps))
+
+	for _, swap := range response.Swaps {
+		rawSwap, err := protojson.Marshal(swap)
+
+		if err != nil {
+			return err
+		}
+
+		rawSwaps = append(rawSwaps, rawSwap)
+	}
+
+	content, err := json.MarshalIndent(rawSwaps, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	// Permissions of existing files would not be changed, so the rescue file is never overwritten
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	_, err = file.Write(content)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Exported " + strconv.Itoa(len(rawSwaps)) + " swaps to " + filePath)
+
+	return nil
+}
+
+var exportSeedCommand = cli.Command{
+	Name:      "exportseed",
+	Category:  "Manual",
+	Usage:     "Writes the mnemonic of the seed of the daemon to a file",
+	ArgsUsage: "file",
+	Description: "Exports the mnemonic from which the keys and preimages of swaps are derived. It is needed to recover " +
+		"swaps with the recoverswaps command if the database is lost. The file can only be read by the current user " +
+		"and should be deleted once the mnemonic is backed up.",
+	Action: exportSeed,
+}
+
+func exportSeed(ctx *cli.Context) error {
+	filePath := ctx.Args().First()
+
+	if filePath == "" {
+		return errors.New("no file was specified")
+	}
+
+	client := getClient(ctx)
+	response, err := client.ExportSeed()
+
+	if err != nil {
+		return err
+	}
+
+	// Permissions of existing files would not be changed, so the mnemonic file is never overwritten
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	_, err = file.WriteString(response.Mnemonic + "\n")
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Exported mnemonic to " + filePath)
+
+	return nil
+}
+
+var importSwapsCommand = cli.Command{
+	Name:      "importswap",
+	Category:  "Manual",
+	Usage:     "Imports the swaps of a rescue file into the database, so that they are refunded or claimed",
+	ArgsUsage: "file",
+	Description: "Reads a file of the exportrescue command or a refund file of the Boltz web app. The swaps are refunded " +
+		"to, and the reverse swaps claimed to, the address in the file or a new address of the LND wallet.",
+	Action: importSwaps,
+}
+
+func importSwaps(ctx *cli.Context) error {
+	if ctx.Args().First() == "" {
+		return errors.New("no rescue file was specified")
+	}
+
+	file, err := ioutil.ReadFile(ctx.Args().First())
+
+	if err != nil {
+		return err
+	}
+
+	swaps, err := utils.ParseRescueFile(file)
+
+	if err != nil {
+		return errors.New("could not parse file: " + err.Error())
+	}
+
+	client := getClient(ctx)
+	response, err := client.ImportSwaps(swaps)
+
+	if err != nil {
+		return err
+	}
+
+	printJson(response)
+
+	return nil
+}
+
+var unlockCommand = cli.Command{
+	Name:     "unlock",
+	Category: "Daemon",
+	Usage:    "Unlocks a daemon whose database is encrypted",
+	Description: "Reads the passphrase of the database from the terminal or, if it is not a terminal, from stdin.\n" +
+		"The passphrase of a database that is not encrypted yet is set with the setpassphrase command.",
+	Action: unlock,
+}
+
+func unlock(ctx *cli.Context) error {
+	passphrase, err := utils.ReadPassphrase()
+
+	if err != nil {
+		return errors.New("could not read passphrase: " + err.Error())
+	}
+
+	client := getUnlockClient(ctx)
+	_, err = client.Unlock(passphrase)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Unlocked boltz-lnd")
+
+	return nil
+}
+
+var setPassphraseCommand = cli.Command{
+	Name:     "setpassphrase",
+	Category: "Daemon",
+	Usage:    "Sets the passphrase of a database that is not encrypted yet and unlocks the daemon",
+	Description: "Reads the passphrase from the terminal or, if it is not a terminal, from stdin.\n" +
+		"The daemon only accepts the passphrase from clients on the same host and not once one was set.",
+	Action: setPassphrase,
+}
+
+func setPassphrase(ctx *cli.Context) error {
+	passphrase, err := utils.ReadPassphrase()
+
+	if err != nil {
+		return errors.New("could not read passphrase: " + err.Error())
+	}
+
+	client := getUnlockClient(ctx)
+	_, err = client.SetPassphrase(passphrase)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Set passphrase and unlocked boltz-lnd")
+
+	return nil
+}
+
 var formatMacaroonCommand = cli.Command{
 	Name:     "formatmacaroon",
 	Category: "Debug",
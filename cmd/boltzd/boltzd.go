@@ -2,17 +2,19 @@ package main
 
 import (
 	"github.com/BoltzExchange/boltz-lnd"
+	"github.com/BoltzExchange/boltz-lnd/autoswap"
 	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/chain"
+	"github.com/BoltzExchange/boltz-lnd/lnd"
 	"github.com/BoltzExchange/boltz-lnd/logger"
-	"github.com/BoltzExchange/boltz-lnd/nursery"
+	"github.com/BoltzExchange/boltz-lnd/rpcserver"
 	"github.com/BoltzExchange/boltz-lnd/utils"
+	"github.com/BoltzExchange/boltz-lnd/webhook"
 	bitcoinCfg "github.com/btcsuite/btcd/chaincfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	litecoinCfg "github.com/ltcsuite/ltcd/chaincfg"
 )
 
-// TODO: close dangling channels
-
 func main() {
 	cfg := boltz_lnd.LoadConfig()
 
@@ -32,42 +34,80 @@ func main() {
 		logger.Fatal("Could not connect to database: " + err.Error())
 	}
 
-	err = cfg.LND.Connect()
+	isLocked, err := cfg.Database.IsLocked()
+
+	if err != nil {
+		logger.Fatal("Could not check whether database is encrypted: " + err.Error())
+	}
+
+	// Nothing that reads private keys or preimages can be started before the database is unlocked
+	if isLocked {
+		err = cfg.RPC.WaitForUnlock(cfg.Database)
+
+		if err != nil {
+			logger.Fatal("Could not unlock database: " + err.Error())
+		}
+	}
+
+	seed, err := cfg.Seed.LoadSeed(cfg.Database)
 
 	if err != nil {
-		logger.Fatal("Could not initialize LND client: " + err.Error())
+		logger.Fatal("Could not load seed: " + err.Error())
 	}
 
-	lndInfo := connectToLnd(cfg.LND)
+	// The notifier has to subscribe to swap events before the nurseries start recovering pending swaps
+	notifier := &webhook.Notifier{}
+	err = notifier.Init(cfg.Webhook, cfg.Database)
 
-	checkLndVersion(lndInfo)
+	if err != nil {
+		logger.Fatal("Could not initialize webhook notifier: " + err.Error())
+	}
 
-	symbol, chainParams := parseChain(lndInfo.Chains[0])
-	logger.Info("Parsed chain: " + symbol + " " + chainParams.Name)
+	notifier.Start()
 
-	cfg.LND.ChainParams = chainParams
+	currencyChains := map[string]*chain.Config{
+		"BTC": cfg.BtcChain,
+		"LTC": cfg.LtcChain,
 
-	waitForLndSynced(cfg.LND)
+		utils.LiquidSymbol: cfg.LiquidChain,
+	}
 
-	setBoltzEndpoint(cfg.Boltz, chainParams.Name)
-	cfg.Boltz.Init(symbol)
+	// The default endpoint of the Boltz API depends on the chain of every node
+	boltzUrl := cfg.Boltz.URL
 
-	checkBoltzVersion(cfg.Boltz)
+	// Swaps of the node of the [LND] section are saved without a node name in the database
+	nodes := []*rpcserver.Node{
+		initNode(cfg.LND, "", cfg.Boltz, "", cfg.Chain, currencyChains, cfg.FeeBump, cfg.ClaimBatch, cfg.DanglingChannels, cfg.Database),
+	}
 
-	boltzPubKey, err := utils.ConnectBoltzLnd(cfg.LND, cfg.Boltz, symbol)
+	for _, lndNode := range cfg.Nodes {
+		boltzApi := &boltz.Boltz{
+			URL: boltzUrl,
+		}
+
+		nodes = append(nodes, initNode(lndNode, lndNode.Name, boltzApi, nodes[0].Symbol, cfg.Chain, currencyChains, cfg.FeeBump, cfg.ClaimBatch, cfg.DanglingChannels, cfg.Database))
+	}
+
+	autoSwapper := &autoswap.AutoSwapper{}
+	err = autoSwapper.Init(cfg.AutoSwap, append([]*lnd.LND{cfg.LND}, cfg.Nodes...), cfg.Database)
 
 	if err != nil {
-		logger.Warning("Could not connect to to Boltz LND node: " + err.Error())
+		logger.Fatal("Could not initialize autoswap: " + err.Error())
 	}
 
-	swapNursery := &nursery.Nursery{}
-	err = swapNursery.Init(symbol, boltzPubKey, chainParams, cfg.LND, cfg.Boltz, cfg.Database)
+	err = cfg.FeePolicy.Validate()
 
 	if err != nil {
-		logger.Fatal("Could not start Swap nursery: " + err.Error())
+		logger.Fatal("Invalid fee policy: " + err.Error())
 	}
 
-	errChannel := cfg.RPC.Start(symbol, chainParams, cfg.LND, cfg.Boltz, swapNursery, cfg.Database)
+	err = cfg.Accounting.Validate()
+
+	if err != nil {
+		logger.Fatal("Invalid accounting config: " + err.Error())
+	}
+
+	errChannel := cfg.RPC.Start(nodes, cfg.FeePolicy, cfg.Accounting, cfg.Database, seed, autoSwapper)
 
 	err = <-errChannel
 
@@ -86,35 +126,41 @@ func parseChain(chain *lnrpc.Chain) (symbol string, params *bitcoinCfg.Params) {
 		logger.Fatal("Chain " + chain.Chain + " not supported")
 	}
 
-	switch symbol {
-	case "BTC":
-		switch chain.Network {
-		case "mainnet":
-			// #reckless
-			params = &bitcoinCfg.MainNetParams
-		case "testnet":
-			params = &bitcoinCfg.TestNet3Params
-		case "regtest":
-			params = &bitcoinCfg.RegressionNetParams
-		default:
-			logger.Fatal("Chain " + chain.Network + " no supported")
+	params, err := utils.GetChainParams(symbol, chain.Network)
+
+	if err != nil {
+		logger.Fatal("Could not parse chain: " + err.Error())
+	}
+
+	return symbol, params
+}
+
+// Swaps can have their onchain side on a currency other than the one of LND when a chain backend is configured for it
+func initCurrencies(cfg map[string]*chain.Config, symbol string, network string) map[string]*chain.Currency {
+	currencies := make(map[string]*chain.Currency)
+
+	for currencySymbol, chainConfig := range cfg {
+		if currencySymbol == symbol || chainConfig.Backend == "" {
+			continue
+		}
+
+		params, err := utils.GetChainParams(currencySymbol, network)
+
+		if err != nil {
+			logger.Fatal("Could not get chain params of " + currencySymbol + ": " + err.Error())
 		}
 
-	case "LTC":
-		switch chain.Network {
-		case "mainnet":
-			// #reckless
-			params = utils.ApplyLitecoinParams(litecoinCfg.MainNetParams)
-		case "testnet":
-			params = utils.ApplyLitecoinParams(litecoinCfg.TestNet4Params)
-		case "regtest":
-			params = utils.ApplyLitecoinParams(litecoinCfg.RegressionNetParams)
-		default:
-			logger.Fatal("Chain " + chain.Network + " no supported")
+		currency, err := chainConfig.InitCurrency(currencySymbol, params)
+
+		if err != nil {
+			logger.Fatal("Could not initialize chain backend of " + currencySymbol + ": " + err.Error())
 		}
+
+		logger.Info("Enabled cross chain swaps with onchain currency " + currencySymbol)
+		currencies[currencySymbol] = currency
 	}
 
-	return symbol, params
+	return currencies
 }
 
 func setBoltzEndpoint(boltz *boltz.Boltz, chain string) {
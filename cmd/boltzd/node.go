@@ -0,0 +1,100 @@
+package main
+
+import (
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/chain"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/lnd"
+	"github.com/BoltzExchange/boltz-lnd/logger"
+	"github.com/BoltzExchange/boltz-lnd/nursery"
+	"github.com/BoltzExchange/boltz-lnd/rpcserver"
+	"github.com/BoltzExchange/boltz-lnd/utils"
+)
+
+// initNode connects to an LND node and starts the nursery of its swaps. The symbol of the node of the [LND] section is
+// empty when that node itself is initialized
+func initNode(
+	lndNode *lnd.LND,
+	databaseNode string,
+	boltzApi *boltz.Boltz,
+	lndSymbol string,
+	lndChain *chain.Config,
+	currencyChains map[string]*chain.Config,
+	feeBump *nursery.FeeBumpConfig,
+	claimBatchConfig *nursery.ClaimBatchConfig,
+	danglingChannelConfig *nursery.DanglingChannelConfig,
+	database *database.Database,
+) *rpcserver.Node {
+	logger.Info("Initializing LND node " + lndNode.Name)
+
+	err := lndNode.Connect()
+
+	if err != nil {
+		logger.Fatal("Could not initialize LND client of node " + lndNode.Name + ": " + err.Error())
+	}
+
+	lndInfo := connectToLnd(lndNode)
+
+	checkLndVersion(lndInfo)
+
+	symbol, chainParams := parseChain(lndInfo.Chains[0])
+	logger.Info("Parsed chain of node " + lndNode.Name + ": " + symbol + " " + chainParams.Name)
+
+	lndNode.ChainParams = chainParams
+
+	waitForLndSynced(lndNode)
+
+	setBoltzEndpoint(boltzApi, chainParams.Name)
+	boltzApi.Init(symbol)
+
+	checkBoltzVersion(boltzApi)
+
+	boltzPubKey, err := utils.ConnectBoltzLnd(lndNode, boltzApi, symbol)
+
+	if err != nil {
+		logger.Warning("Could not connect to to Boltz LND node: " + err.Error())
+	}
+
+	chainBackend, err := getChainConfig(symbol, lndSymbol, lndChain, currencyChains).Init(boltzApi)
+
+	if err != nil {
+		logger.Fatal("Could not initialize chain backend of node " + lndNode.Name + ": " + err.Error())
+	}
+
+	currencies := initCurrencies(currencyChains, symbol, lndInfo.Chains[0].Network)
+
+	swapNursery := &nursery.Nursery{}
+	err = swapNursery.Init(databaseNode, symbol, boltzPubKey, chainParams, lndNode, boltzApi, chainBackend, currencies, feeBump, claimBatchConfig, danglingChannelConfig, database)
+
+	if err != nil {
+		logger.Fatal("Could not start Swap nursery of node " + lndNode.Name + ": " + err.Error())
+	}
+
+	return &rpcserver.Node{
+		Name:        lndNode.Name,
+		Symbol:      symbol,
+		ChainParams: chainParams,
+		Currencies:  currencies,
+		LND:         lndNode,
+		Boltz:       boltzApi,
+		Nursery:     swapNursery,
+	}
+}
+
+// The [CHAIN] section configures the backend of the chain of the node of the [LND] section. Nodes on other chains use
+// the backend that is configured for cross chain swaps of their currency or the Boltz API
+func getChainConfig(symbol string, lndSymbol string, lndChain *chain.Config, currencyChains map[string]*chain.Config) *chain.Config {
+	if lndSymbol == "" || symbol == lndSymbol {
+		return lndChain
+	}
+
+	currencyChain, hasCurrencyChain := currencyChains[symbol]
+
+	if hasCurrencyChain && currencyChain.Backend != "" {
+		return currencyChain
+	}
+
+	return &chain.Config{
+		Backend: chain.BoltzBackend,
+	}
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/build"
+	"github.com/BoltzExchange/boltz-lnd/utils"
+	"github.com/btcsuite/btcutil"
+	"github.com/urfave/cli"
+)
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "boltzrefund"
+	app.Usage = "Refunds Swaps and claims Reverse Swaps without LND or boltzd"
+	app.Description = "Verifies the redeem script of a swap from a rescue file or the database of boltzd and prints a " +
+		"signed transaction that refunds or claims its output of the lockup transaction. No connections are made and " +
+		"nothing is broadcast. Refund transactions can only be broadcast once the timeout block height was reached."
+	app.Version = build.GetVersion()
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:  "rescuefile",
+			Usage: "Path to a file of \"boltzcli exportrescue\" or a refund file of the Boltz web app",
+		},
+		cli.StringFlag{
+			Name:  "database",
+			Usage: "Path to the SQLite database of boltzd",
+		},
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "ID of the swap. Only optional for rescue files with a single swap",
+		},
+		cli.StringFlag{
+			Name:  "lockuptx",
+			Usage: "Raw lockup transaction in hex",
+		},
+		cli.StringFlag{
+			Name:  "address",
+			Usage: "Address to which the coins are sent",
+		},
+		cli.Int64Flag{
+			Name:  "feerate",
+			Usage: "Fee rate of the transaction in satoshis per vbyte",
+		},
+		cli.StringFlag{
+			Name:  "currency",
+			Usage: "Symbol of the onchain currency. Defaults to the one of the rescue file or BTC",
+		},
+		cli.StringFlag{
+			Name:  "network",
+			Value: "mainnet",
+			Usage: "Network of the onchain currency: mainnet, testnet or regtest",
+		},
+	}
+	app.Action = refund
+
+	if err := app.Run(os.Args); err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+}
+
+func refund(ctx *cli.Context) error {
+	swap, err := loadSwap(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	currency := ctx.String("currency")
+
+	if currency == "" {
+		currency = swap.currency
+	}
+
+	if currency == "" {
+		currency = "BTC"
+	}
+
+	if currency == utils.LiquidSymbol {
+		return errLiquid
+	}
+
+	chainParams, err := utils.GetChainParams(currency, ctx.String("network"))
+
+	if err != nil {
+		return err
+	}
+
+	if ctx.String("lockuptx") == "" {
+		return errors.New("no lockup transaction was specified")
+	}
+
+	lockupTransaction, err := parseLockupTransaction(ctx.String("lockuptx"))
+
+	if err != nil {
+		return err
+	}
+
+	address, err := btcutil.DecodeAddress(ctx.String("address"), chainParams)
+
+	if err != nil {
+		return errors.New("could not decode address: " + err.Error())
+	}
+
+	if !address.IsForNet(chainParams) {
+		return errors.New("address " + ctx.String("address") + " is not for network " + chainParams.Name)
+	}
+
+	satPerVbyte := ctx.Int64("feerate")
+
+	if satPerVbyte <= 0 {
+		return errors.New("fee rate has to be positive")
+	}
+
+	transaction, err := constructSpendTransaction(swap, chainParams, lockupTransaction, address, satPerVbyte)
+
+	if err != nil {
+		return err
+	}
+
+	transactionHex, err := boltz.SerializeTransaction(transaction)
+
+	if err != nil {
+		return err
+	}
+
+	// Only the transaction is printed to stdout so that it can be piped
+	if swap.isReverse {
+		fmt.Fprintln(os.Stderr, "Claim transaction of Reverse Swap "+swap.id+":")
+	} else {
+		fmt.Fprintln(os.Stderr, "Refund transaction of Swap "+swap.id+" that can be broadcast from block "+
+			strconv.FormatUint(uint64(swap.timeoutBlockHeight), 10)+":")
+	}
+
+	fmt.Println(transactionHex)
+
+	return nil
+}
+
+func loadSwap(ctx *cli.Context) (*offlineSwap, error) {
+	rescueFile := ctx.String("rescuefile")
+	databasePath := ctx.String("database")
+
+	if (rescueFile == "") == (databasePath == "") {
+		return nil, errors.New("either a rescue file or a database has to be specified")
+	}
+
+	if rescueFile != "" {
+		return loadRescueFileSwap(rescueFile, ctx.String("id"))
+	}
+
+	return loadDatabaseSwap(databasePath, ctx.String("id"))
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/utils"
+	"github.com/btcsuite/btcd/btcec"
+)
+
+// What is needed to refund a Swap or claim a Reverse Swap without LND or the daemon
+type offlineSwap struct {
+	id        string
+	isReverse bool
+
+	// Symbol of the onchain currency. Empty if it is not known
+	currency string
+
+	privateKey   *btcec.PrivateKey
+	preimage     []byte
+	redeemScript []byte
+
+	timeoutBlockHeight uint32
+}
+
+// Rescue files with a single swap, like the refund files of the Boltz web app, do not need an ID
+func loadRescueFileSwap(path string, id string) (*offlineSwap, error) {
+	file, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rescueSwaps, err := utils.ParseRescueFile(file)
+
+	if err != nil {
+		return nil, errors.New("could not parse rescue file: " + err.Error())
+	}
+
+	for _, rescueSwap := range rescueSwaps {
+		if rescueSwap.Id != id && (id != "" || len(rescueSwaps) != 1) {
+			continue
+		}
+
+		if rescueSwap.BlindingKey != "" {
+			return nil, errLiquid
+		}
+
+		swap := &offlineSwap{
+			id:                 rescueSwap.Id,
+			currency:           rescueSwap.Currency,
+			timeoutBlockHeight: rescueSwap.TimeoutBlockHeight,
+		}
+
+		privateKey, err := hex.DecodeString(rescueSwap.PrivateKey)
+
+		if err != nil || len(privateKey) != btcec.PrivKeyBytesLen {
+			return nil, errors.New("invalid private key")
+		}
+
+		swap.privateKey, _ = btcec.PrivKeyFromBytes(btcec.S256(), privateKey)
+
+		swap.preimage, err = hex.DecodeString(rescueSwap.Preimage)
+
+		if err != nil {
+			return nil, errors.New("could not decode preimage: " + err.Error())
+		}
+
+		swap.redeemScript, err = hex.DecodeString(rescueSwap.RedeemScript)
+
+		if err != nil {
+			return nil, errors.New("could not decode redeem script: " + err.Error())
+		}
+
+		// Refund files of the web app do not have a type, so it is inferred from the redeem script
+		_, err = boltz.ParseReverseSwapScript(swap.redeemScript)
+		swap.isReverse = err == nil
+
+		return swap, nil
+	}
+
+	if id == "" {
+		return nil, errors.New("an ID is required for rescue files that do not contain exactly one swap")
+	}
+
+	return nil, errors.New("could not find swap " + id + " in rescue file")
+}
+
+// The database is opened read only so that it is left untouched for the daemon
+func loadDatabaseSwap(path string, id string) (*offlineSwap, error) {
+	if id == "" {
+		return nil, errors.New("an ID is required to load a swap from the database")
+	}
+
+	if !utils.FileExists(path) {
+		return nil, errors.New("database " + path + " does not exist")
+	}
+
+	db := &database.Database{
+		Path: path,
+	}
+
+	err := db.ConnectReadOnly()
+
+	if err != nil {
+		return nil, errors.New("could not open database: " + err.Error())
+	}
+
+	isLocked, err := db.IsLocked()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if isLocked {
+		passphrase, err := utils.ReadPassphrase()
+
+		if err != nil {
+			return nil, errors.New("could not read passphrase: " + err.Error())
+		}
+
+		err = db.Unlock(passphrase)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	swap, err := db.QuerySwap(id)
+
+	if err == nil {
+		if swap.BlindingKey != nil {
+			return nil, errLiquid
+		}
+
+		return &offlineSwap{
+			id:                 swap.Id,
+			currency:           swap.Currency,
+			privateKey:         swap.PrivateKey,
+			preimage:           swap.Preimage,
+			redeemScript:       swap.RedeemScript,
+			timeoutBlockHeight: swap.TimoutBlockHeight,
+		}, nil
+	}
+
+	reverseSwap, err := db.QueryReverseSwap(id)
+
+	if err != nil {
+		return nil, errors.New("could not find Swap or Reverse Swap " + id)
+	}
+
+	if reverseSwap.BlindingKey != nil {
+		return nil, errLiquid
+	}
+
+	return &offlineSwap{
+		id:                 reverseSwap.Id,
+		isReverse:          true,
+		currency:           reverseSwap.Currency,
+		privateKey:         reverseSwap.PrivateKey,
+		preimage:           reverseSwap.Preimage,
+		redeemScript:       reverseSwap.RedeemScript,
+		timeoutBlockHeight: reverseSwap.TimeoutBlockHeight,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+var errLiquid = errors.New("swaps on Liquid are not supported")
+
+// Verifies that the key, preimage and timeout block height of the swap are the ones of its redeem script
+func checkSwapScript(swap *offlineSwap) error {
+	if swap.isReverse {
+		if len(swap.preimage) == 0 {
+			return errors.New("Reverse Swaps cannot be claimed without their preimage")
+		}
+
+		preimageHash := sha256.Sum256(swap.preimage)
+		return boltz.CheckReverseSwapScript(swap.redeemScript, preimageHash[:], swap.privateKey, swap.timeoutBlockHeight)
+	}
+
+	// Swaps whose invoice was not created by the daemon have no preimage, so only the key and timeout can be checked
+	if len(swap.preimage) == 0 {
+		values, err := boltz.ParseSwapScript(swap.redeemScript)
+
+		if err != nil {
+			return err
+		}
+
+		if !values.RefundPublicKey.IsEqual(swap.privateKey.PubKey()) || values.TimeoutBlockHeight != swap.timeoutBlockHeight {
+			return errors.New("invalid redeem script")
+		}
+
+		return nil
+	}
+
+	preimageHash := sha256.Sum256(swap.preimage)
+	return boltz.CheckSwapScript(swap.redeemScript, preimageHash[:], swap.privateKey, swap.timeoutBlockHeight)
+}
+
+func parseLockupTransaction(transactionHex string) (*btcutil.Tx, error) {
+	transactionRaw, err := hex.DecodeString(transactionHex)
+
+	if err != nil {
+		return nil, errors.New("could not decode lockup transaction: " + err.Error())
+	}
+
+	transaction, err := btcutil.NewTxFromBytes(transactionRaw)
+
+	if err != nil {
+		return nil, errors.New("could not parse lockup transaction: " + err.Error())
+	}
+
+	return transaction, nil
+}
+
+// Constructs a transaction that refunds the lockup output of a Swap or claims the one of a Reverse Swap
+func constructSpendTransaction(
+	swap *offlineSwap,
+	chainParams *chaincfg.Params,
+	lockupTransaction *btcutil.Tx,
+	address btcutil.Address,
+	satPerVbyte int64,
+) (*wire.MsgTx, error) {
+	err := checkSwapScript(swap)
+
+	if err != nil {
+		return nil, errors.New("could not verify redeem script: " + err.Error())
+	}
+
+	vout, outputType, err := boltz.FindLockupOutput(chainParams, swap.redeemScript, lockupTransaction.MsgTx().TxOut)
+
+	if err != nil {
+		return nil, errors.New("could not find lockup output in transaction " + lockupTransaction.Hash().String())
+	}
+
+	output := boltz.OutputDetails{
+		LockupTransaction: lockupTransaction,
+		Vout:              vout,
+		OutputType:        outputType,
+		RedeemScript:      swap.redeemScript,
+		PrivateKey:        swap.privateKey,
+	}
+
+	if swap.isReverse {
+		output.Preimage = swap.preimage
+	} else {
+		output.Preimage = []byte{}
+		output.TimeoutBlockHeight = swap.timeoutBlockHeight
+	}
+
+	return boltz.ConstructTransaction([]boltz.OutputDetails{output}, address, satPerVbyte)
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/stretchr/testify/assert"
+)
+
+var chainParams = &chaincfg.RegressionNetParams
+
+const timeoutBlockHeight = 248
+
+func newTestSwap(t *testing.T, isReverse bool) *offlineSwap {
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	boltzKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	preimage := make([]byte, 32)
+	_, err = rand.Read(preimage)
+	assert.Nil(t, err)
+
+	preimageHash := sha256.Sum256(preimage)
+	preimageHash160 := input.Ripemd160H(preimageHash[:])
+
+	builder := txscript.NewScriptBuilder()
+
+	if isReverse {
+		builder.AddOp(txscript.OP_SIZE).AddInt64(32).AddOp(txscript.OP_EQUAL).AddOp(txscript.OP_IF)
+		builder.AddOp(txscript.OP_HASH160).AddData(preimageHash160).AddOp(txscript.OP_EQUALVERIFY)
+		builder.AddData(privateKey.PubKey().SerializeCompressed())
+		builder.AddOp(txscript.OP_ELSE).AddOp(txscript.OP_DROP)
+		builder.AddInt64(timeoutBlockHeight).AddOp(txscript.OP_CHECKLOCKTIMEVERIFY).AddOp(txscript.OP_DROP)
+		builder.AddData(boltzKey.PubKey().SerializeCompressed())
+	} else {
+		builder.AddOp(txscript.OP_HASH160).AddData(preimageHash160).AddOp(txscript.OP_EQUAL).AddOp(txscript.OP_IF)
+		builder.AddData(boltzKey.PubKey().SerializeCompressed())
+		builder.AddOp(txscript.OP_ELSE)
+		builder.AddInt64(timeoutBlockHeight).AddOp(txscript.OP_CHECKLOCKTIMEVERIFY).AddOp(txscript.OP_DROP)
+		builder.AddData(privateKey.PubKey().SerializeCompressed())
+	}
+
+	builder.AddOp(txscript.OP_ENDIF).AddOp(txscript.OP_CHECKSIG)
+
+	redeemScript, err := builder.Script()
+	assert.Nil(t, err)
+
+	return &offlineSwap{
+		id:                 "test",
+		isReverse:          isReverse,
+		privateKey:         privateKey,
+		preimage:           preimage,
+		redeemScript:       redeemScript,
+		timeoutBlockHeight: timeoutBlockHeight,
+	}
+}
+
+func newTestLockupTransaction(t *testing.T, address string, value int64) *btcutil.Tx {
+	decodedAddress, err := btcutil.DecodeAddress(address, chainParams)
+	assert.Nil(t, err)
+
+	outputScript, err := txscript.PayToAddrScript(decodedAddress)
+	assert.Nil(t, err)
+
+	transaction := wire.NewMsgTx(wire.TxVersion)
+	transaction.AddTxOut(wire.NewTxOut(value, outputScript))
+
+	return btcutil.NewTx(transaction)
+}
+
+func newTestAddress(t *testing.T) btcutil.Address {
+	address, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160([]byte{1}), chainParams)
+	assert.Nil(t, err)
+
+	return address
+}
+
+// Executes the scripts of the spending transaction to make sure that it is valid
+func checkSpendTransaction(t *testing.T, transaction *wire.MsgTx, lockupTransaction *btcutil.Tx) {
+	lockupOutput := lockupTransaction.MsgTx().TxOut[0]
+
+	engine, err := txscript.NewEngine(
+		lockupOutput.PkScript,
+		transaction,
+		0,
+		txscript.StandardVerifyFlags,
+		nil,
+		txscript.NewTxSigHashes(transaction),
+		lockupOutput.Value,
+	)
+	assert.Nil(t, err)
+	assert.Nil(t, engine.Execute())
+}
+
+func TestConstructRefundTransaction(t *testing.T) {
+	swap := newTestSwap(t, false)
+
+	address, err := boltz.WitnessScriptHashAddress(chainParams, swap.redeemScript)
+	assert.Nil(t, err)
+
+	lockupTransaction := newTestLockupTransaction(t, address, 100000)
+
+	transaction, err := constructSpendTransaction(swap, chainParams, lockupTransaction, newTestAddress(t), 2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(timeoutBlockHeight), transaction.LockTime)
+	assert.Less(t, transaction.TxOut[0].Value, int64(100000))
+
+	checkSpendTransaction(t, transaction, lockupTransaction)
+
+	// Swaps of the web app have no preimage
+	swap.preimage = nil
+	nestedAddress, err := boltz.NestedScriptHashAddress(chainParams, swap.redeemScript)
+	assert.Nil(t, err)
+
+	lockupTransaction = newTestLockupTransaction(t, nestedAddress, 100000)
+
+	transaction, err = constructSpendTransaction(swap, chainParams, lockupTransaction, newTestAddress(t), 2)
+
+	assert.Nil(t, err)
+	checkSpendTransaction(t, transaction, lockupTransaction)
+
+	swap.timeoutBlockHeight = 249
+
+	_, err = constructSpendTransaction(swap, chainParams, lockupTransaction, newTestAddress(t), 2)
+	assert.Equal(t, "could not verify redeem script: invalid redeem script", err.Error())
+}
+
+func TestConstructClaimTransaction(t *testing.T) {
+	swap := newTestSwap(t, true)
+
+	address, err := boltz.WitnessScriptHashAddress(chainParams, swap.redeemScript)
+	assert.Nil(t, err)
+
+	lockupTransaction := newTestLockupTransaction(t, address, 100000)
+
+	transaction, err := constructSpendTransaction(swap, chainParams, lockupTransaction, newTestAddress(t), 2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), transaction.LockTime)
+
+	checkSpendTransaction(t, transaction, lockupTransaction)
+
+	// The fee is higher than the amount of the lockup output
+	_, err = constructSpendTransaction(swap, chainParams, lockupTransaction, newTestAddress(t), 1000)
+	assert.Contains(t, err.Error(), "would be dust after fees")
+
+	otherSwap := newTestSwap(t, true)
+	_, err = constructSpendTransaction(otherSwap, chainParams, lockupTransaction, newTestAddress(t), 2)
+	assert.Equal(t, "could not find lockup output in transaction "+lockupTransaction.Hash().String(), err.Error())
+
+	swap.preimage = nil
+
+	_, err = constructSpendTransaction(swap, chainParams, lockupTransaction, newTestAddress(t), 2)
+	assert.Equal(t, "could not verify redeem script: Reverse Swaps cannot be claimed without their preimage", err.Error())
+}
@@ -1,13 +1,20 @@
 package boltz_lnd
 
 import (
+	"errors"
 	"fmt"
+	"github.com/BoltzExchange/boltz-lnd/accounting"
+	"github.com/BoltzExchange/boltz-lnd/autoswap"
 	"github.com/BoltzExchange/boltz-lnd/boltz"
 	"github.com/BoltzExchange/boltz-lnd/build"
+	"github.com/BoltzExchange/boltz-lnd/chain"
 	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/keys"
 	"github.com/BoltzExchange/boltz-lnd/lnd"
+	"github.com/BoltzExchange/boltz-lnd/nursery"
 	"github.com/BoltzExchange/boltz-lnd/rpcserver"
 	"github.com/BoltzExchange/boltz-lnd/utils"
+	"github.com/BoltzExchange/boltz-lnd/webhook"
 	"github.com/BurntSushi/toml"
 	"github.com/jessevdk/go-flags"
 	"os"
@@ -28,10 +35,25 @@ type config struct {
 	LogFile   string `short:"l" long:"logfile" description:"Path to the log file"`
 	LogPrefix string `long:"logprefix" description:"Prefix of all log messages"`
 
-	Boltz    *boltz.Boltz         `group:"Boltz Options"`
-	LND      *lnd.LND             `group:"LND Options"`
-	RPC      *rpcserver.RpcServer `group:"RPC options"`
-	Database *database.Database   `group:"Database options"`
+	Boltz            *boltz.Boltz                   `group:"Boltz Options"`
+	LND              *lnd.LND                       `group:"LND Options"`
+	RPC              *rpcserver.RpcServer           `group:"RPC options"`
+	Database         *database.Database             `group:"Database options"`
+	Seed             *keys.Config                   `group:"Seed options"`
+	Chain            *chain.Config                  `group:"Chain options"`
+	BtcChain         *chain.Config                  `group:"BTC chain options" namespace:"btc"`
+	LtcChain         *chain.Config                  `group:"LTC chain options" namespace:"ltc"`
+	LiquidChain      *chain.Config                  `group:"L-BTC chain options" namespace:"liquid"`
+	FeeBump          *nursery.FeeBumpConfig         `group:"Fee bumping options"`
+	ClaimBatch       *nursery.ClaimBatchConfig      `group:"Claim batching options"`
+	DanglingChannels *nursery.DanglingChannelConfig `group:"Dangling channel options"`
+	FeePolicy        *rpcserver.FeePolicy           `group:"Fee policy options"`
+	AutoSwap         *autoswap.Config               `group:"Autoswap options"`
+	Webhook          *webhook.Config                `group:"Webhook options"`
+	Accounting       *accounting.Config             `group:"Accounting options"`
+
+	// Additional LND nodes can only be configured in the config file
+	Nodes []*lnd.LND
 
 	Help *helpOptions `group:"Help Options"`
 }
@@ -57,6 +79,7 @@ func LoadConfig() *config {
 		},
 
 		LND: &lnd.LND{
+			Name:        "lnd",
 			Host:        "127.0.0.1",
 			Port:        10009,
 			Macaroon:    "",
@@ -71,6 +94,11 @@ func LoadConfig() *config {
 			RestPort:     9003,
 			RestDisabled: false,
 
+			MetricsEnabled:       false,
+			MetricsHost:          "127.0.0.1",
+			MetricsPort:          9004,
+			MetricsTimeoutMargin: 24,
+
 			TlsCertPath: "",
 			TlsKeyPath:  "",
 
@@ -80,7 +108,106 @@ func LoadConfig() *config {
 		},
 
 		Database: &database.Database{
+			Backend: database.SqliteBackend,
+
 			Path: "",
+
+			PostgresHost:     "127.0.0.1",
+			PostgresPort:     5432,
+			PostgresUser:     "",
+			PostgresPassword: "",
+			PostgresDatabase: "boltz",
+			PostgresSslMode:  "disable",
+
+			Encrypt: false,
+		},
+
+		Seed: &keys.Config{
+			MnemonicFile: "",
+		},
+
+		Chain: &chain.Config{
+			Backend: chain.BoltzBackend,
+
+			BitcoindHost: "127.0.0.1",
+			BitcoindPort: 8332,
+
+			ElectrumServer: "",
+			ElectrumTls:    false,
+
+			EsploraUrl: "",
+		},
+
+		// The backends of currencies that are not on the chain of LND are disabled by default
+		BtcChain: &chain.Config{
+			Backend: "",
+
+			BitcoindHost: "127.0.0.1",
+			BitcoindPort: 8332,
+		},
+
+		LtcChain: &chain.Config{
+			Backend: "",
+
+			BitcoindHost: "127.0.0.1",
+			BitcoindPort: 9332,
+		},
+
+		LiquidChain: &chain.Config{
+			Backend: "",
+
+			BitcoindHost: "127.0.0.1",
+			BitcoindPort: 7041,
+		},
+
+		FeeBump: &nursery.FeeBumpConfig{
+			Enabled: true,
+			Blocks:  3,
+			MaxFee:  100,
+		},
+
+		ClaimBatch: &nursery.ClaimBatchConfig{
+			Blocks:        0,
+			TimeoutMargin: 10,
+		},
+
+		DanglingChannels: &nursery.DanglingChannelConfig{
+			GracePeriod: 144,
+		},
+
+		FeePolicy: &rpcserver.FeePolicy{
+			MaxFeePercent: 0,
+			MaxFee:        0,
+		},
+
+		AutoSwap: &autoswap.Config{
+			Enabled: false,
+			DryRun:  false,
+
+			Interval: 60,
+
+			PerChannel:             false,
+			MinLocalBalancePercent: 25,
+			MaxLocalBalancePercent: 75,
+
+			MaxFeePercent:  1,
+			Budget:         100000,
+			BudgetInterval: 7 * 24 * 60 * 60,
+
+			MaxInFlight: 1,
+		},
+
+		Webhook: &webhook.Config{
+			Urls:   []string{},
+			Secret: "",
+
+			MaxAttempts:   10,
+			RetryInterval: 10,
+		},
+
+		Accounting: &accounting.Config{
+			FiatCurrency: "",
+			PriceFile:    "",
 		},
 	}
 
@@ -118,10 +245,17 @@ func LoadConfig() *config {
 		printCouldNotParse(err)
 	}
 
+	err = checkNodeNames(cfg.LND, cfg.Nodes)
+
+	if err != nil {
+		printCouldNotParse(err)
+	}
+
 	fmt.Println("Using data dir: " + cfg.DataDir)
 
 	cfg.LogFile = utils.ExpandDefaultPath(cfg.DataDir, cfg.LogFile, "boltz.log")
 	cfg.Database.Path = utils.ExpandDefaultPath(cfg.DataDir, cfg.Database.Path, "boltz.db")
+	cfg.Seed.MnemonicFile = utils.ExpandDefaultPath(cfg.DataDir, cfg.Seed.MnemonicFile, "seed.txt")
 
 	cfg.RPC.TlsKeyPath = utils.ExpandDefaultPath(cfg.DataDir, cfg.RPC.TlsKeyPath, "tls.key")
 	cfg.RPC.TlsCertPath = utils.ExpandDefaultPath(cfg.DataDir, cfg.RPC.TlsCertPath, "tls.cert")
@@ -137,6 +271,31 @@ func LoadConfig() *config {
 	return &cfg
 }
 
+// Nodes are chosen by their name in RPC requests
+func checkNodeNames(lndNode *lnd.LND, nodes []*lnd.LND) error {
+	if lndNode.Name == "" {
+		return errors.New("name of the LND node cannot be empty")
+	}
+
+	names := map[string]bool{
+		lndNode.Name: true,
+	}
+
+	for _, node := range nodes {
+		if node.Name == "" {
+			return errors.New("every node of the [[NODES]] section needs a name")
+		}
+
+		if names[node.Name] {
+			return errors.New("node name " + node.Name + " is used more than once")
+		}
+
+		names[node.Name] = true
+	}
+
+	return nil
+}
+
 func createDirIfNotExists(dir string) {
 	if !utils.FileExists(dir) {
 		err := os.Mkdir(dir, 0700)
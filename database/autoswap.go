@@ -0,0 +1,53 @@
+package database
+
+import (
+	"time"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+)
+
+// AutoSwap records a Swap or Reverse Swap that was created by the autoswap subsystem
+type AutoSwap struct {
+	Id            string
+	Type          SwapType
+	Amount        uint64
+	FeeEstimation uint64
+	CreatedAt     time.Time
+}
+
+func (database *Database) CreateAutoSwap(autoSwap AutoSwap) error {
+	insertStatement := "INSERT INTO autoSwaps (id, type, amount, feeEstimation, createdAt) VALUES (?, ?, ?, ?, ?)"
+	_, err := database.db.Exec(
+		insertStatement,
+		autoSwap.Id,
+		autoSwap.Type,
+		autoSwap.Amount,
+		autoSwap.FeeEstimation,
+		autoSwap.CreatedAt.Unix(),
+	)
+
+	return err
+}
+
+// QueryAutoSwapFees returns the sum of the estimated fees of all AutoSwaps created after the specified time
+func (database *Database) QueryAutoSwapFees(since time.Time) (uint64, error) {
+	var fees uint64
+	err := database.db.QueryRow("SELECT COALESCE(SUM(feeEstimation), 0) FROM autoSwaps WHERE createdAt >= ?", since.Unix()).Scan(&fees)
+
+	return fees, err
+}
+
+// QueryPendingAutoSwapCount returns the number of AutoSwaps whose Swap or Reverse Swap is still pending
+func (database *Database) QueryPendingAutoSwapCount() (uint32, error) {
+	var count uint32
+	err := database.db.QueryRow(
+		"SELECT COUNT(*) FROM autoSwaps "+
+			"LEFT JOIN swaps ON autoSwaps.id = swaps.id "+
+			"LEFT JOIN reverseSwaps ON autoSwaps.id = reverseSwaps.id "+
+			"WHERE swaps.state = ? OR reverseSwaps.state = ?",
+		boltzrpc.SwapState_PENDING,
+		boltzrpc.SwapState_PENDING,
+	).Scan(&count)
+
+	return count, err
+}
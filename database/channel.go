@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"errors"
 	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"strconv"
 )
 
 type ChannelCreation struct {
@@ -62,7 +63,7 @@ func parseChannelCreation(rows *sql.Rows) (*ChannelCreation, error) {
 }
 
 func (database *Database) QueryChannelCreation(id string) (channelCreation *ChannelCreation, err error) {
-	rows, err := database.db.Query("SELECT * FROM channelCreations WHERE swapId = '" + id + "'")
+	rows, err := database.db.Query("SELECT * FROM channelCreations WHERE swapId = ?", id)
 
 	if err != nil {
 		return channelCreation, err
@@ -79,15 +80,30 @@ func (database *Database) QueryChannelCreation(id string) (channelCreation *Chan
 	return channelCreation, err
 }
 
-func (database *Database) CreateChannelCreation(channelCreation ChannelCreation) error {
-	insertStatement := "INSERT INTO channelCreations (swapId, status, inboundLiquidity, private, fundingTransactionId, fundingTransactionVout) VALUES (?, ?, ?, ?, ?, ?)"
-	statement, err := database.db.Prepare(insertStatement)
+func (database *Database) QueryChannelCreationByFunding(fundingTransactionId string, fundingTransactionVout uint32) (*ChannelCreation, error) {
+	rows, err := database.db.Query(
+		"SELECT * FROM channelCreations WHERE fundingTransactionId = ? AND fundingTransactionVout = ?",
+		fundingTransactionId,
+		fundingTransactionVout,
+	)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = statement.Exec(
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, errors.New("could not find Channel Creation with funding " + fundingTransactionId + ":" + strconv.FormatUint(uint64(fundingTransactionVout), 10))
+	}
+
+	return parseChannelCreation(rows)
+}
+
+func (database *Database) CreateChannelCreation(channelCreation ChannelCreation) error {
+	insertStatement := "INSERT INTO channelCreations (swapId, status, inboundLiquidity, private, fundingTransactionId, fundingTransactionVout) VALUES (?, ?, ?, ?, ?, ?)"
+	_, err := database.db.Exec(
+		insertStatement,
 		channelCreation.SwapId,
 		channelCreation.Status.String(),
 		channelCreation.InboundLiquidity,
@@ -96,11 +112,7 @@ func (database *Database) CreateChannelCreation(channelCreation ChannelCreation)
 		channelCreation.FundingTransactionVout,
 	)
 
-	if err != nil {
-		return err
-	}
-
-	return statement.Close()
+	return err
 }
 
 func (database *Database) SetChannelFunding(channelCreation *ChannelCreation, fundingTransactionId string, fundingTransactionVout uint32) error {
@@ -108,20 +120,33 @@ func (database *Database) SetChannelFunding(channelCreation *ChannelCreation, fu
 	channelCreation.FundingTransactionId = fundingTransactionId
 	channelCreation.FundingTransactionVout = fundingTransactionVout
 
-	_, err := database.db.Exec(
-		"UPDATE channelCreations SET status = ?, fundingTransactionId = ?, fundingTransactionVout = ? WHERE swapId = ?",
-		boltz.ChannelAccepted.String(),
-		fundingTransactionId,
-		fundingTransactionVout,
-		channelCreation.SwapId,
-	)
-
-	return err
+	return database.runUpdate(func(database *Database) error {
+		_, err := database.db.Exec(
+			"UPDATE channelCreations SET status = ?, fundingTransactionId = ?, fundingTransactionVout = ? WHERE swapId = ?",
+			boltz.ChannelAccepted.String(),
+			fundingTransactionId,
+			fundingTransactionVout,
+			channelCreation.SwapId,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		return database.emitChannelCreation(channelCreation)
+	})
 }
 
 func (database *Database) UpdateChannelCreationStatus(channelCreation *ChannelCreation, status boltz.ChannelState) error {
 	channelCreation.Status = status
 
-	_, err := database.db.Exec("UPDATE channelCreations SET status = '" + status.String() + "' WHERE swapId = '" + channelCreation.SwapId + "'")
-	return err
+	return database.runUpdate(func(database *Database) error {
+		_, err := database.db.Exec("UPDATE channelCreations SET status = ? WHERE swapId = ?", status.String(), channelCreation.SwapId)
+
+		if err != nil {
+			return err
+		}
+
+		return database.emitChannelCreation(channelCreation)
+	})
 }
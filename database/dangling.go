@@ -0,0 +1,112 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+)
+
+// DanglingChannel is a channel Boltz opened for a Channel Creation that failed afterwards. The nursery closes it once
+// the grace period after its detection is over
+type DanglingChannel struct {
+	SwapId       string
+	ChannelPoint string
+
+	// Name of the LND node to which the channel was opened. Empty for the node of the [LND] section
+	Node string
+
+	// Block height at which the nursery found the channel
+	DetectionHeight uint32
+
+	// Empty until the channel is closed
+	ClosingTransactionId string
+	ForceClosed          bool
+}
+
+func parseDanglingChannel(rows *sql.Rows) (*DanglingChannel, error) {
+	var danglingChannel DanglingChannel
+
+	err := scanRow(
+		rows,
+		map[string]interface{}{
+			"swapId":               &danglingChannel.SwapId,
+			"channelPoint":         &danglingChannel.ChannelPoint,
+			"node":                 &danglingChannel.Node,
+			"detectionHeight":      &danglingChannel.DetectionHeight,
+			"closingTransactionId": &danglingChannel.ClosingTransactionId,
+			"forceClosed":          &danglingChannel.ForceClosed,
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &danglingChannel, nil
+}
+
+func (database *Database) QueryDanglingChannel(swapId string) (*DanglingChannel, error) {
+	rows, err := database.db.Query("SELECT * FROM danglingChannels WHERE swapId = ?", swapId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, errors.New("could not find dangling channel of Channel Creation " + swapId)
+	}
+
+	return parseDanglingChannel(rows)
+}
+
+func (database *Database) QueryDanglingChannels() (danglingChannels []DanglingChannel, err error) {
+	rows, err := database.db.Query("SELECT * FROM danglingChannels")
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		danglingChannel, err := parseDanglingChannel(rows)
+
+		if err != nil {
+			return nil, err
+		}
+
+		danglingChannels = append(danglingChannels, *danglingChannel)
+	}
+
+	return danglingChannels, nil
+}
+
+func (database *Database) CreateDanglingChannel(danglingChannel DanglingChannel) error {
+	insertStatement := "INSERT INTO danglingChannels (swapId, channelPoint, node, detectionHeight, closingTransactionId, forceClosed) VALUES (?, ?, ?, ?, ?, ?)"
+	_, err := database.db.Exec(
+		insertStatement,
+		danglingChannel.SwapId,
+		danglingChannel.ChannelPoint,
+		danglingChannel.Node,
+		danglingChannel.DetectionHeight,
+		danglingChannel.ClosingTransactionId,
+		danglingChannel.ForceClosed,
+	)
+
+	return err
+}
+
+func (database *Database) SetDanglingChannelClosed(danglingChannel *DanglingChannel, closingTransactionId string, forceClosed bool) error {
+	danglingChannel.ClosingTransactionId = closingTransactionId
+	danglingChannel.ForceClosed = forceClosed
+
+	_, err := database.db.Exec(
+		"UPDATE danglingChannels SET closingTransactionId = ?, forceClosed = ? WHERE swapId = ?",
+		closingTransactionId,
+		forceClosed,
+		danglingChannel.SwapId,
+	)
+
+	return err
+}
@@ -0,0 +1,59 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDanglingChannels(t *testing.T) {
+	database, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	danglingChannel := DanglingChannel{
+		SwapId:          "channel",
+		ChannelPoint:    "2f3bd2c5e2f9c0d79b3d4b5b6e8ef3f8f5e1a0b4c9d8e7f6a5b4c3d2e1f0a9b8:1",
+		Node:            "second",
+		DetectionHeight: 210,
+	}
+	assert.Nil(t, database.CreateDanglingChannel(danglingChannel))
+
+	queried, err := database.QueryDanglingChannel(danglingChannel.SwapId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, danglingChannel, *queried)
+
+	assert.Nil(t, database.SetDanglingChannelClosed(queried, "closing", true))
+
+	danglingChannels, err := database.QueryDanglingChannels()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []DanglingChannel{*queried}, danglingChannels)
+	assert.Equal(t, "closing", danglingChannels[0].ClosingTransactionId)
+	assert.True(t, danglingChannels[0].ForceClosed)
+
+	_, err = database.QueryDanglingChannel("other")
+	assert.Equal(t, "could not find dangling channel of Channel Creation other", err.Error())
+}
+
+func TestQueryChannelCreationByFunding(t *testing.T) {
+	database, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	channelCreation := ChannelCreation{
+		SwapId:           "channel",
+		Status:           boltz.ChannelNone,
+		InboundLiquidity: 25,
+	}
+	assert.Nil(t, database.CreateChannelCreation(channelCreation))
+	assert.Nil(t, database.SetChannelFunding(&channelCreation, "funding", 1))
+
+	queried, err := database.QueryChannelCreationByFunding("funding", 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, channelCreation, *queried)
+
+	_, err = database.QueryChannelCreationByFunding("funding", 0)
+	assert.Equal(t, "could not find Channel Creation with funding funding:0", err.Error())
+}
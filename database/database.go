@@ -1,30 +1,64 @@
 package database
 
 import (
+	"crypto/cipher"
 	"database/sql"
 	"encoding/hex"
+	"errors"
 	"github.com/BoltzExchange/boltz-lnd/logger"
 	"github.com/btcsuite/btcd/btcec"
-	_ "github.com/mattn/go-sqlite3"
+	"strconv"
 )
 
-// TODO: prepare insert statements only once
-
 type Database struct {
-	Path string `long:"database.path" description:"Path to the database file"`
+	Backend string `long:"database.backend" description:"Backend in which the data is stored. Options: \"sqlite\" and \"postgres\""`
+
+	Path string `long:"database.path" description:"Path to the SQLite database file"`
+
+	PostgresHost     string `long:"database.postgres.host" description:"Host of the PostgreSQL server"`
+	PostgresPort     int    `long:"database.postgres.port" description:"Port of the PostgreSQL server"`
+	PostgresUser     string `long:"database.postgres.user" description:"User with which boltz-lnd authenticates to PostgreSQL"`
+	PostgresPassword string `long:"database.postgres.password" description:"Password of the PostgreSQL user" json:"-"`
+	PostgresDatabase string `long:"database.postgres.database" description:"Name of the PostgreSQL database"`
+	PostgresSslMode  string `long:"database.postgres.sslmode" description:"SSL mode of the connection to PostgreSQL"`
+
+	Encrypt bool `long:"database.encrypt" description:"Encrypts private keys, preimages and Macaroon root keys with a passphrase that has to be provided with the unlock RPC on every start"`
+
+	// Set once the database was unlocked with the passphrase
+	aead cipher.AEAD
+
+	pool *db
+	db   querier
 
-	db *sql.DB
+	swapEvents *swapEventSubscribers
+
+	// Not nil in transactions, in which SwapEvents are only emitted after the commit
+	pendingEvents *[]SwapEvent
 }
 
 func (database *Database) Connect() error {
-	logger.Info("Opening database: " + database.Path)
-	db, err := sql.Open("sqlite3", database.Path)
+	dialect, dataSource, err := database.getDialect()
 
 	if err != nil {
 		return err
 	}
 
-	database.db = db
+	if database.Backend == PostgresBackend {
+		logger.Info("Connecting to PostgreSQL database " + database.PostgresDatabase + " at " +
+			database.PostgresHost + ":" + strconv.Itoa(database.PostgresPort))
+	} else {
+		logger.Info("Opening database: " + database.Path)
+	}
+
+	sqlDb, err := sql.Open(dialect.driverName(), dataSource)
+
+	if err != nil {
+		return err
+	}
+
+	database.pool = newDb(sqlDb, dialect)
+	database.db = database.pool
+	database.swapEvents = &swapEventSubscribers{}
 
 	err = database.createTables()
 
@@ -35,36 +69,121 @@ func (database *Database) Connect() error {
 	return database.migrate()
 }
 
+// ConnectReadOnly opens an existing SQLite database without creating tables or migrating it, so that tools which only
+// read from it do not change the file. The schema has to be the latest one already because the queries rely on it
+func (database *Database) ConnectReadOnly() error {
+	if database.Backend != "" && database.Backend != SqliteBackend {
+		return errors.New("only SQLite databases can be opened read only")
+	}
+
+	logger.Info("Opening database read only: " + database.Path)
+
+	dialect := sqliteDialect{}
+	sqlDb, err := sql.Open(dialect.driverName(), "file:"+database.Path+"?mode=ro")
+
+	if err != nil {
+		return err
+	}
+
+	database.pool = newDb(sqlDb, dialect)
+	database.db = database.pool
+	database.swapEvents = &swapEventSubscribers{}
+
+	version, err := database.queryVersion()
+
+	if err != nil {
+		return err
+	}
+
+	if version != latestSchemaVersion {
+		return errors.New("database schema version " + strconv.Itoa(version) + " is not the latest version " +
+			strconv.Itoa(latestSchemaVersion) + ", start boltzd once to migrate it")
+	}
+
+	return nil
+}
+
 func (database *Database) createTables() error {
-	_, err := database.db.Exec("CREATE TABLE IF NOT EXISTS version (version INT)")
+	_, err := database.createTable("CREATE TABLE IF NOT EXISTS version (version INT)")
+
+	if err != nil {
+		return err
+	}
+
+	_, err = database.createTable("CREATE TABLE IF NOT EXISTS macaroons (id VARCHAR PRIMARY KEY, rootKey VARCHAR)")
+
+	if err != nil {
+		return err
+	}
+
+	_, err = database.createTable("CREATE TABLE IF NOT EXISTS swaps (id VARCHAR PRIMARY KEY, state INT, error VARCHAR, status VARCHAR, privateKey VARCHAR, preimage VARCHAR, redeemScript VARCHAR, invoice VARCHAR, address VARCHAR, expectedAmount INT, timeoutBlockheight INTEGER, lockupTransactionId VARCHAR, refundTransactionId VARCHAR, blindingKey VARCHAR, refundAddress VARCHAR, outputType INT, currency VARCHAR, node VARCHAR, keyIndex INT DEFAULT -1)")
 
 	if err != nil {
 		return err
 	}
 
-	_, err = database.db.Exec("CREATE TABLE IF NOT EXISTS macaroons (id VARCHAR PRIMARY KEY, rootKey VARCHAR)")
+	_, err = database.createTable("CREATE TABLE IF NOT EXISTS reverseSwaps (id VARCHAR PRIMARY KEY, state INT, error VARCHAR, status VARCHAR, acceptZeroConf BOOLEAN, privateKey VARCHAR, preimage VARCHAR, redeemScript VARCHAR, invoice VARCHAR, claimAddress VARCHAR, expectedAmount INT, timeoutBlockheight INTEGER, lockupTransactionId VARCHAR, claimTransactionId VARCHAR, blindingKey VARCHAR, currency VARCHAR, node VARCHAR, keyIndex INT DEFAULT -1)")
 
 	if err != nil {
 		return err
 	}
 
-	_, err = database.db.Exec("CREATE TABLE IF NOT EXISTS swaps (id VARCHAR PRIMARY KEY, state INT, error VARCHAR, status VARCHAR, privateKey VARCHAR, preimage VARCHAR, redeemScript VARCHAR, invoice VARCHAR, address VARCHAR, expectedAmount INT, timeoutBlockheight INTEGER, lockupTransactionId VARCHAR, refundTransactionId VARCHAR)")
+	_, err = database.createTable("CREATE TABLE IF NOT EXISTS channelCreations (swapId VARCHAR PRIMARY KEY, status VARCHAR, inboundLiquidity INT, private BOOLEAN, fundingTransactionId VARCHAR, fundingTransactionVout INT)")
 
 	if err != nil {
 		return err
 	}
 
-	_, err = database.db.Exec("CREATE TABLE IF NOT EXISTS reverseSwaps (id VARCHAR PRIMARY KEY, state INT, error VARCHAR, status VARCHAR, acceptZeroConf BOOLEAN, privateKey VARCHAR, preimage VARCHAR, redeemScript VARCHAR, invoice VARCHAR, claimAddress VARCHAR, expectedAmount INT, timeoutBlockheight INTEGER, lockupTransactionId VARCHAR, claimTransactionId VARCHAR)")
+	_, err = database.createTable("CREATE TABLE IF NOT EXISTS danglingChannels (swapId VARCHAR PRIMARY KEY, channelPoint VARCHAR, node VARCHAR, detectionHeight INT, closingTransactionId VARCHAR, forceClosed BOOLEAN)")
 
 	if err != nil {
 		return err
 	}
 
-	_, err = database.db.Exec("CREATE TABLE IF NOT EXISTS channelCreations (swapId VARCHAR PRIMARY KEY, status VARCHAR, inboundLiquidity INT, private BOOLEAN, fundingTransactionId VARCHAR, fundingTransactionVout INT)")
+	_, err = database.createTable("CREATE TABLE IF NOT EXISTS autoSwaps (id VARCHAR PRIMARY KEY, type INT, amount INT, feeEstimation INT, createdAt INT)")
+
+	if err != nil {
+		return err
+	}
+
+	_, err = database.createTable("CREATE TABLE IF NOT EXISTS pendingTransactions (id VARCHAR PRIMARY KEY, type INT, swapIds VARCHAR, address VARCHAR, feeSatPerVbyte INT, broadcastHeight INT, node VARCHAR)")
+
+	if err != nil {
+		return err
+	}
+
+	_, err = database.createTable("CREATE TABLE IF NOT EXISTS ledger (swapId VARCHAR PRIMARY KEY, type INT, node VARCHAR, onchainCurrency VARCHAR, lightningCurrency VARCHAR, amountSent INT, amountReceived INT, serviceFee INT, boltzMinerFee INT, minerFee INT, routingFeeMsat INT, createdAt INT, updatedAt INT)")
+
+	if err != nil {
+		return err
+	}
+
+	_, err = database.createTable("CREATE TABLE IF NOT EXISTS encryption (salt VARCHAR, verification VARCHAR)")
+
+	if err != nil {
+		return err
+	}
+
+	_, err = database.createTable("CREATE TABLE IF NOT EXISTS seed (id INT PRIMARY KEY, mnemonic VARCHAR, nextKeyIndex INT)")
+
+	if err != nil {
+		return err
+	}
+
+	_, err = database.createTable("CREATE TABLE IF NOT EXISTS webhookNotifications (id INTEGER PRIMARY KEY AUTOINCREMENT, url VARCHAR, swapId VARCHAR, event VARCHAR, transactionId VARCHAR, payload VARCHAR, attempts INT, nextAttempt INT, delivered BOOLEAN, failed BOOLEAN, UNIQUE (url, swapId, event, transactionId))")
 
 	return err
 }
 
+func (database *Database) createTable(statement string) (sql.Result, error) {
+	return database.pool.execSchema(statement)
+}
+
+// Migrations have to change tables with the same column types of the backend as createTable
+func (database *Database) alterTable(statement string) (sql.Result, error) {
+	return database.pool.execSchema(statement)
+}
+
 func parsePrivateKey(privateKeyBytes []byte) (*btcec.PrivateKey, *btcec.PublicKey) {
 	return btcec.PrivKeyFromBytes(btcec.S256(), privateKeyBytes)
 }
@@ -72,3 +191,27 @@ func parsePrivateKey(privateKeyBytes []byte) (*btcec.PrivateKey, *btcec.PublicKe
 func formatPrivateKey(key *btcec.PrivateKey) string {
 	return hex.EncodeToString(key.Serialize())
 }
+
+// Blinding keys are only set for swaps on Liquid
+func parseBlindingKey(blindingKey string) (*btcec.PrivateKey, error) {
+	if blindingKey == "" {
+		return nil, nil
+	}
+
+	blindingKeyBytes, err := hex.DecodeString(blindingKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, _ := parsePrivateKey(blindingKeyBytes)
+	return privateKey, nil
+}
+
+func formatBlindingKey(key *btcec.PrivateKey) string {
+	if key == nil {
+		return ""
+	}
+
+	return formatPrivateKey(key)
+}
@@ -0,0 +1,48 @@
+package database
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectReadOnly(t *testing.T) {
+	database, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	assert.Nil(t, database.CreateSwap(Swap{Id: "swap", State: boltzrpc.SwapState_PENDING, PrivateKey: privateKey}))
+
+	file, err := ioutil.ReadFile(database.Path)
+	assert.Nil(t, err)
+
+	readOnly := &Database{Path: database.Path}
+	assert.Nil(t, readOnly.ConnectReadOnly())
+
+	swap, err := readOnly.QuerySwap("swap")
+
+	assert.Nil(t, err)
+	assert.Equal(t, privateKey, swap.PrivateKey)
+
+	_, err = readOnly.db.Exec("UPDATE swaps SET state = ?", boltzrpc.SwapState_ERROR)
+	assert.NotNil(t, err)
+
+	readFile, err := ioutil.ReadFile(database.Path)
+
+	assert.Nil(t, err)
+	assert.Equal(t, file, readFile)
+
+	// Databases with an outdated schema would have to be migrated, which is a write
+	_, err = database.db.Exec("UPDATE version SET version = ?", latestSchemaVersion-1)
+	assert.Nil(t, err)
+
+	readOnly = &Database{Path: database.Path}
+	assert.Contains(t, readOnly.ConnectReadOnly().Error(), "is not the latest version")
+
+	assert.Equal(t, "only SQLite databases can be opened read only", (&Database{Backend: PostgresBackend}).ConnectReadOnly().Error())
+}
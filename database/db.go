@@ -0,0 +1,189 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"sync"
+)
+
+// querier is implemented by the connection pool and by transactions. All queries of the package go through it
+type querier interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// db prepares every query only once for the dialect of the backend and reuses the statement afterwards
+type db struct {
+	*sql.DB
+
+	dialect dialect
+
+	statementsLock sync.Mutex
+	statements     map[string]*sql.Stmt
+}
+
+func newDb(sqlDb *sql.DB, dialect dialect) *db {
+	return &db{
+		DB:         sqlDb,
+		dialect:    dialect,
+		statements: make(map[string]*sql.Stmt),
+	}
+}
+
+func (db *db) prepare(query string) (*sql.Stmt, error) {
+	db.statementsLock.Lock()
+	defer db.statementsLock.Unlock()
+
+	if statement, isPrepared := db.statements[query]; isPrepared {
+		return statement, nil
+	}
+
+	statement, err := db.DB.Prepare(db.dialect.rebind(query))
+
+	if err != nil {
+		return nil, err
+	}
+
+	db.statements[query] = statement
+
+	return statement, nil
+}
+
+func (db *db) Exec(query string, args ...interface{}) (sql.Result, error) {
+	statement, err := db.prepare(query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return statement.Exec(args...)
+}
+
+func (db *db) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	statement, err := db.prepare(query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return statement.Query(args...)
+}
+
+func (db *db) QueryRow(query string, args ...interface{}) *sql.Row {
+	statement, err := db.prepare(query)
+
+	// Running the query unprepared yields a row that returns the error when it is scanned
+	if err != nil {
+		return db.DB.QueryRow(db.dialect.rebind(query), args...)
+	}
+
+	return statement.QueryRow(args...)
+}
+
+// execSchema runs statements that change the schema. Those are not cached because they are run only once
+func (db *db) execSchema(statement string) (sql.Result, error) {
+	return db.DB.Exec(db.dialect.translateSchema(statement))
+}
+
+func (db *db) Close() error {
+	db.statementsLock.Lock()
+
+	for query, statement := range db.statements {
+		_ = statement.Close()
+		delete(db.statements, query)
+	}
+
+	db.statementsLock.Unlock()
+
+	return db.DB.Close()
+}
+
+// tx runs the prepared statements of the pool in a transaction
+type tx struct {
+	*sql.Tx
+
+	db *db
+}
+
+func (tx *tx) Exec(query string, args ...interface{}) (sql.Result, error) {
+	statement, err := tx.db.prepare(query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Stmt(statement).Exec(args...)
+}
+
+func (tx *tx) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	statement, err := tx.db.prepare(query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Stmt(statement).Query(args...)
+}
+
+func (tx *tx) QueryRow(query string, args ...interface{}) *sql.Row {
+	statement, err := tx.db.prepare(query)
+
+	if err != nil {
+		return tx.Tx.QueryRow(tx.db.dialect.rebind(query), args...)
+	}
+
+	return tx.Stmt(statement).QueryRow(args...)
+}
+
+// Transaction has all methods of the Database but runs them in a single database transaction
+type Transaction struct {
+	Database
+}
+
+var errNestedTransaction = errors.New("transactions cannot be nested")
+
+// RunTx runs the function in a transaction that is committed when it returns no error and rolled back otherwise.
+// SwapEvents of the updates in the transaction are emitted only after it was committed
+func (database *Database) RunTx(run func(transaction *Transaction) error) error {
+	if database.pendingEvents != nil {
+		return errNestedTransaction
+	}
+
+	sqlTx, err := database.pool.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	var pendingEvents []SwapEvent
+
+	transaction := &Transaction{
+		Database: Database{
+			pool:          database.pool,
+			db:            &tx{Tx: sqlTx, db: database.pool},
+			swapEvents:    database.swapEvents,
+			pendingEvents: &pendingEvents,
+			aead:          database.aead,
+		},
+	}
+
+	err = run(transaction)
+
+	if err != nil {
+		_ = sqlTx.Rollback()
+		return err
+	}
+
+	err = sqlTx.Commit()
+
+	if err != nil {
+		return err
+	}
+
+	for _, event := range pendingEvents {
+		database.emitSwapEvent(event)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package database
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunTx(t *testing.T) {
+	database, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	swap := Swap{
+		Id:         "swap",
+		State:      boltzrpc.SwapState_PENDING,
+		PrivateKey: privateKey,
+	}
+	assert.Nil(t, database.CreateSwap(swap))
+
+	events, unsubscribe := database.SubscribeSwapEvents()
+	defer unsubscribe()
+
+	// Nothing of a transaction that failed is persisted or emitted
+	errFailed := errors.New("failed")
+
+	err = database.RunTx(func(transaction *Transaction) error {
+		assert.Nil(t, transaction.SetSwapLockupTransactionId(&swap, "lockup"))
+		assert.Nil(t, transaction.UpdateSwapStatus(&swap, boltz.TransactionMempool))
+
+		return errFailed
+	})
+	assert.Equal(t, errFailed, err)
+
+	queriedSwap, err := database.QuerySwap(swap.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, "", queriedSwap.LockupTransactionId)
+	assert.Equal(t, boltz.SwapCreated, queriedSwap.Status)
+	assert.Len(t, events, 0)
+
+	// Events of a committed transaction are emitted after the commit
+	err = database.RunTx(func(transaction *Transaction) error {
+		assert.Nil(t, transaction.SetSwapLockupTransactionId(queriedSwap, "lockup"))
+		assert.Nil(t, transaction.UpdateSwapStatus(queriedSwap, boltz.TransactionConfirmed))
+		assert.Nil(t, transaction.UpdateSwapState(queriedSwap, boltzrpc.SwapState_SUCCESSFUL, ""))
+
+		assert.Len(t, events, 0)
+
+		return nil
+	})
+	assert.Nil(t, err)
+
+	queriedSwap, err = database.QuerySwap(swap.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, "lockup", queriedSwap.LockupTransactionId)
+	assert.Equal(t, boltz.TransactionConfirmed, queriedSwap.Status)
+	assert.Equal(t, boltzrpc.SwapState_SUCCESSFUL, queriedSwap.State)
+
+	assert.Len(t, events, 2)
+	assert.Equal(t, boltz.TransactionConfirmed, (<-events).Swap.Status)
+	assert.Equal(t, boltzrpc.SwapState_SUCCESSFUL, (<-events).Swap.State)
+
+	err = database.RunTx(func(transaction *Transaction) error {
+		return transaction.RunTx(func(*Transaction) error {
+			return nil
+		})
+	})
+	assert.Equal(t, errNestedTransaction, err)
+}
@@ -0,0 +1,121 @@
+package database
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const (
+	SqliteBackend   = "sqlite"
+	PostgresBackend = "postgres"
+)
+
+// dialect covers everything in which the SQL of the supported database backends differs. Queries and table
+// definitions are written for SQLite and translated by the dialect of the backend
+type dialect interface {
+	driverName() string
+
+	// rebind replaces the "?" placeholders of a query with the ones of the backend. Quoted literals and identifiers
+	// are left as they are
+	rebind(query string) string
+
+	// translateSchema adjusts the column types of a table definition
+	translateSchema(statement string) string
+}
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) driverName() string {
+	return "sqlite3"
+}
+
+func (sqliteDialect) rebind(query string) string {
+	return query
+}
+
+func (sqliteDialect) translateSchema(statement string) string {
+	return statement
+}
+
+type postgresDialect struct{}
+
+var postgresIntegerType = regexp.MustCompile(`\bINT(EGER)?\b`)
+
+func (postgresDialect) driverName() string {
+	return "postgres"
+}
+
+func (postgresDialect) rebind(query string) string {
+	var rebound strings.Builder
+	placeholder := 0
+
+	// Quote with which the current literal or identifier was opened. Escaped quotes inside of it close and reopen it
+	var quote rune
+
+	for _, character := range query {
+		if quote != 0 {
+			if character == quote {
+				quote = 0
+			}
+
+			rebound.WriteRune(character)
+			continue
+		}
+
+		if character == '\'' || character == '"' {
+			quote = character
+		}
+
+		if character != '?' {
+			rebound.WriteRune(character)
+			continue
+		}
+
+		placeholder++
+		rebound.WriteString("$" + strconv.Itoa(placeholder))
+	}
+
+	return rebound.String()
+}
+
+// Integers in PostgreSQL are only 32 bits long, which is not enough for amounts and timestamps
+func (postgresDialect) translateSchema(statement string) string {
+	statement = strings.ReplaceAll(statement, "INTEGER PRIMARY KEY AUTOINCREMENT", "BIGSERIAL PRIMARY KEY")
+	return postgresIntegerType.ReplaceAllString(statement, "BIGINT")
+}
+
+func (database *Database) getDialect() (dialect, string, error) {
+	switch database.Backend {
+	case "", SqliteBackend:
+		return sqliteDialect{}, database.Path, nil
+
+	case PostgresBackend:
+		return postgresDialect{}, database.postgresDataSource(), nil
+
+	default:
+		return nil, "", errors.New("unknown database backend: " + database.Backend)
+	}
+}
+
+func (database *Database) postgresDataSource() string {
+	dataSource := url.URL{
+		Scheme: "postgres",
+		Host:   database.PostgresHost + ":" + strconv.Itoa(database.PostgresPort),
+		Path:   "/" + database.PostgresDatabase,
+		RawQuery: url.Values{
+			"sslmode": []string{database.PostgresSslMode},
+		}.Encode(),
+	}
+
+	if database.PostgresUser != "" {
+		dataSource.User = url.UserPassword(database.PostgresUser, database.PostgresPassword)
+	}
+
+	return dataSource.String()
+}
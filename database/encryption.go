@@ -0,0 +1,349 @@
+package database
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/BoltzExchange/boltz-lnd/logger"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Encrypted values are prefixed to tell them apart from the hex encoded plaintext that was written before the
+// encryption was enabled
+const encryptedValuePrefix = "encrypted:"
+
+// Known plaintext that is encrypted with the key of the passphrase to verify passphrases when unlocking
+const passphraseVerification = "boltz-lnd"
+
+const (
+	saltLength = 32
+
+	scryptN      = 32768
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+)
+
+var ErrLocked = errors.New("database is locked")
+
+var ErrNoPassphrase = errors.New("database has no passphrase yet")
+
+var errInvalidPassphrase = errors.New("invalid passphrase")
+
+// Columns with private keys, preimages, Macaroon root keys and the mnemonic of the seed, which are encrypted when the
+// encryption is enabled
+var encryptedColumns = []struct {
+	table    string
+	idColumn string
+	columns  []string
+}{
+	{table: "swaps", idColumn: "id", columns: []string{"privateKey", "preimage"}},
+	{table: "reverseSwaps", idColumn: "id", columns: []string{"privateKey", "preimage"}},
+	{table: "macaroons", idColumn: "id", columns: []string{"rootKey"}},
+	{table: "seed", idColumn: "id", columns: []string{"mnemonic"}},
+}
+
+// IsLocked returns whether the database is encrypted, or encryption is enabled, and the passphrase was not provided yet
+func (database *Database) IsLocked() (bool, error) {
+	if database.aead != nil {
+		return false, nil
+	}
+
+	if database.Encrypt {
+		return true, nil
+	}
+
+	_, _, err := database.queryEncryption()
+
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// IsEncrypted returns whether secrets are written encrypted, which is the case once an encrypted database was unlocked
+func (database *Database) IsEncrypted() bool {
+	return database.aead != nil || database.Encrypt
+}
+
+// SetPassphrase sets the passphrase of a database that is not encrypted yet and encrypts all existing secrets
+func (database *Database) SetPassphrase(passphrase string) error {
+	err := database.checkPassphrase(passphrase)
+
+	if err != nil {
+		return err
+	}
+
+	_, _, err = database.queryEncryption()
+
+	if err == nil {
+		return errors.New("database has a passphrase already")
+	}
+
+	if err != sql.ErrNoRows {
+		return err
+	}
+
+	return database.enableEncryption(passphrase)
+}
+
+// Unlock derives the key with which secrets are encrypted from the passphrase and verifies it against the one that
+// was set with SetPassphrase
+func (database *Database) Unlock(passphrase string) error {
+	err := database.checkPassphrase(passphrase)
+
+	if err != nil {
+		return err
+	}
+
+	salt, verification, err := database.queryEncryption()
+
+	if err == sql.ErrNoRows {
+		return ErrNoPassphrase
+	}
+
+	if err != nil {
+		return err
+	}
+
+	aead, err := deriveAead(passphrase, salt)
+
+	if err != nil {
+		return err
+	}
+
+	decryptedVerification, err := decrypt(aead, verification)
+
+	if err != nil || decryptedVerification != passphraseVerification {
+		return errInvalidPassphrase
+	}
+
+	database.aead = aead
+
+	logger.Info("Unlocked database")
+
+	return nil
+}
+
+func (database *Database) checkPassphrase(passphrase string) error {
+	if passphrase == "" {
+		return errors.New("passphrase cannot be empty")
+	}
+
+	if database.aead != nil {
+		return errors.New("database is unlocked already")
+	}
+
+	return nil
+}
+
+func (database *Database) enableEncryption(passphrase string) error {
+	logger.Info("Encrypting database")
+
+	salt := make([]byte, saltLength)
+	_, err := rand.Read(salt)
+
+	if err != nil {
+		return err
+	}
+
+	aead, err := deriveAead(passphrase, salt)
+
+	if err != nil {
+		return err
+	}
+
+	verification, err := encrypt(aead, passphraseVerification)
+
+	if err != nil {
+		return err
+	}
+
+	database.aead = aead
+
+	err = database.RunTx(func(transaction *Transaction) error {
+		_, err := transaction.db.Exec(
+			"INSERT INTO encryption (salt, verification) VALUES (?, ?)",
+			hex.EncodeToString(salt),
+			verification,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		for _, encrypted := range encryptedColumns {
+			err = transaction.encryptColumns(encrypted.table, encrypted.idColumn, encrypted.columns)
+
+			if err != nil {
+				return errors.New("could not encrypt table " + encrypted.table + ": " + err.Error())
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		database.aead = nil
+		return err
+	}
+
+	logger.Info("Encrypted database")
+
+	return nil
+}
+
+// Encrypts the values of the columns that were written before the encryption was enabled
+func (database *Database) encryptColumns(table string, idColumn string, columns []string) error {
+	rows, err := database.db.Query("SELECT " + idColumn + ", " + strings.Join(columns, ", ") + " FROM " + table)
+
+	if err != nil {
+		return err
+	}
+
+	var rowValues [][]string
+
+	for rows.Next() {
+		values := make([]string, len(columns)+1)
+		valuePointers := make([]interface{}, len(values))
+
+		for i := range values {
+			valuePointers[i] = &values[i]
+		}
+
+		err = rows.Scan(valuePointers...)
+
+		if err != nil {
+			_ = rows.Close()
+			return err
+		}
+
+		rowValues = append(rowValues, values)
+	}
+
+	// Not all backends can run statements while the rows of a query are read in a transaction
+	err = rows.Close()
+
+	if err != nil {
+		return err
+	}
+
+	for _, values := range rowValues {
+		for i, column := range columns {
+			encryptedValue, err := database.encryptValue(values[i+1])
+
+			if err != nil {
+				return err
+			}
+
+			_, err = database.db.Exec("UPDATE "+table+" SET "+column+" = ? WHERE "+idColumn+" = ?", encryptedValue, values[0])
+
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (database *Database) queryEncryption() (salt []byte, verification string, err error) {
+	var encodedSalt string
+
+	err = database.db.QueryRow("SELECT salt, verification FROM encryption").Scan(&encodedSalt, &verification)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	salt, err = hex.DecodeString(encodedSalt)
+
+	return salt, verification, err
+}
+
+// Values are returned as they are when the encryption is not enabled. Empty values and ones that are encrypted already
+// are not encrypted
+func (database *Database) encryptValue(value string) (string, error) {
+	if database.aead == nil {
+		if database.Encrypt {
+			return "", ErrLocked
+		}
+
+		return value, nil
+	}
+
+	if value == "" || strings.HasPrefix(value, encryptedValuePrefix) {
+		return value, nil
+	}
+
+	return encrypt(database.aead, value)
+}
+
+// Values that were written before the encryption was enabled are returned as they are
+func (database *Database) decryptValue(value string) (string, error) {
+	if !strings.HasPrefix(value, encryptedValuePrefix) {
+		return value, nil
+	}
+
+	if database.aead == nil {
+		return "", ErrLocked
+	}
+
+	return decrypt(database.aead, value)
+}
+
+func deriveAead(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, scryptKeyLen)
+
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func encrypt(aead cipher.AEAD, value string) (string, error) {
+	nonce := make([]byte, aead.NonceSize())
+	_, err := rand.Read(nonce)
+
+	if err != nil {
+		return "", err
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(value), nil)
+
+	return encryptedValuePrefix + hex.EncodeToString(sealed), nil
+}
+
+func decrypt(aead cipher.AEAD, value string) (string, error) {
+	sealed, err := hex.DecodeString(strings.TrimPrefix(value, encryptedValuePrefix))
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("encrypted value is too short")
+	}
+
+	nonceSize := aead.NonceSize()
+	plaintext, err := aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
@@ -0,0 +1,134 @@
+package database
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+)
+
+func queryRawValue(t *testing.T, database *Database, query string, args ...interface{}) string {
+	var value string
+	assert.Nil(t, database.db.QueryRow(query, args...).Scan(&value))
+
+	return value
+}
+
+func TestEncryption(t *testing.T) {
+	database, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	isLocked, err := database.IsLocked()
+	assert.Nil(t, err)
+	assert.False(t, isLocked)
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	// Written before the encryption was enabled
+	plaintextSwap := Swap{
+		Id:         "plaintext",
+		PrivateKey: privateKey,
+		Preimage:   []byte{1, 2, 3},
+	}
+	assert.Nil(t, database.CreateSwap(plaintextSwap))
+
+	macaroon := Macaroon{
+		Id:      []byte{0},
+		RootKey: []byte{4, 5, 6},
+	}
+	assert.Nil(t, database.CreateMacaroon(macaroon))
+
+	assert.Equal(t, "010203", queryRawValue(t, database, "SELECT preimage FROM swaps WHERE id = ?", plaintextSwap.Id))
+
+	// Unlocking cannot set the passphrase
+	assert.Equal(t, ErrNoPassphrase, database.Unlock("passphrase"))
+
+	assert.Equal(t, "passphrase cannot be empty", database.SetPassphrase("").Error())
+	assert.Nil(t, database.SetPassphrase("passphrase"))
+	assert.Equal(t, "database is unlocked already", database.SetPassphrase("other").Error())
+	assert.Equal(t, "database is unlocked already", database.Unlock("passphrase").Error())
+
+	// Existing secrets are encrypted when the encryption is enabled
+	for _, query := range []string{
+		"SELECT privateKey FROM swaps WHERE id = ?",
+		"SELECT preimage FROM swaps WHERE id = ?",
+	} {
+		assert.True(t, strings.HasPrefix(queryRawValue(t, database, query, plaintextSwap.Id), encryptedValuePrefix))
+	}
+
+	rawRootKey := queryRawValue(t, database, "SELECT rootKey FROM macaroons WHERE id = ?", hex.EncodeToString(macaroon.Id))
+	assert.True(t, strings.HasPrefix(rawRootKey, encryptedValuePrefix))
+
+	reverseSwap := ReverseSwap{
+		Id:         "reverse",
+		PrivateKey: privateKey,
+		Preimage:   []byte{7, 8, 9},
+	}
+	assert.Nil(t, database.CreateReverseSwap(reverseSwap))
+
+	rawPreimage := queryRawValue(t, database, "SELECT preimage FROM reverseSwaps WHERE id = ?", reverseSwap.Id)
+	assert.True(t, strings.HasPrefix(rawPreimage, encryptedValuePrefix))
+
+	queriedSwap, err := database.QuerySwap(plaintextSwap.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, plaintextSwap.Preimage, queriedSwap.Preimage)
+	assert.Equal(t, privateKey.Serialize(), queriedSwap.PrivateKey.Serialize())
+
+	queriedReverseSwap, err := database.QueryReverseSwap(reverseSwap.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, reverseSwap.Preimage, queriedReverseSwap.Preimage)
+
+	queriedMacaroon, err := database.QueryMacaroon(macaroon.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, macaroon.RootKey, queriedMacaroon.RootKey)
+
+	// A new connection to the encrypted database is locked until the right passphrase is provided
+	lockedDatabase := &Database{
+		Path: database.Path,
+	}
+	assert.Nil(t, lockedDatabase.Connect())
+
+	isLocked, err = lockedDatabase.IsLocked()
+	assert.Nil(t, err)
+	assert.True(t, isLocked)
+
+	_, err = lockedDatabase.QuerySwap(plaintextSwap.Id)
+	assert.Equal(t, ErrLocked, err)
+
+	// The passphrase cannot be replaced by whoever asks first
+	assert.Equal(t, "database has a passphrase already", lockedDatabase.SetPassphrase("other").Error())
+
+	assert.Equal(t, errInvalidPassphrase, lockedDatabase.Unlock("wrong"))
+	assert.Nil(t, lockedDatabase.Unlock("passphrase"))
+
+	isLocked, err = lockedDatabase.IsLocked()
+	assert.Nil(t, err)
+	assert.False(t, isLocked)
+
+	queriedReverseSwap, err = lockedDatabase.QueryReverseSwap(reverseSwap.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, reverseSwap.Preimage, queriedReverseSwap.Preimage)
+}
+
+func TestEncryptionEnabledLocked(t *testing.T) {
+	database, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	database.Encrypt = true
+
+	isLocked, err := database.IsLocked()
+	assert.Nil(t, err)
+	assert.True(t, isLocked)
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	// Nothing can be written in plaintext while the database is locked
+	assert.Equal(t, ErrLocked, database.CreateSwap(Swap{
+		Id:         "swap",
+		PrivateKey: privateKey,
+	}))
+}
@@ -0,0 +1,189 @@
+package database
+
+import (
+	"sync"
+
+	"github.com/BoltzExchange/boltz-lnd/logger"
+)
+
+type SwapType int
+
+const (
+	SubmarineSwap SwapType = iota
+	ReverseSubmarineSwap
+	ChannelCreationSwap
+)
+
+// SwapEvent is emitted whenever the status or state of a Swap, Reverse Swap or Channel Creation is persisted.
+// For Channel Creations both the Swap and the ChannelCreation are set
+type SwapEvent struct {
+	Type SwapType
+	Id   string
+
+	Swap            *Swap
+	ReverseSwap     *ReverseSwap
+	ChannelCreation *ChannelCreation
+}
+
+// Events are dropped for subscribers that are not reading fast enough instead of blocking the nursery
+const swapEventBufferSize = 64
+
+// SwapEventRecorder is called with the SwapEvent of an update in the transaction of that update
+type SwapEventRecorder func(transaction *Database, event SwapEvent) error
+
+type swapEventSubscribers struct {
+	lock        sync.RWMutex
+	nextId      uint64
+	subscribers map[uint64]chan SwapEvent
+
+	recorder SwapEventRecorder
+}
+
+// RecordSwapEvents registers a recorder for all future SwapEvents. Unlike subscribers, the recorder cannot miss events:
+// updates are rolled back when it returns an error
+func (database *Database) RecordSwapEvents(recorder SwapEventRecorder) {
+	database.swapEvents.lock.Lock()
+	database.swapEvents.recorder = recorder
+	database.swapEvents.lock.Unlock()
+}
+
+func (database *Database) getSwapEventRecorder() SwapEventRecorder {
+	database.swapEvents.lock.RLock()
+	defer database.swapEvents.lock.RUnlock()
+
+	return database.swapEvents.recorder
+}
+
+// runUpdate runs an update that emits SwapEvents in a transaction if there is a recorder for them
+func (database *Database) runUpdate(update func(database *Database) error) error {
+	if database.pendingEvents != nil || database.getSwapEventRecorder() == nil {
+		return update(database)
+	}
+
+	return database.RunTx(func(transaction *Transaction) error {
+		return update(&transaction.Database)
+	})
+}
+
+// SubscribeSwapEvents returns a channel for all future SwapEvents and a function to unsubscribe
+func (database *Database) SubscribeSwapEvents() (<-chan SwapEvent, func()) {
+	events := make(chan SwapEvent, swapEventBufferSize)
+
+	database.swapEvents.lock.Lock()
+
+	if database.swapEvents.subscribers == nil {
+		database.swapEvents.subscribers = make(map[uint64]chan SwapEvent)
+	}
+
+	id := database.swapEvents.nextId
+	database.swapEvents.nextId += 1
+	database.swapEvents.subscribers[id] = events
+
+	database.swapEvents.lock.Unlock()
+
+	return events, func() {
+		database.swapEvents.lock.Lock()
+		defer database.swapEvents.lock.Unlock()
+
+		if _, isSubscribed := database.swapEvents.subscribers[id]; isSubscribed {
+			delete(database.swapEvents.subscribers, id)
+			close(events)
+		}
+	}
+}
+
+func (database *Database) hasSwapEventSubscribers() bool {
+	database.swapEvents.lock.RLock()
+	defer database.swapEvents.lock.RUnlock()
+
+	return len(database.swapEvents.subscribers) != 0 || database.swapEvents.recorder != nil
+}
+
+// recordSwapEvent passes the event to the recorder before it is emitted to the subscribers
+func (database *Database) recordSwapEvent(event SwapEvent) error {
+	if recorder := database.getSwapEventRecorder(); recorder != nil {
+		err := recorder(database, event)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	database.emitSwapEvent(event)
+	return nil
+}
+
+func (database *Database) emitSwapEvent(event SwapEvent) {
+	if database.pendingEvents != nil {
+		*database.pendingEvents = append(*database.pendingEvents, event)
+		return
+	}
+
+	database.swapEvents.lock.RLock()
+	defer database.swapEvents.lock.RUnlock()
+
+	for _, subscriber := range database.swapEvents.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+			logger.Warning("Dropped event of " + event.Id + " because subscriber is not reading fast enough")
+		}
+	}
+}
+
+func (database *Database) emitSwap(swap *Swap) error {
+	if !database.hasSwapEventSubscribers() {
+		return nil
+	}
+
+	swapCopy := *swap
+	event := SwapEvent{
+		Type: SubmarineSwap,
+		Id:   swap.Id,
+		Swap: &swapCopy,
+	}
+
+	channelCreation, err := database.QueryChannelCreation(swap.Id)
+
+	if err == nil {
+		event.Type = ChannelCreationSwap
+		event.ChannelCreation = channelCreation
+	}
+
+	return database.recordSwapEvent(event)
+}
+
+func (database *Database) emitReverseSwap(reverseSwap *ReverseSwap) error {
+	if !database.hasSwapEventSubscribers() {
+		return nil
+	}
+
+	reverseSwapCopy := *reverseSwap
+
+	return database.recordSwapEvent(SwapEvent{
+		Type:        ReverseSubmarineSwap,
+		Id:          reverseSwap.Id,
+		ReverseSwap: &reverseSwapCopy,
+	})
+}
+
+func (database *Database) emitChannelCreation(channelCreation *ChannelCreation) error {
+	if !database.hasSwapEventSubscribers() {
+		return nil
+	}
+
+	channelCreationCopy := *channelCreation
+	event := SwapEvent{
+		Type:            ChannelCreationSwap,
+		Id:              channelCreation.SwapId,
+		ChannelCreation: &channelCreationCopy,
+	}
+
+	swap, err := database.QuerySwap(channelCreation.SwapId)
+
+	if err == nil {
+		event.Swap = swap
+	}
+
+	return database.recordSwapEvent(event)
+}
@@ -0,0 +1,121 @@
+package database
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwapEvents(t *testing.T) {
+	database, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	reverseSwap := ReverseSwap{
+		Id:         "reverse",
+		PrivateKey: privateKey,
+	}
+	assert.Nil(t, database.CreateReverseSwap(reverseSwap))
+
+	swap := Swap{
+		Id:         "channel",
+		PrivateKey: privateKey,
+	}
+	assert.Nil(t, database.CreateSwap(swap))
+
+	channelCreation := ChannelCreation{
+		SwapId: swap.Id,
+	}
+	assert.Nil(t, database.CreateChannelCreation(channelCreation))
+
+	events, unsubscribe := database.SubscribeSwapEvents()
+
+	assert.Nil(t, database.UpdateReverseSwapStatus(&reverseSwap, boltz.TransactionMempool))
+
+	event := <-events
+	assert.Equal(t, ReverseSubmarineSwap, event.Type)
+	assert.Equal(t, reverseSwap.Id, event.Id)
+	assert.Equal(t, boltz.TransactionMempool, event.ReverseSwap.Status)
+
+	assert.Nil(t, database.UpdateSwapState(&swap, boltzrpc.SwapState_SUCCESSFUL, ""))
+
+	event = <-events
+	assert.Equal(t, ChannelCreationSwap, event.Type)
+	assert.Equal(t, swap.Id, event.Id)
+	assert.Equal(t, boltzrpc.SwapState_SUCCESSFUL, event.Swap.State)
+	assert.Equal(t, swap.Id, event.ChannelCreation.SwapId)
+
+	assert.Nil(t, database.UpdateChannelCreationStatus(&channelCreation, boltz.ChannelSettled))
+
+	event = <-events
+	assert.Equal(t, ChannelCreationSwap, event.Type)
+	assert.Equal(t, boltz.ChannelSettled, event.ChannelCreation.Status)
+	assert.Equal(t, boltzrpc.SwapState_SUCCESSFUL, event.Swap.State)
+
+	unsubscribe()
+
+	_, isOpen := <-events
+	assert.False(t, isOpen)
+
+	// Updates without subscribers must not block
+	assert.Nil(t, database.UpdateReverseSwapState(&reverseSwap, boltzrpc.SwapState_ERROR, "error"))
+}
+
+func TestRecordSwapEvents(t *testing.T) {
+	database, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	swap := Swap{
+		Id:         "swap",
+		State:      boltzrpc.SwapState_PENDING,
+		PrivateKey: privateKey,
+	}
+	assert.Nil(t, database.CreateSwap(swap))
+
+	recorderErr := errors.New("could not record")
+
+	database.RecordSwapEvents(func(transaction *Database, event SwapEvent) error {
+		assert.NotNil(t, transaction.pendingEvents)
+		assert.Equal(t, swap.Id, event.Id)
+
+		_, err := transaction.CreateWebhookNotification(WebhookNotification{SwapId: event.Id, Event: event.Swap.State.String()})
+
+		if err != nil {
+			return err
+		}
+
+		return recorderErr
+	})
+
+	// Updates are rolled back when their event cannot be recorded
+	assert.Equal(t, recorderErr, database.UpdateSwapState(&swap, boltzrpc.SwapState_ERROR, "error"))
+
+	queriedSwap, err := database.QuerySwap(swap.Id)
+
+	assert.Nil(t, err)
+	assert.Equal(t, boltzrpc.SwapState_PENDING, queriedSwap.State)
+
+	recorderErr = nil
+	assert.Nil(t, database.UpdateSwapState(&swap, boltzrpc.SwapState_ERROR, "error"))
+
+	queriedSwap, err = database.QuerySwap(swap.Id)
+
+	assert.Nil(t, err)
+	assert.Equal(t, boltzrpc.SwapState_ERROR, queriedSwap.State)
+
+	notifications, err := database.QueryDueWebhookNotifications(time.Unix(0, 0))
+
+	assert.Nil(t, err)
+	assert.Len(t, notifications, 1)
+	assert.Equal(t, "ERROR", notifications[0].Event)
+}
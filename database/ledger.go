@@ -0,0 +1,181 @@
+package database
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+)
+
+// LedgerEntry records what a Swap, Reverse Swap or Channel Creation cost. Amounts and fees are in satoshis of the
+// currency they were paid in: onchain ones in the onchain currency and routing fees in the Lightning currency
+type LedgerEntry struct {
+	SwapId string
+	Type   SwapType
+
+	// Name of the LND node of the swap. Empty for the node of the [LND] section
+	Node string
+
+	OnchainCurrency   string
+	LightningCurrency string
+
+	AmountSent     uint64
+	AmountReceived uint64
+
+	// Percentage fee and miner fees Boltz charged for the swap
+	ServiceFee    uint64
+	BoltzMinerFee uint64
+
+	// Fees of the claim and refund transactions that were broadcast for the swap
+	MinerFee       uint64
+	RoutingFeeMsat uint64
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// State of the swap at the time the entry was queried
+	State boltzrpc.SwapState
+}
+
+const ledgerQuery = "SELECT ledger.*, COALESCE(swaps.state, reverseSwaps.state, 0) AS state FROM ledger " +
+	"LEFT JOIN swaps ON ledger.swapId = swaps.id " +
+	"LEFT JOIN reverseSwaps ON ledger.swapId = reverseSwaps.id"
+
+func parseLedgerEntry(rows *sql.Rows) (*LedgerEntry, error) {
+	var entry LedgerEntry
+
+	var createdAt, updatedAt int64
+
+	err := scanRow(
+		rows,
+		map[string]interface{}{
+			"swapId":            &entry.SwapId,
+			"type":              &entry.Type,
+			"node":              &entry.Node,
+			"onchainCurrency":   &entry.OnchainCurrency,
+			"lightningCurrency": &entry.LightningCurrency,
+			"amountSent":        &entry.AmountSent,
+			"amountReceived":    &entry.AmountReceived,
+			"serviceFee":        &entry.ServiceFee,
+			"boltzMinerFee":     &entry.BoltzMinerFee,
+			"minerFee":          &entry.MinerFee,
+			"routingFeeMsat":    &entry.RoutingFeeMsat,
+			"createdAt":         &createdAt,
+			"updatedAt":         &updatedAt,
+			"state":             &entry.State,
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	entry.CreatedAt = time.Unix(createdAt, 0)
+	entry.UpdatedAt = time.Unix(updatedAt, 0)
+
+	return &entry, nil
+}
+
+func (database *Database) QueryLedgerEntry(swapId string) (*LedgerEntry, error) {
+	rows, err := database.db.Query(ledgerQuery+" WHERE ledger.swapId = ?", swapId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, sql.ErrNoRows
+	}
+
+	return parseLedgerEntry(rows)
+}
+
+// QueryLedgerEntries returns the entries of the swaps that were created in the time range. The start is inclusive and
+// the end exclusive
+func (database *Database) QueryLedgerEntries(from time.Time, to time.Time) (entries []LedgerEntry, err error) {
+	rows, err := database.db.Query(
+		ledgerQuery+" WHERE ledger.createdAt >= ? AND ledger.createdAt < ? ORDER BY ledger.createdAt",
+		from.Unix(),
+		to.Unix(),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		entry, err := parseLedgerEntry(rows)
+
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, *entry)
+	}
+
+	return entries, rows.Err()
+}
+
+func (database *Database) CreateLedgerEntry(entry LedgerEntry) error {
+	insertStatement := "INSERT INTO ledger (swapId, type, node, onchainCurrency, lightningCurrency, amountSent, amountReceived, serviceFee, boltzMinerFee, minerFee, routingFeeMsat, createdAt, updatedAt) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	_, err := database.db.Exec(
+		insertStatement,
+		entry.SwapId,
+		entry.Type,
+		entry.Node,
+		entry.OnchainCurrency,
+		entry.LightningCurrency,
+		entry.AmountSent,
+		entry.AmountReceived,
+		entry.ServiceFee,
+		entry.BoltzMinerFee,
+		entry.MinerFee,
+		entry.RoutingFeeMsat,
+		entry.CreatedAt.Unix(),
+		entry.CreatedAt.Unix(),
+	)
+
+	return err
+}
+
+// SetLedgerAmounts sets the amounts of swaps whose amounts are only known once their lockup transaction was found
+func (database *Database) SetLedgerAmounts(swapId string, amountSent uint64, amountReceived uint64, serviceFee uint64, boltzMinerFee uint64) error {
+	_, err := database.db.Exec(
+		"UPDATE ledger SET amountSent = ?, amountReceived = ?, serviceFee = ?, boltzMinerFee = ?, updatedAt = ? WHERE swapId = ?",
+		amountSent,
+		amountReceived,
+		serviceFee,
+		boltzMinerFee,
+		time.Now().Unix(),
+		swapId,
+	)
+
+	return err
+}
+
+// AddLedgerMinerFee adds the fee of a claim or refund transaction to the entry of a swap
+func (database *Database) AddLedgerMinerFee(swapId string, fee uint64) error {
+	_, err := database.db.Exec(
+		"UPDATE ledger SET minerFee = minerFee + ?, updatedAt = ? WHERE swapId = ?",
+		fee,
+		time.Now().Unix(),
+		swapId,
+	)
+
+	return err
+}
+
+func (database *Database) SetLedgerRoutingFee(swapId string, feeMsat uint64) error {
+	_, err := database.db.Exec(
+		"UPDATE ledger SET routingFeeMsat = ?, updatedAt = ? WHERE swapId = ?",
+		feeMsat,
+		time.Now().Unix(),
+		swapId,
+	)
+
+	return err
+}
@@ -0,0 +1,79 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLedger(t *testing.T) {
+	database, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	reverseSwap := ReverseSwap{
+		Id:         "reverse",
+		State:      boltzrpc.SwapState_SUCCESSFUL,
+		PrivateKey: privateKey,
+	}
+	assert.Nil(t, database.CreateReverseSwap(reverseSwap))
+
+	createdAt := time.Unix(1614556800, 0)
+
+	entry := LedgerEntry{
+		SwapId:            reverseSwap.Id,
+		Type:              ReverseSubmarineSwap,
+		OnchainCurrency:   "BTC",
+		LightningCurrency: "BTC",
+		AmountSent:        100000,
+		AmountReceived:    98500,
+		ServiceFee:        500,
+		BoltzMinerFee:     1000,
+		CreatedAt:         createdAt,
+	}
+	assert.Nil(t, database.CreateLedgerEntry(entry))
+
+	assert.Nil(t, database.SetLedgerRoutingFee(reverseSwap.Id, 1234))
+	assert.Nil(t, database.AddLedgerMinerFee(reverseSwap.Id, 150))
+	assert.Nil(t, database.AddLedgerMinerFee(reverseSwap.Id, 50))
+
+	queriedEntry, err := database.QueryLedgerEntry(reverseSwap.Id)
+	assert.Nil(t, err)
+
+	assert.Equal(t, uint64(200), queriedEntry.MinerFee)
+	assert.Equal(t, uint64(1234), queriedEntry.RoutingFeeMsat)
+	assert.Equal(t, createdAt, queriedEntry.CreatedAt)
+	assert.True(t, queriedEntry.UpdatedAt.After(createdAt))
+	assert.Equal(t, boltzrpc.SwapState_SUCCESSFUL, queriedEntry.State)
+
+	// Entries of deposits get their amounts later
+	deposit := LedgerEntry{
+		SwapId:    "deposit",
+		Type:      SubmarineSwap,
+		CreatedAt: createdAt.Add(time.Hour),
+	}
+	assert.Nil(t, database.CreateLedgerEntry(deposit))
+	assert.Nil(t, database.SetLedgerAmounts(deposit.SwapId, 101500, 100000, 1000, 500))
+
+	queriedEntry, err = database.QueryLedgerEntry(deposit.SwapId)
+	assert.Nil(t, err)
+
+	assert.Equal(t, uint64(101500), queriedEntry.AmountSent)
+	assert.Equal(t, uint64(100000), queriedEntry.AmountReceived)
+	assert.Equal(t, uint64(1000), queriedEntry.ServiceFee)
+	assert.Equal(t, uint64(500), queriedEntry.BoltzMinerFee)
+
+	entries, err := database.QueryLedgerEntries(createdAt, createdAt.Add(2*time.Hour))
+	assert.Nil(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, reverseSwap.Id, entries[0].SwapId)
+
+	entries, err = database.QueryLedgerEntries(createdAt, createdAt.Add(time.Hour))
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+}
@@ -11,7 +11,7 @@ type Macaroon struct {
 	RootKey []byte
 }
 
-func parseMacaroon(rows *sql.Rows) (*Macaroon, error) {
+func (database *Database) parseMacaroon(rows *sql.Rows) (*Macaroon, error) {
 	var macaroon Macaroon
 
 	var id string
@@ -35,6 +35,12 @@ func parseMacaroon(rows *sql.Rows) (*Macaroon, error) {
 		return nil, err
 	}
 
+	rootKey, err = database.decryptValue(rootKey)
+
+	if err != nil {
+		return nil, err
+	}
+
 	macaroon.RootKey, err = hex.DecodeString(rootKey)
 
 	if err != nil {
@@ -45,7 +51,7 @@ func parseMacaroon(rows *sql.Rows) (*Macaroon, error) {
 }
 
 func (database *Database) QueryMacaroon(id []byte) (macaroon *Macaroon, err error) {
-	rows, err := database.db.Query("SELECT * FROM macaroons WHERE id = '" + hex.EncodeToString(id) + "'")
+	rows, err := database.db.Query("SELECT * FROM macaroons WHERE id = ?", hex.EncodeToString(id))
 
 	if err != nil {
 		return macaroon, err
@@ -54,7 +60,7 @@ func (database *Database) QueryMacaroon(id []byte) (macaroon *Macaroon, err erro
 	defer rows.Close()
 
 	if rows.Next() {
-		macaroon, err = parseMacaroon(rows)
+		macaroon, err = database.parseMacaroon(rows)
 
 		if err != nil {
 			return macaroon, err
@@ -67,21 +73,18 @@ func (database *Database) QueryMacaroon(id []byte) (macaroon *Macaroon, err erro
 }
 
 func (database *Database) CreateMacaroon(macaroon Macaroon) error {
-	insertStatement := "INSERT INTO macaroons (id, rootKey) VALUES (?, ?)"
-	statement, err := database.db.Prepare(insertStatement)
+	rootKey, err := database.encryptValue(hex.EncodeToString(macaroon.RootKey))
 
 	if err != nil {
 		return err
 	}
 
-	_, err = statement.Exec(
+	insertStatement := "INSERT INTO macaroons (id, rootKey) VALUES (?, ?)"
+	_, err = database.db.Exec(
+		insertStatement,
 		hex.EncodeToString(macaroon.Id),
-		hex.EncodeToString(macaroon.RootKey),
+		rootKey,
 	)
 
-	if err != nil {
-		return err
-	}
-
-	return statement.Close()
+	return err
 }
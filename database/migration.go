@@ -14,7 +14,7 @@ type swapStatus struct {
 	status string
 }
 
-const latestSchemaVersion = 2
+const latestSchemaVersion = 9
 
 func (database *Database) migrate() error {
 	version, err := database.queryVersion()
@@ -43,13 +43,13 @@ func (database *Database) performMigration(fromVersion int) error {
 
 		logger.Info("Migrating table \"swaps\"")
 
-		_, err := database.db.Exec("ALTER TABLE swaps ADD COLUMN state INT")
+		_, err := database.alterTable("ALTER TABLE swaps ADD COLUMN state INT")
 
 		if err != nil {
 			return err
 		}
 
-		_, err = database.db.Exec("ALTER TABLE swaps ADD COLUMN error VARCHAR")
+		_, err = database.alterTable("ALTER TABLE swaps ADD COLUMN error VARCHAR")
 
 		if err != nil {
 			return err
@@ -116,13 +116,13 @@ func (database *Database) performMigration(fromVersion int) error {
 
 		logger.Info("Migrating table \"reverseSwaps\"")
 
-		_, err = database.db.Exec("ALTER TABLE reverseSwaps ADD COLUMN state INT")
+		_, err = database.alterTable("ALTER TABLE reverseSwaps ADD COLUMN state INT")
 
 		if err != nil {
 			return err
 		}
 
-		_, err = database.db.Exec("ALTER TABLE reverseSwaps ADD COLUMN error VARCHAR")
+		_, err = database.alterTable("ALTER TABLE reverseSwaps ADD COLUMN error VARCHAR")
 
 		if err != nil {
 			return err
@@ -185,6 +185,171 @@ func (database *Database) performMigration(fromVersion int) error {
 		logger.Info("Update to database version 2 completed")
 		return database.postMigration(fromVersion)
 
+	case 2:
+		logger.Info("Updating database from version 2 to 3")
+
+		for _, table := range []string{"swaps", "reverseSwaps"} {
+			logger.Info("Migrating table \"" + table + "\"")
+
+			_, err := database.alterTable("ALTER TABLE " + table + " ADD COLUMN blindingKey VARCHAR DEFAULT ''")
+
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := database.db.Exec("UPDATE version SET version = 3 WHERE version = 2")
+		if err != nil {
+			return err
+		}
+
+		logger.Info("Update to database version 3 completed")
+		return database.postMigration(fromVersion)
+
+	case 3:
+		logger.Info("Updating database from version 3 to 4")
+
+		logger.Info("Migrating table \"swaps\"")
+
+		_, err := database.alterTable("ALTER TABLE swaps ADD COLUMN refundAddress VARCHAR DEFAULT ''")
+
+		if err != nil {
+			return err
+		}
+
+		_, err = database.db.Exec("UPDATE version SET version = 4 WHERE version = 3")
+		if err != nil {
+			return err
+		}
+
+		logger.Info("Update to database version 4 completed")
+		return database.postMigration(fromVersion)
+
+	case 4:
+		logger.Info("Updating database from version 4 to 5")
+
+		logger.Info("Migrating table \"swaps\"")
+
+		// All Swaps that were created before the output type was saved have nested P2WSH lockup addresses
+		_, err := database.alterTable("ALTER TABLE swaps ADD COLUMN outputType INT DEFAULT " + strconv.Itoa(int(boltz.Compatibility)))
+
+		if err != nil {
+			return err
+		}
+
+		_, err = database.db.Exec("UPDATE version SET version = 5 WHERE version = 4")
+		if err != nil {
+			return err
+		}
+
+		logger.Info("Update to database version 5 completed")
+		return database.postMigration(fromVersion)
+
+	case 5:
+		logger.Info("Updating database from version 5 to 6")
+
+		// All Swaps and Reverse Swaps that were created before cross chain pairs were supported are on the chain of LND
+		for _, table := range []string{"swaps", "reverseSwaps"} {
+			logger.Info("Migrating table \"" + table + "\"")
+
+			_, err := database.alterTable("ALTER TABLE " + table + " ADD COLUMN currency VARCHAR DEFAULT ''")
+
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := database.db.Exec("UPDATE version SET version = 6 WHERE version = 5")
+		if err != nil {
+			return err
+		}
+
+		logger.Info("Update to database version 6 completed")
+		return database.postMigration(fromVersion)
+
+	case 6:
+		logger.Info("Updating database from version 6 to 7")
+
+		// Everything that was created before multiple nodes were supported belongs to the node of the [LND] section.
+		// The table "pendingTransactions" is not migrated, because it is created with the column before the migration
+		for _, table := range []string{"swaps", "reverseSwaps"} {
+			logger.Info("Migrating table \"" + table + "\"")
+
+			_, err := database.alterTable("ALTER TABLE " + table + " ADD COLUMN node VARCHAR DEFAULT ''")
+
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := database.db.Exec("UPDATE version SET version = 7 WHERE version = 6")
+		if err != nil {
+			return err
+		}
+
+		logger.Info("Update to database version 7 completed")
+		return database.postMigration(fromVersion)
+
+	case 7:
+		logger.Info("Updating database from version 7 to 8")
+
+		// The keys of swaps that were created before they were derived from a seed are random
+		for _, table := range []string{"swaps", "reverseSwaps"} {
+			logger.Info("Migrating table \"" + table + "\"")
+
+			_, err := database.alterTable("ALTER TABLE " + table + " ADD COLUMN keyIndex INT DEFAULT -1")
+
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := database.db.Exec("UPDATE version SET version = 8 WHERE version = 7")
+		if err != nil {
+			return err
+		}
+
+		logger.Info("Update to database version 8 completed")
+		return database.postMigration(fromVersion)
+
+	case 8:
+		logger.Info("Updating database from version 8 to 9")
+		logger.Info("Migrating table \"webhookNotifications\"")
+
+		// The unique constraint cannot be altered, so the table is copied to one with the transaction in the constraint.
+		// The IDs are not copied, because PostgreSQL would not advance the sequence of the new table for them
+		_, err := database.createTable("CREATE TABLE webhookNotificationsMigration (id INTEGER PRIMARY KEY AUTOINCREMENT, url VARCHAR, swapId VARCHAR, event VARCHAR, transactionId VARCHAR, payload VARCHAR, attempts INT, nextAttempt INT, delivered BOOLEAN, failed BOOLEAN, UNIQUE (url, swapId, event, transactionId))")
+
+		if err != nil {
+			return err
+		}
+
+		_, err = database.db.Exec("INSERT INTO webhookNotificationsMigration (url, swapId, event, transactionId, payload, attempts, nextAttempt, delivered, failed) SELECT url, swapId, event, '', payload, attempts, nextAttempt, delivered, failed FROM webhookNotifications ORDER BY id")
+
+		if err != nil {
+			return err
+		}
+
+		_, err = database.alterTable("DROP TABLE webhookNotifications")
+
+		if err != nil {
+			return err
+		}
+
+		_, err = database.alterTable("ALTER TABLE webhookNotificationsMigration RENAME TO webhookNotifications")
+
+		if err != nil {
+			return err
+		}
+
+		_, err = database.db.Exec("UPDATE version SET version = 9 WHERE version = 8")
+		if err != nil {
+			return err
+		}
+
+		logger.Info("Update to database version 9 completed")
+		return database.postMigration(fromVersion)
+
 	case latestSchemaVersion:
 		logger.Info("Database already at latest schema version: " + strconv.Itoa(latestSchemaVersion))
 
@@ -0,0 +1,204 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+)
+
+// Tables of the oldest schema version that can be migrated
+var baselineSchema = []string{
+	"CREATE TABLE version (version INT)",
+	"CREATE TABLE macaroons (id VARCHAR PRIMARY KEY, rootKey VARCHAR)",
+	"CREATE TABLE swaps (id VARCHAR PRIMARY KEY, state INT, error VARCHAR, status VARCHAR, privateKey VARCHAR, preimage VARCHAR, redeemScript VARCHAR, invoice VARCHAR, address VARCHAR, expectedAmount INT, timeoutBlockheight INTEGER, lockupTransactionId VARCHAR, refundTransactionId VARCHAR)",
+	"CREATE TABLE reverseSwaps (id VARCHAR PRIMARY KEY, state INT, error VARCHAR, status VARCHAR, acceptZeroConf BOOLEAN, privateKey VARCHAR, preimage VARCHAR, redeemScript VARCHAR, invoice VARCHAR, claimAddress VARCHAR, expectedAmount INT, timeoutBlockheight INTEGER, lockupTransactionId VARCHAR, claimTransactionId VARCHAR)",
+	"CREATE TABLE channelCreations (swapId VARCHAR PRIMARY KEY, status VARCHAR, inboundLiquidity INT, private BOOLEAN, fundingTransactionId VARCHAR, fundingTransactionVout INT)",
+	"INSERT INTO version (version) VALUES (2)",
+}
+
+func TestMigrateFromBaseline(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "boltz-lnd")
+	assert.Nil(t, err)
+
+	defer os.RemoveAll(dataDir)
+
+	databasePath := path.Join(dataDir, "boltz.db")
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	formattedKey := hex.EncodeToString(privateKey.Serialize())
+
+	baseline, err := sql.Open("sqlite3", databasePath)
+	assert.Nil(t, err)
+
+	for _, statement := range baselineSchema {
+		_, err = baseline.Exec(statement)
+		assert.Nil(t, err)
+	}
+
+	_, err = baseline.Exec(
+		"INSERT INTO swaps (id, state, error, status, privateKey, preimage, redeemScript, invoice, address, expectedAmount, timeoutBlockheight, lockupTransactionId, refundTransactionId) VALUES (?, ?, '', ?, ?, '', '', '', '', 0, 0, '', '')",
+		"swap",
+		boltzrpc.SwapState_PENDING,
+		boltz.SwapCreated.String(),
+		formattedKey,
+	)
+	assert.Nil(t, err)
+
+	_, err = baseline.Exec(
+		"INSERT INTO reverseSwaps (id, state, error, status, acceptZeroConf, privateKey, preimage, redeemScript, invoice, claimAddress, expectedAmount, timeoutBlockheight, lockupTransactionId, claimTransactionId) VALUES (?, ?, '', ?, false, ?, '', '', '', '', 0, 0, '', '')",
+		"reverse",
+		boltzrpc.SwapState_PENDING,
+		boltz.SwapCreated.String(),
+		formattedKey,
+	)
+	assert.Nil(t, err)
+
+	assert.Nil(t, baseline.Close())
+
+	database := &Database{
+		Path: databasePath,
+	}
+	assert.Nil(t, database.Connect())
+
+	version, err := database.queryVersion()
+
+	assert.Nil(t, err)
+	assert.Equal(t, latestSchemaVersion, version)
+
+	swap, err := database.QuerySwap("swap")
+
+	assert.Nil(t, err)
+	assert.Equal(t, boltz.Compatibility, swap.OutputType)
+	assert.Equal(t, "", swap.Node)
+	assert.Equal(t, int64(-1), swap.KeyIndex)
+
+	reverseSwap, err := database.QueryReverseSwap("reverse")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "", reverseSwap.Node)
+	assert.Equal(t, int64(-1), reverseSwap.KeyIndex)
+
+	assert.Nil(t, database.CreatePendingTransaction(PendingTransaction{
+		Id:      "transaction",
+		SwapIds: []string{swap.Id},
+		Node:    "second",
+	}))
+}
+
+func TestMigrateWebhookNotifications(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "boltz-lnd")
+	assert.Nil(t, err)
+
+	defer os.RemoveAll(dataDir)
+
+	databasePath := path.Join(dataDir, "boltz.db")
+
+	previous, err := sql.Open("sqlite3", databasePath)
+	assert.Nil(t, err)
+
+	for _, statement := range []string{
+		"CREATE TABLE version (version INT)",
+		"INSERT INTO version (version) VALUES (8)",
+		"CREATE TABLE webhookNotifications (id INTEGER PRIMARY KEY AUTOINCREMENT, url VARCHAR, swapId VARCHAR, event VARCHAR, payload VARCHAR, attempts INT, nextAttempt INT, delivered BOOLEAN, failed BOOLEAN, UNIQUE (url, swapId, event))",
+		"INSERT INTO webhookNotifications (url, swapId, event, payload, attempts, nextAttempt, delivered, failed) VALUES ('https://example.com', 'reverse', 'claim_broadcast', '{}', 1, 0, false, false)",
+	} {
+		_, err = previous.Exec(statement)
+		assert.Nil(t, err)
+	}
+
+	assert.Nil(t, previous.Close())
+
+	database := &Database{
+		Path: databasePath,
+	}
+	assert.Nil(t, database.Connect())
+
+	version, err := database.queryVersion()
+
+	assert.Nil(t, err)
+	assert.Equal(t, latestSchemaVersion, version)
+
+	// Broadcasts of other transactions are not suppressed by the notification of the previous schema anymore
+	created, err := database.CreateWebhookNotification(WebhookNotification{
+		Url:           "https://example.com",
+		SwapId:        "reverse",
+		Event:         "claim_broadcast",
+		TransactionId: "bumped",
+	})
+
+	assert.Nil(t, err)
+	assert.True(t, created)
+
+	notifications, err := database.QueryDueWebhookNotifications(time.Unix(0, 0))
+
+	assert.Nil(t, err)
+	assert.Len(t, notifications, 2)
+	assert.Equal(t, uint32(1), notifications[0].Attempts)
+	assert.Equal(t, "", notifications[0].TransactionId)
+	assert.Equal(t, "bumped", notifications[1].TransactionId)
+}
+
+// Records which statements were translated as schema changes and which were rebound as queries
+type recordingDialect struct {
+	sqliteDialect
+
+	schema  []string
+	queries []string
+}
+
+func (dialect *recordingDialect) rebind(query string) string {
+	dialect.queries = append(dialect.queries, query)
+	return query
+}
+
+func (dialect *recordingDialect) translateSchema(statement string) string {
+	dialect.schema = append(dialect.schema, statement)
+	return statement
+}
+
+func TestMigrationSchemaTranslation(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "boltz-lnd")
+	assert.Nil(t, err)
+
+	defer os.RemoveAll(dataDir)
+
+	sqlDb, err := sql.Open("sqlite3", path.Join(dataDir, "boltz.db"))
+	assert.Nil(t, err)
+
+	for _, statement := range baselineSchema {
+		_, err = sqlDb.Exec(statement)
+		assert.Nil(t, err)
+	}
+
+	dialect := &recordingDialect{}
+
+	database := &Database{}
+	database.pool = newDb(sqlDb, dialect)
+	database.db = database.pool
+
+	assert.Nil(t, database.createTables())
+	assert.Nil(t, database.migrate())
+
+	// Otherwise PostgreSQL would get columns with the types of SQLite
+	assert.Contains(t, dialect.schema, "ALTER TABLE swaps ADD COLUMN outputType INT DEFAULT 1")
+	assert.Contains(t, dialect.schema, "ALTER TABLE reverseSwaps ADD COLUMN keyIndex INT DEFAULT -1")
+	assert.Contains(t, dialect.schema, "DROP TABLE webhookNotifications")
+
+	for _, query := range dialect.queries {
+		assert.False(t, strings.HasPrefix(query, "ALTER") || strings.HasPrefix(query, "DROP"), query)
+	}
+
+	assert.Nil(t, sqlDb.Close())
+}
@@ -4,7 +4,6 @@ import (
 	"database/sql"
 	"encoding/hex"
 	"errors"
-	"strconv"
 
 	"github.com/BoltzExchange/boltz-lnd/boltz"
 	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
@@ -26,6 +25,14 @@ type ReverseSwap struct {
 	TimeoutBlockHeight  uint32
 	LockupTransactionId string
 	ClaimTransactionId  string
+	BlindingKey         *btcec.PrivateKey
+	// Symbol of the onchain currency. Empty when the Reverse Swap is on the chain of the LND node
+	Currency string
+	// Name of the LND node through which the Reverse Swap was created. Empty for the node of the [LND] section
+	Node string
+	// Index from which the keys and preimage were derived. -1 for Reverse Swaps whose keys were not derived from the seed,
+	// because they were created before or imported from a rescue file
+	KeyIndex int64
 }
 
 type ReverseSwapSerialized struct {
@@ -43,6 +50,10 @@ type ReverseSwapSerialized struct {
 	TimeoutBlockHeight  uint32
 	LockupTransactionId string
 	ClaimTransactionId  string
+	BlindingKey         string
+	Currency            string
+	Node                string
+	KeyIndex            int64
 }
 
 func (reverseSwap *ReverseSwap) Serialize() ReverseSwapSerialized {
@@ -61,16 +72,21 @@ func (reverseSwap *ReverseSwap) Serialize() ReverseSwapSerialized {
 		TimeoutBlockHeight:  reverseSwap.TimeoutBlockHeight,
 		LockupTransactionId: reverseSwap.LockupTransactionId,
 		ClaimTransactionId:  reverseSwap.ClaimTransactionId,
+		BlindingKey:         formatBlindingKey(reverseSwap.BlindingKey),
+		Currency:            reverseSwap.Currency,
+		Node:                reverseSwap.Node,
+		KeyIndex:            reverseSwap.KeyIndex,
 	}
 }
 
-func parseReverseSwap(rows *sql.Rows) (*ReverseSwap, error) {
+func (database *Database) parseReverseSwap(rows *sql.Rows) (*ReverseSwap, error) {
 	var reverseSwap ReverseSwap
 
 	var status string
 	var privateKey string
 	var preimage string
 	var redeemScript string
+	var blindingKey string
 
 	err := scanRow(
 		rows,
@@ -89,6 +105,10 @@ func parseReverseSwap(rows *sql.Rows) (*ReverseSwap, error) {
 			"timeoutBlockheight":  &reverseSwap.TimeoutBlockHeight,
 			"lockupTransactionId": &reverseSwap.LockupTransactionId,
 			"claimTransactionId":  &reverseSwap.ClaimTransactionId,
+			"blindingKey":         &blindingKey,
+			"currency":            &reverseSwap.Currency,
+			"node":                &reverseSwap.Node,
+			"keyIndex":            &reverseSwap.KeyIndex,
 		},
 	)
 
@@ -97,6 +117,19 @@ func parseReverseSwap(rows *sql.Rows) (*ReverseSwap, error) {
 	}
 
 	reverseSwap.Status = boltz.ParseEvent(status)
+
+	privateKey, err = database.decryptValue(privateKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	preimage, err = database.decryptValue(preimage)
+
+	if err != nil {
+		return nil, err
+	}
+
 	privateKeyBytes, err := hex.DecodeString(privateKey)
 
 	if err != nil {
@@ -112,6 +145,12 @@ func parseReverseSwap(rows *sql.Rows) (*ReverseSwap, error) {
 
 	reverseSwap.RedeemScript, err = hex.DecodeString(redeemScript)
 
+	if err != nil {
+		return nil, err
+	}
+
+	reverseSwap.BlindingKey, err = parseBlindingKey(blindingKey)
+
 	return &reverseSwap, err
 }
 
@@ -126,7 +165,7 @@ func (database *Database) QueryReverseSwap(id string) (reverseSwap *ReverseSwap,
 	defer rows.Close()
 
 	if rows.Next() {
-		reverseSwap, err = parseReverseSwap(rows)
+		reverseSwap, err = database.parseReverseSwap(rows)
 
 		if err != nil {
 			return reverseSwap, err
@@ -138,8 +177,8 @@ func (database *Database) QueryReverseSwap(id string) (reverseSwap *ReverseSwap,
 	return reverseSwap, err
 }
 
-func (database *Database) queryReverseSwaps(query string) (swaps []ReverseSwap, err error) {
-	rows, err := database.db.Query(query)
+func (database *Database) queryReverseSwaps(query string, args ...interface{}) (swaps []ReverseSwap, err error) {
+	rows, err := database.db.Query(query, args...)
 
 	if err != nil {
 		return nil, err
@@ -148,7 +187,7 @@ func (database *Database) queryReverseSwaps(query string) (swaps []ReverseSwap,
 	defer rows.Close()
 
 	for rows.Next() {
-		swap, err := parseReverseSwap(rows)
+		swap, err := database.parseReverseSwap(rows)
 
 		if err != nil {
 			return nil, err
@@ -165,25 +204,32 @@ func (database *Database) QueryReverseSwaps() ([]ReverseSwap, error) {
 }
 
 func (database *Database) QueryPendingReverseSwaps() ([]ReverseSwap, error) {
-	return database.queryReverseSwaps("SELECT * FROM reverseSwaps WHERE state = '" + strconv.Itoa(int(boltzrpc.SwapState_PENDING)) + "'")
+	return database.queryReverseSwaps("SELECT * FROM reverseSwaps WHERE state = ?", boltzrpc.SwapState_PENDING)
 }
 
 func (database *Database) CreateReverseSwap(reverseSwap ReverseSwap) error {
-	insertStatement := "INSERT INTO reverseSwaps (id, state, error, status, acceptZeroConf, privateKey, preimage, redeemScript, invoice, claimAddress, expectedAmount, timeoutBlockheight, lockupTransactionId, claimTransactionId) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
-	statement, err := database.db.Prepare(insertStatement)
+	privateKey, err := database.encryptValue(formatPrivateKey(reverseSwap.PrivateKey))
+
+	if err != nil {
+		return err
+	}
+
+	preimage, err := database.encryptValue(hex.EncodeToString(reverseSwap.Preimage))
 
 	if err != nil {
 		return err
 	}
 
-	_, err = statement.Exec(
+	insertStatement := "INSERT INTO reverseSwaps (id, state, error, status, acceptZeroConf, privateKey, preimage, redeemScript, invoice, claimAddress, expectedAmount, timeoutBlockheight, lockupTransactionId, claimTransactionId, blindingKey, currency, node, keyIndex) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	_, err = database.db.Exec(
+		insertStatement,
 		reverseSwap.Id,
 		reverseSwap.State,
 		reverseSwap.Error,
 		reverseSwap.Status.String(),
 		reverseSwap.AcceptZeroConf,
-		formatPrivateKey(reverseSwap.PrivateKey),
-		hex.EncodeToString(reverseSwap.Preimage),
+		privateKey,
+		preimage,
 		hex.EncodeToString(reverseSwap.RedeemScript),
 		reverseSwap.Invoice,
 		reverseSwap.ClaimAddress,
@@ -191,28 +237,42 @@ func (database *Database) CreateReverseSwap(reverseSwap ReverseSwap) error {
 		reverseSwap.TimeoutBlockHeight,
 		reverseSwap.LockupTransactionId,
 		reverseSwap.ClaimTransactionId,
+		formatBlindingKey(reverseSwap.BlindingKey),
+		reverseSwap.Currency,
+		reverseSwap.Node,
+		reverseSwap.KeyIndex,
 	)
 
-	if err != nil {
-		return err
-	}
-
-	return statement.Close()
+	return err
 }
 
-func (database *Database) UpdateReverseSwapState(reverseSwap *ReverseSwap, state boltzrpc.SwapState, error string) error {
+func (database *Database) UpdateReverseSwapState(reverseSwap *ReverseSwap, state boltzrpc.SwapState, errorMessage string) error {
 	reverseSwap.State = state
-	reverseSwap.Error = error
+	reverseSwap.Error = errorMessage
 
-	_, err := database.db.Exec("UPDATE reverseSwaps SET state = ?, error = ? WHERE id = ?", state, error, reverseSwap.Id)
-	return err
+	return database.runUpdate(func(database *Database) error {
+		_, err := database.db.Exec("UPDATE reverseSwaps SET state = ?, error = ? WHERE id = ?", state, errorMessage, reverseSwap.Id)
+
+		if err != nil {
+			return err
+		}
+
+		return database.emitReverseSwap(reverseSwap)
+	})
 }
 
 func (database *Database) UpdateReverseSwapStatus(reverseSwap *ReverseSwap, status boltz.SwapUpdateEvent) error {
 	reverseSwap.Status = status
 
-	_, err := database.db.Exec("UPDATE reverseSwaps SET status = ? WHERE id = ?", status.String(), reverseSwap.Id)
-	return err
+	return database.runUpdate(func(database *Database) error {
+		_, err := database.db.Exec("UPDATE reverseSwaps SET status = ? WHERE id = ?", status.String(), reverseSwap.Id)
+
+		if err != nil {
+			return err
+		}
+
+		return database.emitReverseSwap(reverseSwap)
+	})
 }
 
 func (database *Database) SetReverseSwapLockupTransactionId(reverseSwap *ReverseSwap, lockupTransactionId string) error {
@@ -225,6 +285,13 @@ func (database *Database) SetReverseSwapLockupTransactionId(reverseSwap *Reverse
 func (database *Database) SetReverseSwapClaimTransactionId(reverseSwap *ReverseSwap, claimTransactionId string) error {
 	reverseSwap.ClaimTransactionId = claimTransactionId
 
-	_, err := database.db.Exec("UPDATE reverseSwaps SET claimTransactionId = ? WHERE id = ?", claimTransactionId, reverseSwap.Id)
-	return err
+	return database.runUpdate(func(database *Database) error {
+		_, err := database.db.Exec("UPDATE reverseSwaps SET claimTransactionId = ? WHERE id = ?", claimTransactionId, reverseSwap.Id)
+
+		if err != nil {
+			return err
+		}
+
+		return database.emitReverseSwap(reverseSwap)
+	})
 }
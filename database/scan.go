@@ -2,6 +2,7 @@ package database
 
 import (
 	"database/sql"
+	"strings"
 )
 
 // TODO: optimize (query columns only once per query (improves speed by like a microsecond per row so low priority))
@@ -12,10 +13,17 @@ func scanRow(row *sql.Rows, rowValues map[string]interface{}) error {
 		return err
 	}
 
+	// PostgreSQL folds the names of columns that were not quoted to lower case
+	lowerCaseValues := make(map[string]interface{}, len(rowValues))
+
+	for column, value := range rowValues {
+		lowerCaseValues[strings.ToLower(column)] = value
+	}
+
 	var values []interface{}
 
 	for _, column := range columns {
-		values = append(values, rowValues[column])
+		values = append(values, lowerCaseValues[strings.ToLower(column)])
 	}
 
 	err = row.Scan(values...)
@@ -32,6 +32,23 @@ func TestScanRow(t *testing.T) {
 
 	assert.Equal(t, "some", idValue)
 	assert.Equal(t, "values", testValue)
+	assert.Nil(t, row.Close())
+
+	// Column names are matched regardless of their case
+	row, err = db.Query("SELECT id AS swapid, test FROM test")
+	assert.Nil(t, err)
+	assert.True(t, row.Next())
+
+	var swapIdValue string
+
+	err = scanRow(row, map[string]interface{}{
+		"swapId": &swapIdValue,
+		"test":   &testValue,
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, "some", swapIdValue)
+	assert.Nil(t, row.Close())
 
 	assert.Nil(t, db.Close())
 }
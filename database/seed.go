@@ -0,0 +1,58 @@
+package database
+
+// The seed table has a single row
+const seedId = 0
+
+// QuerySeed returns the mnemonic of the seed from which the keys and preimages of swaps are derived. sql.ErrNoRows is
+// returned if no seed was created yet
+func (database *Database) QuerySeed() (string, error) {
+	var mnemonic string
+
+	err := database.db.QueryRow("SELECT mnemonic FROM seed WHERE id = ?", seedId).Scan(&mnemonic)
+
+	if err != nil {
+		return "", err
+	}
+
+	return database.decryptValue(mnemonic)
+}
+
+func (database *Database) CreateSeed(mnemonic string) error {
+	encryptedMnemonic, err := database.encryptValue(mnemonic)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = database.db.Exec("INSERT INTO seed (id, mnemonic, nextKeyIndex) VALUES (?, ?, ?)", seedId, encryptedMnemonic, 0)
+	return err
+}
+
+// NewKeyIndex reserves the index from which the keys and preimage of a new swap are derived
+func (database *Database) NewKeyIndex() (index uint32, err error) {
+	err = database.RunTx(func(transaction *Transaction) error {
+		// Updating first locks the row, so that concurrent swaps cannot get the same index
+		_, err := transaction.db.Exec("UPDATE seed SET nextKeyIndex = nextKeyIndex + 1 WHERE id = ?", seedId)
+
+		if err != nil {
+			return err
+		}
+
+		return transaction.db.QueryRow("SELECT nextKeyIndex - 1 FROM seed WHERE id = ?", seedId).Scan(&index)
+	})
+
+	return index, err
+}
+
+// ReserveKeyIndexes makes sure that no index up to and including the one is used for new swaps. It is needed after
+// swaps were recovered, because the counter starts from zero again when a seed is imported into a new database
+func (database *Database) ReserveKeyIndexes(index uint32) error {
+	_, err := database.db.Exec(
+		"UPDATE seed SET nextKeyIndex = ? WHERE id = ? AND nextKeyIndex <= ?",
+		int64(index)+1,
+		seedId,
+		index,
+	)
+
+	return err
+}
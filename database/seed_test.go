@@ -0,0 +1,53 @@
+package database
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeed(t *testing.T) {
+	database, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	_, err := database.QuerySeed()
+	assert.Equal(t, sql.ErrNoRows, err)
+
+	_, err = database.NewKeyIndex()
+	assert.Equal(t, sql.ErrNoRows, err)
+
+	assert.Nil(t, database.CreateSeed("mnemonic"))
+
+	mnemonic, err := database.QuerySeed()
+	assert.Nil(t, err)
+	assert.Equal(t, "mnemonic", mnemonic)
+
+	for expected := uint32(0); expected < 3; expected++ {
+		index, err := database.NewKeyIndex()
+		assert.Nil(t, err)
+		assert.Equal(t, expected, index)
+	}
+
+	assert.Nil(t, database.ReserveKeyIndexes(10))
+
+	index, err := database.NewKeyIndex()
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(11), index)
+
+	// Indexes that are used already are not reserved again
+	assert.Nil(t, database.ReserveKeyIndexes(5))
+
+	index, err = database.NewKeyIndex()
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(12), index)
+
+	// The mnemonic is encrypted like the other secrets
+	assert.Nil(t, database.SetPassphrase("passphrase"))
+	assert.True(t, strings.HasPrefix(queryRawValue(t, database, "SELECT mnemonic FROM seed"), encryptedValuePrefix))
+
+	mnemonic, err = database.QuerySeed()
+	assert.Nil(t, err)
+	assert.Equal(t, "mnemonic", mnemonic)
+}
@@ -0,0 +1,73 @@
+package database
+
+import (
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+)
+
+// SwapStatistics contains the number and the summed expected amount of the Swaps or Reverse Swaps in a state
+type SwapStatistics struct {
+	Type   SwapType
+	State  boltzrpc.SwapState
+	Count  uint64
+	Amount uint64
+}
+
+// QuerySwapStatistics groups Swaps and Reverse Swaps by their state. Channel Creations are counted as Swaps
+func (database *Database) QuerySwapStatistics() ([]SwapStatistics, error) {
+	swapStatistics, err := database.querySwapStatistics("swaps", SubmarineSwap)
+
+	if err != nil {
+		return nil, err
+	}
+
+	reverseSwapStatistics, err := database.querySwapStatistics("reverseSwaps", ReverseSubmarineSwap)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append(swapStatistics, reverseSwapStatistics...), nil
+}
+
+// QueryTimingOutSwapCount returns the number of pending Swaps and Reverse Swaps of a node on the chain of a currency that
+// time out at or before the height. An empty currency is the chain of the LND node
+func (database *Database) QueryTimingOutSwapCount(node string, currency string, blockHeight uint32) (swaps uint32, reverseSwaps uint32, err error) {
+	query := " WHERE state = ? AND node = ? AND currency = ? AND timeoutBlockHeight <= ?"
+	args := []interface{}{boltzrpc.SwapState_PENDING, node, currency, blockHeight}
+
+	err = database.db.QueryRow("SELECT COUNT(*) FROM swaps"+query, args...).Scan(&swaps)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	err = database.db.QueryRow("SELECT COUNT(*) FROM reverseSwaps"+query, args...).Scan(&reverseSwaps)
+
+	return swaps, reverseSwaps, err
+}
+
+func (database *Database) querySwapStatistics(table string, swapType SwapType) (statistics []SwapStatistics, err error) {
+	rows, err := database.db.Query("SELECT state, COUNT(*), COALESCE(SUM(expectedAmount), 0) FROM " + table + " GROUP BY state")
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		stateStatistics := SwapStatistics{
+			Type: swapType,
+		}
+
+		err = rows.Scan(&stateStatistics.State, &stateStatistics.Count, &stateStatistics.Amount)
+
+		if err != nil {
+			return nil, err
+		}
+
+		statistics = append(statistics, stateStatistics)
+	}
+
+	return statistics, rows.Err()
+}
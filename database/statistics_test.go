@@ -0,0 +1,70 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwapStatistics(t *testing.T) {
+	database, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	swaps := []Swap{
+		{Id: "first", State: boltzrpc.SwapState_SUCCESSFUL, ExpectedAmount: 100000, TimoutBlockHeight: 100},
+		{Id: "second", State: boltzrpc.SwapState_SUCCESSFUL, ExpectedAmount: 50000, TimoutBlockHeight: 100},
+		{Id: "third", State: boltzrpc.SwapState_PENDING, ExpectedAmount: 20000, TimoutBlockHeight: 120},
+		{Id: "fourth", State: boltzrpc.SwapState_PENDING, ExpectedAmount: 10000, TimoutBlockHeight: 50, Currency: "L-BTC"},
+		{Id: "fifth", State: boltzrpc.SwapState_PENDING, ExpectedAmount: 10000, TimoutBlockHeight: 50, Node: "second"},
+	}
+
+	for _, swap := range swaps {
+		swap.PrivateKey = privateKey
+		assert.Nil(t, database.CreateSwap(swap))
+	}
+
+	reverseSwap := ReverseSwap{
+		Id:                 "reverse",
+		State:              boltzrpc.SwapState_PENDING,
+		PrivateKey:         privateKey,
+		OnchainAmount:      30000,
+		TimeoutBlockHeight: 110,
+	}
+	assert.Nil(t, database.CreateReverseSwap(reverseSwap))
+
+	statistics, err := database.QuerySwapStatistics()
+
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []SwapStatistics{
+		{Type: SubmarineSwap, State: boltzrpc.SwapState_PENDING, Count: 3, Amount: 40000},
+		{Type: SubmarineSwap, State: boltzrpc.SwapState_SUCCESSFUL, Count: 2, Amount: 150000},
+		{Type: ReverseSubmarineSwap, State: boltzrpc.SwapState_PENDING, Count: 1, Amount: 30000},
+	}, statistics)
+
+	timingOutSwaps, timingOutReverseSwaps, err := database.QueryTimingOutSwapCount("", "", 110)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), timingOutSwaps)
+	assert.Equal(t, uint32(1), timingOutReverseSwaps)
+
+	timingOutSwaps, _, err = database.QueryTimingOutSwapCount("", "", 120)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), timingOutSwaps)
+
+	timingOutSwaps, timingOutReverseSwaps, err = database.QueryTimingOutSwapCount("", "L-BTC", 50)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), timingOutSwaps)
+	assert.Equal(t, uint32(0), timingOutReverseSwaps)
+
+	timingOutSwaps, _, err = database.QueryTimingOutSwapCount("second", "", 50)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), timingOutSwaps)
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/BoltzExchange/boltz-lnd/boltz"
 	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
 	"github.com/btcsuite/btcd/btcec"
-	"strconv"
 )
 
 type Swap struct {
@@ -24,6 +23,18 @@ type Swap struct {
 	TimoutBlockHeight   uint32
 	LockupTransactionId string
 	RefundTransactionId string
+	BlindingKey         *btcec.PrivateKey
+	// Empty when the refund should go to a new address of the LND wallet
+	RefundAddress string
+	// Type of the lockup output
+	OutputType boltz.OutputType
+	// Symbol of the onchain currency. Empty when the Swap is on the chain of the LND node
+	Currency string
+	// Name of the LND node through which the Swap was created. Empty for the node of the [LND] section
+	Node string
+	// Index from which the keys and preimage were derived. -1 for Swaps whose keys were not derived from the seed,
+	// because they were created before or imported from a rescue file
+	KeyIndex int64
 }
 
 type SwapSerialized struct {
@@ -40,6 +51,12 @@ type SwapSerialized struct {
 	TimeoutBlockHeight  uint32
 	LockupTransactionId string
 	RefundTransactionId string
+	BlindingKey         string
+	RefundAddress       string
+	OutputType          string
+	Currency            string
+	Node                string
+	KeyIndex            int64
 }
 
 func (swap *Swap) Serialize() SwapSerialized {
@@ -63,16 +80,23 @@ func (swap *Swap) Serialize() SwapSerialized {
 		TimeoutBlockHeight:  swap.TimoutBlockHeight,
 		LockupTransactionId: swap.LockupTransactionId,
 		RefundTransactionId: swap.RefundTransactionId,
+		BlindingKey:         formatBlindingKey(swap.BlindingKey),
+		RefundAddress:       swap.RefundAddress,
+		OutputType:          swap.OutputType.String(),
+		Currency:            swap.Currency,
+		Node:                swap.Node,
+		KeyIndex:            swap.KeyIndex,
 	}
 }
 
-func parseSwap(rows *sql.Rows) (*Swap, error) {
+func (database *Database) parseSwap(rows *sql.Rows) (*Swap, error) {
 	var swap Swap
 
 	var status string
 	var privateKey string
 	var preimage string
 	var redeemScript string
+	var blindingKey string
 
 	err := scanRow(
 		rows,
@@ -90,6 +114,12 @@ func parseSwap(rows *sql.Rows) (*Swap, error) {
 			"timeoutBlockheight":  &swap.TimoutBlockHeight,
 			"lockupTransactionId": &swap.LockupTransactionId,
 			"refundTransactionId": &swap.RefundTransactionId,
+			"blindingKey":         &blindingKey,
+			"refundAddress":       &swap.RefundAddress,
+			"outputType":          &swap.OutputType,
+			"currency":            &swap.Currency,
+			"node":                &swap.Node,
+			"keyIndex":            &swap.KeyIndex,
 		},
 	)
 
@@ -99,6 +129,18 @@ func parseSwap(rows *sql.Rows) (*Swap, error) {
 
 	swap.Status = boltz.ParseEvent(status)
 
+	privateKey, err = database.decryptValue(privateKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	preimage, err = database.decryptValue(preimage)
+
+	if err != nil {
+		return nil, err
+	}
+
 	privateKeyBytes, err := hex.DecodeString(privateKey)
 
 	if err != nil {
@@ -121,11 +163,17 @@ func parseSwap(rows *sql.Rows) (*Swap, error) {
 		return nil, err
 	}
 
+	swap.BlindingKey, err = parseBlindingKey(blindingKey)
+
+	if err != nil {
+		return nil, err
+	}
+
 	return &swap, err
 }
 
 func (database *Database) QuerySwap(id string) (swap *Swap, err error) {
-	rows, err := database.db.Query("SELECT * FROM swaps WHERE id = '" + id + "'")
+	rows, err := database.db.Query("SELECT * FROM swaps WHERE id = ?", id)
 
 	if err != nil {
 		return swap, err
@@ -134,7 +182,7 @@ func (database *Database) QuerySwap(id string) (swap *Swap, err error) {
 	defer rows.Close()
 
 	if rows.Next() {
-		swap, err = parseSwap(rows)
+		swap, err = database.parseSwap(rows)
 
 		if err != nil {
 			return swap, err
@@ -146,8 +194,8 @@ func (database *Database) QuerySwap(id string) (swap *Swap, err error) {
 	return swap, err
 }
 
-func (database *Database) querySwaps(query string) (swaps []Swap, err error) {
-	rows, err := database.db.Query(query)
+func (database *Database) querySwaps(query string, args ...interface{}) (swaps []Swap, err error) {
+	rows, err := database.db.Query(query, args...)
 
 	if err != nil {
 		return nil, err
@@ -156,7 +204,7 @@ func (database *Database) querySwaps(query string) (swaps []Swap, err error) {
 	defer rows.Close()
 
 	for rows.Next() {
-		swap, err := parseSwap(rows)
+		swap, err := database.parseSwap(rows)
 
 		if err != nil {
 			return nil, err
@@ -173,16 +221,26 @@ func (database *Database) QuerySwaps() ([]Swap, error) {
 }
 
 func (database *Database) QueryPendingSwaps() ([]Swap, error) {
-	return database.querySwaps("SELECT * FROM swaps WHERE state = '" + strconv.Itoa(int(boltzrpc.SwapState_PENDING)) + "'")
+	return database.querySwaps("SELECT * FROM swaps WHERE state = ?", boltzrpc.SwapState_PENDING)
 }
 
-func (database *Database) QueryRefundableSwaps(currentBlockHeight uint32) ([]Swap, error) {
-	return database.querySwaps("SELECT * FROM swaps WHERE (state = '" + strconv.Itoa(int(boltzrpc.SwapState_PENDING)) + "' OR state = '" + strconv.Itoa(int(boltzrpc.SwapState_SERVER_ERROR)) + "') AND timeoutBlockHeight <= " + strconv.FormatUint(uint64(currentBlockHeight), 10))
+// QueryRefundableSwaps returns the Swaps of the node and currency that timed out at the block height of its chain.
+// Swaps that failed on the side of the client, like Channel Creations whose HTLCs were sent through the wrong channel,
+// are refunded too
+func (database *Database) QueryRefundableSwaps(node string, currency string, currentBlockHeight uint32) ([]Swap, error) {
+	return database.querySwaps(
+		"SELECT * FROM swaps WHERE (state = ? OR state = ? OR state = ?) AND node = ? AND currency = ? AND timeoutBlockHeight <= ?",
+		boltzrpc.SwapState_PENDING,
+		boltzrpc.SwapState_SERVER_ERROR,
+		boltzrpc.SwapState_ERROR,
+		node,
+		currency,
+		currentBlockHeight,
+	)
 }
 
 func (database *Database) CreateSwap(swap Swap) error {
-	insertStatement := "INSERT INTO swaps (id, state, error, status, privateKey, preimage, redeemScript, invoice, address, expectedAmount, timeoutBlockheight, lockupTransactionId, refundTransactionId) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
-	statement, err := database.db.Prepare(insertStatement)
+	privateKey, err := database.encryptValue(formatPrivateKey(swap.PrivateKey))
 
 	if err != nil {
 		return err
@@ -191,15 +249,21 @@ func (database *Database) CreateSwap(swap Swap) error {
 	preimage := ""
 
 	if swap.Preimage != nil {
-		preimage = hex.EncodeToString(swap.Preimage)
+		preimage, err = database.encryptValue(hex.EncodeToString(swap.Preimage))
+
+		if err != nil {
+			return err
+		}
 	}
 
-	_, err = statement.Exec(
+	insertStatement := "INSERT INTO swaps (id, state, error, status, privateKey, preimage, redeemScript, invoice, address, expectedAmount, timeoutBlockheight, lockupTransactionId, refundTransactionId, blindingKey, refundAddress, outputType, currency, node, keyIndex) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	_, err = database.db.Exec(
+		insertStatement,
 		swap.Id,
 		swap.State,
 		swap.Error,
 		swap.Status.String(),
-		formatPrivateKey(swap.PrivateKey),
+		privateKey,
 		preimage,
 		hex.EncodeToString(swap.RedeemScript),
 		swap.Invoice,
@@ -208,28 +272,44 @@ func (database *Database) CreateSwap(swap Swap) error {
 		swap.TimoutBlockHeight,
 		swap.LockupTransactionId,
 		swap.RefundTransactionId,
+		formatBlindingKey(swap.BlindingKey),
+		swap.RefundAddress,
+		swap.OutputType,
+		swap.Currency,
+		swap.Node,
+		swap.KeyIndex,
 	)
 
-	if err != nil {
-		return err
-	}
-
-	return statement.Close()
+	return err
 }
 
-func (database *Database) UpdateSwapState(swap *Swap, state boltzrpc.SwapState, error string) error {
+func (database *Database) UpdateSwapState(swap *Swap, state boltzrpc.SwapState, errorMessage string) error {
 	swap.State = state
-	swap.Error = error
+	swap.Error = errorMessage
 
-	_, err := database.db.Exec("UPDATE swaps SET state = ?, error = ? WHERE id = ?", state, error, swap.Id)
-	return err
+	return database.runUpdate(func(database *Database) error {
+		_, err := database.db.Exec("UPDATE swaps SET state = ?, error = ? WHERE id = ?", state, errorMessage, swap.Id)
+
+		if err != nil {
+			return err
+		}
+
+		return database.emitSwap(swap)
+	})
 }
 
 func (database *Database) UpdateSwapStatus(swap *Swap, status boltz.SwapUpdateEvent) error {
 	swap.Status = status
 
-	_, err := database.db.Exec("UPDATE swaps SET status = ? WHERE id = ?", status.String(), swap.Id)
-	return err
+	return database.runUpdate(func(database *Database) error {
+		_, err := database.db.Exec("UPDATE swaps SET status = ? WHERE id = ?", status.String(), swap.Id)
+
+		if err != nil {
+			return err
+		}
+
+		return database.emitSwap(swap)
+	})
 }
 
 func (database *Database) SetSwapInvoice(swap *Swap, invoice string) error {
@@ -250,6 +330,13 @@ func (database *Database) SetSwapRefundTransactionId(swap *Swap, refundTransacti
 	swap.State = boltzrpc.SwapState_REFUNDED
 	swap.RefundTransactionId = refundTransactionId
 
-	_, err := database.db.Exec("UPDATE swaps SET state = ?, refundTransactionId = ? WHERE id = ?", swap.State, refundTransactionId, swap.Id)
-	return err
+	return database.runUpdate(func(database *Database) error {
+		_, err := database.db.Exec("UPDATE swaps SET state = ?, refundTransactionId = ? WHERE id = ?", swap.State, refundTransactionId, swap.Id)
+
+		if err != nil {
+			return err
+		}
+
+		return database.emitSwap(swap)
+	})
 }
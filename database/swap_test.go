@@ -0,0 +1,162 @@
+package database
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestDatabase(t *testing.T) (*Database, func()) {
+	dataDir, err := ioutil.TempDir("", "boltz-lnd")
+	assert.Nil(t, err)
+
+	database := &Database{
+		Path: path.Join(dataDir, "boltz.db"),
+	}
+
+	assert.Nil(t, database.Connect())
+
+	return database, func() {
+		_ = os.RemoveAll(dataDir)
+	}
+}
+
+func TestSwapRefundAddress(t *testing.T) {
+	database, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	swap := Swap{
+		Id:            "refund",
+		PrivateKey:    privateKey,
+		RefundAddress: "bcrt1q0ghnvwtshnsecr2dh05u7q0vuwlqkdl4jfz7zr",
+		OutputType:    boltz.SegWit,
+	}
+	assert.Nil(t, database.CreateSwap(swap))
+
+	querySwap, err := database.QuerySwap(swap.Id)
+
+	assert.Nil(t, err)
+	assert.Equal(t, swap.RefundAddress, querySwap.RefundAddress)
+	assert.Equal(t, swap.RefundAddress, querySwap.Serialize().RefundAddress)
+	assert.Equal(t, boltz.SegWit, querySwap.OutputType)
+	assert.Equal(t, "segwit", querySwap.Serialize().OutputType)
+
+	refundableSwaps, err := database.QueryRefundableSwaps("", "", 0)
+
+	assert.Nil(t, err)
+	assert.Len(t, refundableSwaps, 1)
+
+	assert.Nil(t, database.UpdateSwapState(querySwap, boltzrpc.SwapState_ERROR, "error"))
+
+	refundableSwaps, err = database.QueryRefundableSwaps("", "", 0)
+
+	assert.Nil(t, err)
+	assert.Len(t, refundableSwaps, 1)
+
+	assert.Nil(t, database.SetSwapRefundTransactionId(querySwap, "transaction"))
+
+	refundableSwaps, err = database.QueryRefundableSwaps("", "", 0)
+
+	assert.Nil(t, err)
+	assert.Len(t, refundableSwaps, 0)
+}
+
+func TestSwapKeyIndex(t *testing.T) {
+	database, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	assert.Nil(t, database.CreateSwap(Swap{Id: "swap", PrivateKey: privateKey, KeyIndex: 5}))
+	assert.Nil(t, database.CreateReverseSwap(ReverseSwap{Id: "reverse", PrivateKey: privateKey, KeyIndex: 3}))
+
+	swap, err := database.QuerySwap("swap")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), swap.KeyIndex)
+	assert.Equal(t, int64(5), swap.Serialize().KeyIndex)
+
+	reverseSwap, err := database.QueryReverseSwap("reverse")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), reverseSwap.KeyIndex)
+}
+
+func TestSwapCurrency(t *testing.T) {
+	database, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	swap := Swap{
+		Id:                "crossChain",
+		PrivateKey:        privateKey,
+		TimoutBlockHeight: 100,
+		Currency:          "LTC",
+	}
+	assert.Nil(t, database.CreateSwap(swap))
+
+	querySwap, err := database.QuerySwap(swap.Id)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "LTC", querySwap.Currency)
+	assert.Equal(t, "LTC", querySwap.Serialize().Currency)
+
+	// Swaps are only refundable at the block height of the chain of their currency
+	refundableSwaps, err := database.QueryRefundableSwaps("", "", 100)
+
+	assert.Nil(t, err)
+	assert.Len(t, refundableSwaps, 0)
+
+	refundableSwaps, err = database.QueryRefundableSwaps("", "LTC", 99)
+
+	assert.Nil(t, err)
+	assert.Len(t, refundableSwaps, 0)
+
+	refundableSwaps, err = database.QueryRefundableSwaps("", "LTC", 100)
+
+	assert.Nil(t, err)
+	assert.Len(t, refundableSwaps, 1)
+}
+
+func TestSwapNode(t *testing.T) {
+	database, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	swap := Swap{
+		Id:                "otherNode",
+		PrivateKey:        privateKey,
+		TimoutBlockHeight: 100,
+		Node:              "second",
+	}
+	assert.Nil(t, database.CreateSwap(swap))
+
+	querySwap, err := database.QuerySwap(swap.Id)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "second", querySwap.Node)
+	assert.Equal(t, "second", querySwap.Serialize().Node)
+
+	// Swaps are only refunded by the nursery of their node
+	refundableSwaps, err := database.QueryRefundableSwaps("", "", 100)
+
+	assert.Nil(t, err)
+	assert.Len(t, refundableSwaps, 0)
+
+	refundableSwaps, err = database.QueryRefundableSwaps("second", "", 100)
+
+	assert.Nil(t, err)
+	assert.Len(t, refundableSwaps, 1)
+}
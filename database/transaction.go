@@ -0,0 +1,132 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+)
+
+type TransactionType int
+
+const (
+	ClaimTransaction TransactionType = iota
+	RefundTransaction
+)
+
+func (transactionType TransactionType) String() string {
+	switch transactionType {
+	case ClaimTransaction:
+		return "claim"
+
+	case RefundTransaction:
+		return "refund"
+
+	default:
+		return "unknown"
+	}
+}
+
+// PendingTransaction is a claim or refund transaction that was broadcast by the nursery but is not confirmed yet.
+// It is kept to be able to replace the transaction with one that pays a higher fee
+type PendingTransaction struct {
+	Id   string
+	Type TransactionType
+
+	// IDs of the Reverse Swaps that are claimed or of the Swaps that are refunded
+	SwapIds []string
+
+	// Address to which refunded lockup outputs are sent. Claimed ones are sent to the claim address of their Reverse Swap
+	Address string
+
+	FeeSatPerVbyte  int64
+	BroadcastHeight uint32
+
+	// Name of the LND node whose nursery broadcast the transaction. Empty for the node of the [LND] section
+	Node string
+}
+
+func parsePendingTransaction(rows *sql.Rows) (*PendingTransaction, error) {
+	var pendingTransaction PendingTransaction
+
+	var swapIds string
+
+	err := scanRow(
+		rows,
+		map[string]interface{}{
+			"id":              &pendingTransaction.Id,
+			"type":            &pendingTransaction.Type,
+			"swapIds":         &swapIds,
+			"address":         &pendingTransaction.Address,
+			"feeSatPerVbyte":  &pendingTransaction.FeeSatPerVbyte,
+			"broadcastHeight": &pendingTransaction.BroadcastHeight,
+			"node":            &pendingTransaction.Node,
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pendingTransaction.SwapIds = strings.Split(swapIds, ",")
+
+	return &pendingTransaction, nil
+}
+
+func (database *Database) QueryPendingTransaction(id string) (*PendingTransaction, error) {
+	rows, err := database.db.Query("SELECT * FROM pendingTransactions WHERE id = ?", id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, errors.New("could not find pending transaction " + id)
+	}
+
+	return parsePendingTransaction(rows)
+}
+
+func (database *Database) QueryPendingTransactions() (pendingTransactions []PendingTransaction, err error) {
+	rows, err := database.db.Query("SELECT * FROM pendingTransactions")
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		pendingTransaction, err := parsePendingTransaction(rows)
+
+		if err != nil {
+			return nil, err
+		}
+
+		pendingTransactions = append(pendingTransactions, *pendingTransaction)
+	}
+
+	return pendingTransactions, nil
+}
+
+func (database *Database) CreatePendingTransaction(pendingTransaction PendingTransaction) error {
+	insertStatement := "INSERT INTO pendingTransactions (id, type, swapIds, address, feeSatPerVbyte, broadcastHeight, node) VALUES (?, ?, ?, ?, ?, ?, ?)"
+	_, err := database.db.Exec(
+		insertStatement,
+		pendingTransaction.Id,
+		pendingTransaction.Type,
+		strings.Join(pendingTransaction.SwapIds, ","),
+		pendingTransaction.Address,
+		pendingTransaction.FeeSatPerVbyte,
+		pendingTransaction.BroadcastHeight,
+		pendingTransaction.Node,
+	)
+
+	return err
+}
+
+func (database *Database) DeletePendingTransaction(id string) error {
+	_, err := database.db.Exec("DELETE FROM pendingTransactions WHERE id = ?", id)
+	return err
+}
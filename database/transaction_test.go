@@ -0,0 +1,38 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPendingTransactions(t *testing.T) {
+	database, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	pendingTransaction := PendingTransaction{
+		Id:              "2f3bd2c5e2f9c0d79b3d4b5b6e8ef3f8f5e1a0b4c9d8e7f6a5b4c3d2e1f0a9b8",
+		Type:            RefundTransaction,
+		SwapIds:         []string{"first", "second"},
+		Address:         "bcrt1q0ghnvwtshnsecr2dh05u7q0vuwlqkdl4jfz7zr",
+		FeeSatPerVbyte:  12,
+		BroadcastHeight: 210,
+		Node:            "second",
+	}
+	assert.Nil(t, database.CreatePendingTransaction(pendingTransaction))
+
+	queried, err := database.QueryPendingTransaction(pendingTransaction.Id)
+
+	assert.Nil(t, err)
+	assert.Equal(t, pendingTransaction, *queried)
+
+	pendingTransactions, err := database.QueryPendingTransactions()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []PendingTransaction{pendingTransaction}, pendingTransactions)
+
+	assert.Nil(t, database.DeletePendingTransaction(pendingTransaction.Id))
+
+	_, err = database.QueryPendingTransaction(pendingTransaction.Id)
+	assert.Equal(t, "could not find pending transaction "+pendingTransaction.Id, err.Error())
+}
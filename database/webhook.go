@@ -0,0 +1,146 @@
+package database
+
+import (
+	"database/sql"
+	"time"
+)
+
+// WebhookNotification is an entry in the outbox of the webhook notifier. Every notification is sent to a single URL
+// and there is at most one notification per URL, swap, event and transaction
+type WebhookNotification struct {
+	Id     int64
+	Url    string
+	SwapId string
+	Event  string
+
+	// Only set for events of transactions, so that every transaction that replaces another one is notified about too
+	TransactionId string
+
+	// Body of the request. It is persisted so that retries send exactly the same signed payload
+	Payload string
+
+	Attempts    uint32
+	NextAttempt time.Time
+
+	Delivered bool
+	// Set when the notification could not be delivered after the maximal number of attempts
+	Failed bool
+}
+
+func parseWebhookNotification(rows *sql.Rows) (*WebhookNotification, error) {
+	var notification WebhookNotification
+
+	var nextAttempt int64
+
+	err := scanRow(
+		rows,
+		map[string]interface{}{
+			"id":            &notification.Id,
+			"url":           &notification.Url,
+			"swapId":        &notification.SwapId,
+			"event":         &notification.Event,
+			"transactionId": &notification.TransactionId,
+			"payload":       &notification.Payload,
+			"attempts":      &notification.Attempts,
+			"nextAttempt":   &nextAttempt,
+			"delivered":     &notification.Delivered,
+			"failed":        &notification.Failed,
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	notification.NextAttempt = time.Unix(nextAttempt, 0)
+
+	return &notification, nil
+}
+
+// QueryDueWebhookNotifications returns the notifications that were neither delivered nor given up on and whose next
+// attempt is due at the specified time
+func (database *Database) QueryDueWebhookNotifications(now time.Time) (notifications []WebhookNotification, err error) {
+	rows, err := database.db.Query(
+		"SELECT * FROM webhookNotifications WHERE delivered = ? AND failed = ? AND nextAttempt <= ? ORDER BY id",
+		false,
+		false,
+		now.Unix(),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		notification, err := parseWebhookNotification(rows)
+
+		if err != nil {
+			return nil, err
+		}
+
+		notifications = append(notifications, *notification)
+	}
+
+	return notifications, nil
+}
+
+// CreateWebhookNotification adds the notification to the outbox and returns false if an equal one was created already
+func (database *Database) CreateWebhookNotification(notification WebhookNotification) (bool, error) {
+	insertStatement := "INSERT INTO webhookNotifications (url, swapId, event, transactionId, payload, attempts, nextAttempt, delivered, failed) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT DO NOTHING"
+	result, err := database.db.Exec(
+		insertStatement,
+		notification.Url,
+		notification.SwapId,
+		notification.Event,
+		notification.TransactionId,
+		notification.Payload,
+		notification.Attempts,
+		notification.NextAttempt.Unix(),
+		notification.Delivered,
+		notification.Failed,
+	)
+
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected != 0, nil
+}
+
+func (database *Database) SetWebhookNotificationDelivered(notification *WebhookNotification) error {
+	notification.Attempts += 1
+	notification.Delivered = true
+
+	_, err := database.db.Exec(
+		"UPDATE webhookNotifications SET attempts = ?, delivered = ? WHERE id = ?",
+		notification.Attempts,
+		notification.Delivered,
+		notification.Id,
+	)
+	return err
+}
+
+// SetWebhookNotificationAttemptFailed records a failed delivery attempt and schedules the next one. If failed is
+// true, no more attempts will be made
+func (database *Database) SetWebhookNotificationAttemptFailed(notification *WebhookNotification, nextAttempt time.Time, failed bool) error {
+	notification.Attempts += 1
+	notification.NextAttempt = nextAttempt
+	notification.Failed = failed
+
+	_, err := database.db.Exec(
+		"UPDATE webhookNotifications SET attempts = ?, nextAttempt = ?, failed = ? WHERE id = ?",
+		notification.Attempts,
+		notification.NextAttempt.Unix(),
+		notification.Failed,
+		notification.Id,
+	)
+	return err
+}
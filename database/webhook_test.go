@@ -0,0 +1,72 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookNotifications(t *testing.T) {
+	database, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	now := time.Unix(1600000000, 0)
+
+	notification := WebhookNotification{
+		Url:         "https://example.com/webhook",
+		SwapId:      "swap",
+		Event:       "successful",
+		Payload:     "{}",
+		NextAttempt: now,
+	}
+
+	created, err := database.CreateWebhookNotification(notification)
+
+	assert.Nil(t, err)
+	assert.True(t, created)
+
+	// Notifications are only created once per URL, swap and event
+	created, err = database.CreateWebhookNotification(notification)
+
+	assert.Nil(t, err)
+	assert.False(t, created)
+
+	notification.Url = "https://example.org/webhook"
+	notification.NextAttempt = now.Add(time.Minute)
+
+	created, err = database.CreateWebhookNotification(notification)
+
+	assert.Nil(t, err)
+	assert.True(t, created)
+
+	due, err := database.QueryDueWebhookNotifications(now)
+
+	assert.Nil(t, err)
+	assert.Len(t, due, 1)
+	assert.Equal(t, "https://example.com/webhook", due[0].Url)
+	assert.Equal(t, now, due[0].NextAttempt)
+
+	assert.Nil(t, database.SetWebhookNotificationAttemptFailed(&due[0], now.Add(time.Hour), false))
+
+	due, err = database.QueryDueWebhookNotifications(now.Add(time.Minute))
+
+	assert.Nil(t, err)
+	assert.Len(t, due, 1)
+	assert.Equal(t, "https://example.org/webhook", due[0].Url)
+
+	assert.Nil(t, database.SetWebhookNotificationDelivered(&due[0]))
+
+	due, err = database.QueryDueWebhookNotifications(now.Add(time.Hour))
+
+	assert.Nil(t, err)
+	assert.Len(t, due, 1)
+	assert.Equal(t, uint32(1), due[0].Attempts)
+
+	assert.Nil(t, database.SetWebhookNotificationAttemptFailed(&due[0], now.Add(time.Hour), true))
+
+	due, err = database.QueryDueWebhookNotifications(now.Add(time.Hour))
+
+	assert.Nil(t, err)
+	assert.Empty(t, due)
+}
@@ -0,0 +1,125 @@
+package keys
+
+import (
+	"database/sql"
+	"errors"
+	"io/ioutil"
+	"strings"
+
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/logger"
+	"github.com/BoltzExchange/boltz-lnd/utils"
+)
+
+type Config struct {
+	MnemonicFile string `long:"seed.mnemonicfile" description:"Path to the file to which the mnemonic of a new seed is written and from which a seed is imported into a new database"`
+}
+
+// LoadSeed reads the seed from the database. If the database has none, the seed of the mnemonic file is imported, or a
+// new seed is generated and its mnemonic written to the file when that does not exist either. The mnemonic of a new
+// seed is not written when the database is encrypted, because it would be plaintext on disk
+func (cfg *Config) LoadSeed(database *database.Database) (*Seed, error) {
+	mnemonic, err := database.QuerySeed()
+
+	if err == nil {
+		seed, err := SeedFromMnemonic(mnemonic)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return seed, cfg.checkMnemonicFile(seed)
+	}
+
+	if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	if utils.FileExists(cfg.MnemonicFile) {
+		return cfg.importSeed(database)
+	}
+
+	seed, err := NewSeed()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if database.IsEncrypted() {
+		err = database.CreateSeed(seed.Mnemonic())
+
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Warning("Generated new seed. Back its mnemonic up with \"boltzcli exportseed\", because it is needed " +
+			"to recover swaps if the database is lost")
+
+		return seed, nil
+	}
+
+	err = ioutil.WriteFile(cfg.MnemonicFile, []byte(seed.Mnemonic()+"\n"), 0600)
+
+	if err != nil {
+		return nil, errors.New("could not write mnemonic file: " + err.Error())
+	}
+
+	err = database.CreateSeed(seed.Mnemonic())
+
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Warning("Generated new seed and wrote its mnemonic to " + cfg.MnemonicFile + ". Back it up, because " +
+		"it is needed to recover swaps if the database is lost, and delete the file afterwards")
+
+	return seed, nil
+}
+
+func (cfg *Config) importSeed(database *database.Database) (*Seed, error) {
+	seed, err := cfg.readMnemonicFile()
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.CreateSeed(seed.Mnemonic())
+
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Imported seed from " + cfg.MnemonicFile + ". Swaps that were lost with a previous database can be " +
+		"recovered with the RecoverSwaps call")
+
+	return seed, nil
+}
+
+// A mnemonic file that is left over from another seed is most likely a mistake that would make a backup useless
+func (cfg *Config) checkMnemonicFile(seed *Seed) error {
+	if !utils.FileExists(cfg.MnemonicFile) {
+		return nil
+	}
+
+	fileSeed, err := cfg.readMnemonicFile()
+
+	if err != nil {
+		return err
+	}
+
+	if fileSeed.Mnemonic() != seed.Mnemonic() {
+		return errors.New("mnemonic in " + cfg.MnemonicFile + " does not match the seed of the database")
+	}
+
+	return nil
+}
+
+func (cfg *Config) readMnemonicFile() (*Seed, error) {
+	mnemonic, err := ioutil.ReadFile(cfg.MnemonicFile)
+
+	if err != nil {
+		return nil, errors.New("could not read mnemonic file: " + err.Error())
+	}
+
+	return SeedFromMnemonic(strings.TrimSpace(string(mnemonic)))
+}
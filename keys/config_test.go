@@ -0,0 +1,96 @@
+package keys
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestConfig(t *testing.T) (*Config, *database.Database, func()) {
+	dataDir, err := ioutil.TempDir("", "boltz-lnd")
+	assert.Nil(t, err)
+
+	db := &database.Database{
+		Path: path.Join(dataDir, "boltz.db"),
+	}
+	assert.Nil(t, db.Connect())
+
+	return &Config{MnemonicFile: path.Join(dataDir, "seed.txt")}, db, func() {
+		_ = os.RemoveAll(dataDir)
+	}
+}
+
+func TestLoadSeed(t *testing.T) {
+	cfg, db, cleanup := newTestConfig(t)
+	defer cleanup()
+
+	// A new seed is generated and written to the mnemonic file
+	seed, err := cfg.LoadSeed(db)
+	assert.Nil(t, err)
+
+	mnemonic, err := ioutil.ReadFile(cfg.MnemonicFile)
+	assert.Nil(t, err)
+	assert.Equal(t, seed.Mnemonic()+"\n", string(mnemonic))
+
+	info, err := os.Stat(cfg.MnemonicFile)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	loadedSeed, err := cfg.LoadSeed(db)
+	assert.Nil(t, err)
+	assert.Equal(t, seed.Mnemonic(), loadedSeed.Mnemonic())
+
+	// The mnemonic file is not needed once the seed is in the database
+	assert.Nil(t, os.Remove(cfg.MnemonicFile))
+
+	loadedSeed, err = cfg.LoadSeed(db)
+	assert.Nil(t, err)
+	assert.Equal(t, seed.Mnemonic(), loadedSeed.Mnemonic())
+
+	assert.Nil(t, ioutil.WriteFile(cfg.MnemonicFile, []byte(testMnemonic), 0600))
+
+	_, err = cfg.LoadSeed(db)
+	assert.Equal(t, "mnemonic in "+cfg.MnemonicFile+" does not match the seed of the database", err.Error())
+}
+
+func TestLoadSeedEncrypted(t *testing.T) {
+	cfg, db, cleanup := newTestConfig(t)
+	defer cleanup()
+
+	db.Encrypt = true
+	assert.Nil(t, db.SetPassphrase("passphrase"))
+
+	// The mnemonic of a new seed is only in the encrypted database
+	seed, err := cfg.LoadSeed(db)
+	assert.Nil(t, err)
+	assert.False(t, utils.FileExists(cfg.MnemonicFile))
+
+	mnemonic, err := db.QuerySeed()
+	assert.Nil(t, err)
+	assert.Equal(t, seed.Mnemonic(), mnemonic)
+
+	loadedSeed, err := cfg.LoadSeed(db)
+	assert.Nil(t, err)
+	assert.Equal(t, seed.Mnemonic(), loadedSeed.Mnemonic())
+	assert.False(t, utils.FileExists(cfg.MnemonicFile))
+}
+
+func TestImportSeed(t *testing.T) {
+	cfg, db, cleanup := newTestConfig(t)
+	defer cleanup()
+
+	assert.Nil(t, ioutil.WriteFile(cfg.MnemonicFile, []byte(testMnemonic+"\n"), 0600))
+
+	seed, err := cfg.LoadSeed(db)
+	assert.Nil(t, err)
+	assert.Equal(t, testMnemonic, seed.Mnemonic())
+
+	mnemonic, err := db.QuerySeed()
+	assert.Nil(t, err)
+	assert.Equal(t, testMnemonic, mnemonic)
+}
@@ -0,0 +1,127 @@
+package keys
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/lightningnetwork/lnd/aezeed"
+)
+
+// Keys and preimages are derived at m/branch'/index'. All derivations are hardened so that leaking a private key of a
+// swap cannot reveal the keys of other swaps
+const (
+	keyBranch      = 0
+	preimageBranch = 1
+)
+
+// MaxIndex is the largest index from which keys and preimages can be derived
+const MaxIndex = hdkeychain.HardenedKeyStart - 1
+
+// Seed is the master key from which the private keys and preimages of all swaps are derived
+type Seed struct {
+	mnemonic string
+	master   *hdkeychain.ExtendedKey
+}
+
+// NewSeed generates a seed with fresh randomness
+func NewSeed() (*Seed, error) {
+	var entropy [aezeed.EntropySize]byte
+	_, err := rand.Read(entropy[:])
+
+	if err != nil {
+		return nil, err
+	}
+
+	cipherSeed, err := aezeed.New(aezeed.CipherSeedVersion, &entropy, time.Now())
+
+	if err != nil {
+		return nil, err
+	}
+
+	mnemonic, err := cipherSeed.ToMnemonic(nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return SeedFromMnemonic(strings.Join(mnemonic[:], " "))
+}
+
+// SeedFromMnemonic parses a mnemonic in the aezeed format of LND. Seeds with a passphrase are not supported
+func SeedFromMnemonic(mnemonic string) (*Seed, error) {
+	words := strings.Fields(mnemonic)
+
+	var parsedMnemonic aezeed.Mnemonic
+
+	if len(words) != len(parsedMnemonic) {
+		return nil, errors.New("mnemonic has to consist of " + strconv.Itoa(len(parsedMnemonic)) + " words")
+	}
+
+	copy(parsedMnemonic[:], words)
+
+	cipherSeed, err := parsedMnemonic.ToCipherSeed(nil)
+
+	if err != nil {
+		return nil, errors.New("invalid mnemonic: " + err.Error())
+	}
+
+	master, err := hdkeychain.NewMaster(cipherSeed.Entropy[:], &chaincfg.MainNetParams)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Seed{
+		mnemonic: strings.Join(words, " "),
+		master:   master,
+	}, nil
+}
+
+func (seed *Seed) Mnemonic() string {
+	return seed.mnemonic
+}
+
+// DeriveKey returns the refund key of a Swap or the claim key of a Reverse Swap
+func (seed *Seed) DeriveKey(index uint32) (*btcec.PrivateKey, error) {
+	return seed.derivePrivateKey(keyBranch, index)
+}
+
+// DerivePreimage returns the preimage of a Reverse Swap or of a Swap whose invoice is created by the daemon. It is the
+// hash of a private key of its own branch, so that knowing a preimage reveals neither the key nor other preimages
+func (seed *Seed) DerivePreimage(index uint32) ([]byte, error) {
+	privateKey, err := seed.derivePrivateKey(preimageBranch, index)
+
+	if err != nil {
+		return nil, err
+	}
+
+	preimage := sha256.Sum256(privateKey.Serialize())
+	return preimage[:], nil
+}
+
+func (seed *Seed) derivePrivateKey(branch uint32, index uint32) (*btcec.PrivateKey, error) {
+	if index > MaxIndex {
+		return nil, errors.New("index " + strconv.FormatUint(uint64(index), 10) + " is out of range")
+	}
+
+	branchKey, err := seed.master.Child(hdkeychain.HardenedKeyStart + branch)
+
+	if err != nil {
+		return nil, err
+	}
+
+	child, err := branchKey.Child(hdkeychain.HardenedKeyStart + index)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return child.ECPrivKey()
+}
@@ -0,0 +1,61 @@
+package keys
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testMnemonic = "about garbage until infant fall vague erupt hood bacon awful winter solar tomorrow leg trade tongue warm hill rigid salon stay swamp salute hill"
+
+func TestDerivation(t *testing.T) {
+	seed, err := SeedFromMnemonic(testMnemonic)
+	assert.Nil(t, err)
+
+	// Changing the derivation would make the swaps of existing seeds unrecoverable
+	key, err := seed.DeriveKey(0)
+	assert.Nil(t, err)
+	assert.Equal(t, "0307068a643a86d879abcae72b23ab753e9c53dd61128b2edc3102052d651172f6", hex.EncodeToString(key.PubKey().SerializeCompressed()))
+
+	preimage, err := seed.DerivePreimage(0)
+	assert.Nil(t, err)
+	assert.Equal(t, "217f3dc3f6ceb2a40bc4e79eaca60c1ede60371df93839a733b1957b4016749c", hex.EncodeToString(preimage))
+
+	nextKey, err := seed.DeriveKey(1)
+	assert.Nil(t, err)
+	assert.NotEqual(t, key.Serialize(), nextKey.Serialize())
+
+	nextPreimage, err := seed.DerivePreimage(1)
+	assert.Nil(t, err)
+	assert.NotEqual(t, preimage, nextPreimage)
+
+	_, err = seed.DeriveKey(MaxIndex)
+	assert.Nil(t, err)
+
+	_, err = seed.DeriveKey(MaxIndex + 1)
+	assert.Equal(t, "index 2147483648 is out of range", err.Error())
+}
+
+func TestSeedFromMnemonic(t *testing.T) {
+	seed, err := NewSeed()
+	assert.Nil(t, err)
+
+	parsedSeed, err := SeedFromMnemonic(seed.Mnemonic())
+	assert.Nil(t, err)
+
+	key, _ := seed.DeriveKey(7)
+	parsedKey, _ := parsedSeed.DeriveKey(7)
+	assert.Equal(t, key.Serialize(), parsedKey.Serialize())
+
+	// Additional whitespace is ignored
+	_, err = SeedFromMnemonic(" " + strings.ReplaceAll(testMnemonic, " ", "  ") + "\n")
+	assert.Nil(t, err)
+
+	_, err = SeedFromMnemonic("about garbage")
+	assert.Equal(t, "mnemonic has to consist of 24 words", err.Error())
+
+	_, err = SeedFromMnemonic(strings.Replace(testMnemonic, "about", "abandon", 1))
+	assert.NotNil(t, err)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"github.com/BoltzExchange/boltz-lnd/logger"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/chainrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
@@ -17,19 +18,37 @@ import (
 	"google.golang.org/grpc/metadata"
 	"io/ioutil"
 	"strconv"
+	"strings"
 )
 
+// LightningClient is implemented by LND. Packages that depend on it instead of LND can be tested without a node
 type LightningClient interface {
 	GetInfo() (*lnrpc.GetInfoResponse, error)
 	GetNodeInfo(pubkey string) (*lnrpc.NodeInfo, error)
+	ConnectPeer(pubKey string, host string) (*lnrpc.ConnectPeerResponse, error)
+	PendingChannels() (*lnrpc.PendingChannelsResponse, error)
 	ListChannels() (*lnrpc.ListChannelsResponse, error)
 	ClosedChannels() (*lnrpc.ClosedChannelsResponse, error)
 	GetChannelInfo(chanId uint64) (*lnrpc.ChannelEdge, error)
 	ListInactiveChannels() (*lnrpc.ListChannelsResponse, error)
+	CloseChannel(channelPoint string) (lnrpc.Lightning_CloseChannelClient, error)
 	ForceCloseChannel(channelPoint string) (lnrpc.Lightning_CloseChannelClient, error)
+	AddInvoice(value int64, preimage []byte, expiry int64, memo string) (*lnrpc.AddInvoiceResponse, error)
+	SettleInvoice(preimage []byte) (*invoicesrpc.SettleInvoiceResp, error)
+	CancelInvoice(preimageHash []byte) (*invoicesrpc.CancelInvoiceResp, error)
+	LookupInvoice(preimageHash []byte) (*lnrpc.Invoice, error)
+	NewAddress() (string, error)
+	EstimateFee(confTarget int32) (*walletrpc.EstimateFeeResponse, error)
+	RegisterBlockListener(channel chan *chainrpc.BlockEpoch) error
+	RegisterConfirmationListener(transactionId string, outputScript []byte, heightHint uint32, stop chan bool) (bool, error)
+	RegisterChannelAcceptor(acceptChannel func(request *lnrpc.ChannelAcceptRequest) bool) error
+	SubscribeSingleInvoice(preimageHash []byte, channel chan *lnrpc.Invoice, errChannel chan error)
 }
 
+var _ LightningClient = &LND{}
+
 type LND struct {
+	Name        string `long:"lnd.name" description:"Name with which the LND node is chosen in RPC requests"`
 	Host        string `long:"lnd.host" description:"gRPC host of the LND node"`
 	Port        int    `long:"lnd.port" description:"gRPC port of the LND node"`
 	Macaroon    string `long:"lnd.macaroon" description:"Path to a macaroon file of the LND node"`
@@ -93,6 +112,12 @@ func (lnd *LND) ConnectPeer(pubKey string, host string) (*lnrpc.ConnectPeerRespo
 	})
 }
 
+func (lnd *LND) GetNodeInfo(pubkey string) (*lnrpc.NodeInfo, error) {
+	return lnd.client.GetNodeInfo(lnd.ctx, &lnrpc.NodeInfoRequest{
+		PubKey: pubkey,
+	})
+}
+
 func (lnd *LND) PendingChannels() (*lnrpc.PendingChannelsResponse, error) {
 	return lnd.client.PendingChannels(lnd.ctx, &lnrpc.PendingChannelsRequest{})
 }
@@ -101,6 +126,16 @@ func (lnd *LND) ListChannels() (*lnrpc.ListChannelsResponse, error) {
 	return lnd.client.ListChannels(lnd.ctx, &lnrpc.ListChannelsRequest{})
 }
 
+func (lnd *LND) ClosedChannels() (*lnrpc.ClosedChannelsResponse, error) {
+	return lnd.client.ClosedChannels(lnd.ctx, &lnrpc.ClosedChannelsRequest{})
+}
+
+func (lnd *LND) ListInactiveChannels() (*lnrpc.ListChannelsResponse, error) {
+	return lnd.client.ListChannels(lnd.ctx, &lnrpc.ListChannelsRequest{
+		InactiveOnly: true,
+	})
+}
+
 func (lnd *LND) AddInvoice(value int64, preimage []byte, expiry int64, memo string) (*lnrpc.AddInvoiceResponse, error) {
 	return lnd.client.AddInvoice(lnd.ctx, &lnrpc.Invoice{
 		Memo:      memo,
@@ -143,7 +178,43 @@ func (lnd *LND) GetChannelInfo(channelId uint64) (*lnrpc.ChannelEdge, error) {
 	})
 }
 
-func (lnd *LND) PayInvoice(invoice string, maxParts uint32, timeoutSeconds int32) (*lnrpc.Payment, error) {
+// CloseChannel closes the channel with the channel point "txid:vout" cooperatively. The first update of the stream is
+// sent once the closing transaction is broadcast
+func (lnd *LND) CloseChannel(channelPoint string) (lnrpc.Lightning_CloseChannelClient, error) {
+	return lnd.closeChannel(channelPoint, false)
+}
+
+// ForceCloseChannel broadcasts the latest commitment transaction of the channel, which works while the peer is offline
+func (lnd *LND) ForceCloseChannel(channelPoint string) (lnrpc.Lightning_CloseChannelClient, error) {
+	return lnd.closeChannel(channelPoint, true)
+}
+
+func (lnd *LND) closeChannel(channelPoint string, force bool) (lnrpc.Lightning_CloseChannelClient, error) {
+	split := strings.Split(channelPoint, ":")
+
+	if len(split) != 2 {
+		return nil, errors.New("invalid channel point: " + channelPoint)
+	}
+
+	vout, err := strconv.ParseUint(split[1], 10, 32)
+
+	if err != nil {
+		return nil, errors.New("invalid channel point: " + channelPoint)
+	}
+
+	return lnd.client.CloseChannel(lnd.ctx, &lnrpc.CloseChannelRequest{
+		ChannelPoint: &lnrpc.ChannelPoint{
+			FundingTxid: &lnrpc.ChannelPoint_FundingTxidStr{
+				FundingTxidStr: split[0],
+			},
+			OutputIndex: uint32(vout),
+		},
+		Force: force,
+	})
+}
+
+// PayInvoice only pays through the outgoing channels if any are specified
+func (lnd *LND) PayInvoice(invoice string, maxParts uint32, timeoutSeconds int32, outgoingChannelIds []uint64) (*lnrpc.Payment, error) {
 	feeLimit, err := lnd.getFeeLimit(invoice)
 
 	if err != nil {
@@ -151,10 +222,11 @@ func (lnd *LND) PayInvoice(invoice string, maxParts uint32, timeoutSeconds int32
 	}
 
 	client, err := lnd.router.SendPaymentV2(lnd.ctx, &routerrpc.SendPaymentRequest{
-		MaxParts:       maxParts,
-		PaymentRequest: invoice,
-		TimeoutSeconds: timeoutSeconds,
-		FeeLimitSat:    feeLimit,
+		MaxParts:        maxParts,
+		PaymentRequest:  invoice,
+		TimeoutSeconds:  timeoutSeconds,
+		FeeLimitSat:     feeLimit,
+		OutgoingChanIds: outgoingChannelIds,
 	})
 
 	if err != nil {
@@ -202,6 +274,17 @@ func (lnd *LND) NewAddress() (string, error) {
 	return response.Address, err
 }
 
+func (lnd *LND) SendCoins(address string, amount int64, confTarget int32, satPerVbyte int64, label string) (*lnrpc.SendCoinsResponse, error) {
+	return lnd.client.SendCoins(lnd.ctx, &lnrpc.SendCoinsRequest{
+		Addr:       address,
+		Amount:     amount,
+		TargetConf: confTarget,
+		// The fee rate is actually in satoshis per vbyte
+		SatPerByte: satPerVbyte,
+		Label:      label,
+	})
+}
+
 func (lnd *LND) EstimateFee(confTarget int32) (*walletrpc.EstimateFeeResponse, error) {
 	return lnd.walletKit.EstimateFee(lnd.ctx, &walletrpc.EstimateFeeRequest{
 		ConfTarget: confTarget,
@@ -235,6 +318,85 @@ func (lnd *LND) RegisterBlockListener(channel chan *chainrpc.BlockEpoch) error {
 	return <-errChannel
 }
 
+// RegisterConfirmationListener blocks until the transaction confirmed or the stop channel is closed and returns whether
+// it confirmed. The output script has to be the one of an output of the transaction and the height hint a height before
+// the transaction could have confirmed
+func (lnd *LND) RegisterConfirmationListener(transactionId string, outputScript []byte, heightHint uint32, stop chan bool) (bool, error) {
+	transactionHash, err := chainhash.NewHashFromStr(transactionId)
+
+	if err != nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithCancel(lnd.ctx)
+	defer cancel()
+
+	client, err := lnd.chainNotifier.RegisterConfirmationsNtfn(ctx, &chainrpc.ConfRequest{
+		Txid:       transactionHash[:],
+		Script:     outputScript,
+		NumConfs:   1,
+		HeightHint: heightHint,
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	go func() {
+		select {
+		case <-stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		event, err := client.Recv()
+
+		if err != nil {
+			// The stream is canceled when the stop channel is closed
+			if ctx.Err() != nil {
+				return false, nil
+			}
+
+			return false, err
+		}
+
+		if event.GetConf() != nil {
+			return true, nil
+		}
+	}
+}
+
+// RegisterChannelAcceptor lets the function decide whether channels that are opened to the node are accepted. LND
+// rejects channels that are not answered in time, so the function has to be quick. Returns when the stream breaks
+func (lnd *LND) RegisterChannelAcceptor(acceptChannel func(request *lnrpc.ChannelAcceptRequest) bool) error {
+	client, err := lnd.client.ChannelAcceptor(lnd.ctx)
+
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Connected to LND channel acceptor stream")
+
+	for {
+		request, err := client.Recv()
+
+		if err != nil {
+			return err
+		}
+
+		err = client.Send(&lnrpc.ChannelAcceptResponse{
+			Accept:        acceptChannel(request),
+			PendingChanId: request.PendingChanId,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
 func (lnd *LND) SubscribeSingleInvoice(preimageHash []byte, channel chan *lnrpc.Invoice, errChannel chan error) {
 	client, err := lnd.invoices.SubscribeSingleInvoice(lnd.ctx, &invoicesrpc.SubscribeSingleInvoiceRequest{
 		RHash: preimageHash,
@@ -8,7 +8,7 @@ import (
 
 const (
 	minPaymentFee              = 21
-	maxPaymentFeeRatio float64 = 0.00000001 
+	maxPaymentFeeRatio float64 = 0.03
 )
 
 // getFeeLimit calculates the fee limit of a payment in sat
@@ -19,11 +19,16 @@ func (lnd *LND) getFeeLimit(invoice string) (int64, error) {
 		return 0, err
 	}
 
+	return CalculateFeeLimit(decodedInvoice.MilliSat.ToSatoshis()), nil
+}
+
+// CalculateFeeLimit returns the maximal routing fee in sat that is paid for a payment of the amount
+func CalculateFeeLimit(amount btcutil.Amount) int64 {
 	// Use the minimum value for small payments
 	feeLimit := math.Max(
-		decodedInvoice.MilliSat.ToSatoshis().MulF64(maxPaymentFeeRatio).ToUnit(btcutil.AmountSatoshi),
+		amount.MulF64(maxPaymentFeeRatio).ToUnit(btcutil.AmountSatoshi),
 		minPaymentFee,
 	)
 
-	return int64(feeLimit), nil
+	return int64(feeLimit)
 }
@@ -34,6 +34,10 @@ var (
 			Entity: "info",
 			Action: "read",
 		}},
+		"/boltzrpc.Boltz/GetQuote": {{
+			Entity: "info",
+			Action: "read",
+		}},
 		"/boltzrpc.Boltz/ListSwaps": {{
 			Entity: "swap",
 			Action: "read",
@@ -42,6 +46,10 @@ var (
 			Entity: "swap",
 			Action: "read",
 		}},
+		"/boltzrpc.Boltz/GetFeeReport": {{
+			Entity: "swap",
+			Action: "read",
+		}},
 		"/boltzrpc.Boltz/Deposit": {{
 			Entity: "swap",
 			Action: "write",
@@ -58,6 +66,58 @@ var (
 			Entity: "swap",
 			Action: "write",
 		}},
+		"/boltzrpc.Boltz/RefundSwap": {{
+			Entity: "swap",
+			Action: "write",
+		}},
+		"/boltzrpc.Boltz/BumpFee": {{
+			Entity: "swap",
+			Action: "write",
+		}},
+		"/boltzrpc.Boltz/SubscribeSwapEvents": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/boltzrpc.Boltz/GetAutoSwapConfig": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/boltzrpc.Boltz/SetAutoSwapConfig": {{
+			Entity: "swap",
+			Action: "write",
+		}},
+		"/boltzrpc.Boltz/GetAutoSwapRecommendations": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/boltzrpc.Boltz/Unlock": {{
+			Entity: "info",
+			Action: "write",
+		}},
+		"/boltzrpc.Boltz/SetPassphrase": {{
+			Entity: "info",
+			Action: "write",
+		}},
+		"/boltzrpc.Boltz/RecoverSwaps": {{
+			Entity: "swap",
+			Action: "write",
+		}},
+		"/boltzrpc.Boltz/ExportRescueFile": {{
+			Entity: "swap",
+			Action: "write",
+		}},
+		"/boltzrpc.Boltz/ExportSeed": {{
+			Entity: "swap",
+			Action: "write",
+		}},
+		"/boltzrpc.Boltz/ImportSwaps": {{
+			Entity: "swap",
+			Action: "write",
+		}},
+		"/boltzrpc.Boltz/ListDanglingChannels": {{
+			Entity: "swap",
+			Action: "read",
+		}},
 	}
 )
 
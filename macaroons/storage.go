@@ -5,8 +5,6 @@ import (
 	"github.com/BoltzExchange/boltz-lnd/database"
 )
 
-// TODO: encryption
-
 var rootKeyLen = 32
 
 type RootKeyStorage struct {
@@ -0,0 +1,82 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Namespace is the prefix of the names of all metrics of the daemon
+const Namespace = "boltz"
+
+// Registry contains all metrics of the daemon. It is served by the metrics endpoint of the RPC server
+var Registry = prometheus.NewRegistry()
+
+var (
+	routingFees = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "routing_fees_millisatoshis_total",
+		Help:      "Routing fees paid for the invoices of Reverse Swaps",
+	})
+
+	minerFees = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "miner_fees_satoshis_total",
+		Help:      "Miner fees paid for claim and refund transactions",
+	}, []string{"type"})
+
+	eventStreamReconnects = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "event_stream_reconnects_total",
+		Help:      "Reconnections to the swap status event stream of the Boltz API",
+	}, []string{"type"})
+
+	apiRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Name:      "api_request_duration_seconds",
+		Help:      "Latency of requests to the Boltz API",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"endpoint"})
+
+	apiRequestErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "api_request_errors_total",
+		Help:      "Requests to the Boltz API that failed",
+	}, []string{"endpoint"})
+)
+
+func init() {
+	Registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		routingFees,
+		minerFees,
+		eventStreamReconnects,
+		apiRequestDuration,
+		apiRequestErrors,
+	)
+}
+
+func AddRoutingFee(feeMsat int64) {
+	routingFees.Add(float64(feeMsat))
+}
+
+// AddMinerFee records the fee of a claim or refund transaction. The transactionType is "claim" or "refund"
+func AddMinerFee(transactionType string, fee int64) {
+	minerFees.WithLabelValues(transactionType).Add(float64(fee))
+}
+
+// AddEventStreamReconnect records a reconnection to the event stream of a Swap or Reverse Swap
+func AddEventStreamReconnect(swapType string) {
+	eventStreamReconnects.WithLabelValues(swapType).Inc()
+}
+
+// ObserveApiRequest records the duration of a request to an endpoint of the Boltz API and whether it failed or was
+// rejected by the API
+func ObserveApiRequest(endpoint string, start time.Time, failed bool) {
+	apiRequestDuration.WithLabelValues(endpoint).Observe(time.Since(start).Seconds())
+
+	if failed {
+		apiRequestErrors.WithLabelValues(endpoint).Inc()
+	}
+}
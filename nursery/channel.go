@@ -2,15 +2,32 @@ package nursery
 
 import (
 	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
 	"github.com/BoltzExchange/boltz-lnd/database"
 	"github.com/BoltzExchange/boltz-lnd/logger"
+	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/zpay32"
 	"math"
 	"strconv"
 	"time"
 )
 
+const channelQueryAttempts = 3
+
+var channelQueryRetryInterval = retryInterval * time.Second
+
+// Capacity and privacy of the channel Boltz has to open for a pending Channel Creation
+type expectedChannel struct {
+	swapId   string
+	capacity int64
+	private  bool
+}
+
 func (nursery *Nursery) subscribeChannelCreationInvoice(swap database.Swap, channelCreation *database.ChannelCreation) chan bool {
 	stopListening := make(chan bool)
 
@@ -27,46 +44,7 @@ func (nursery *Nursery) subscribeChannelCreationInvoice(swap database.Swap, chan
 			case invoice := <-invoiceChannel:
 				switch invoice.State {
 				case lnrpc.Invoice_ACCEPTED:
-					var expectedChannelId uint64
-
-					channels, err := nursery.lnd.ListChannels()
-
-					if err != nil {
-						logger.Warning("Could not query channels")
-						return
-					}
-
-					for _, channel := range channels.Channels {
-						id, vout, err := parseChannelPoint(channel.ChannelPoint)
-
-						if err != nil {
-							logger.Error("Could not parse funding channel point: " + err.Error())
-							return
-						}
-
-						if id == channelCreation.FundingTransactionId && vout == channelCreation.FundingTransactionVout {
-							expectedChannelId = channel.ChanId
-							break
-						}
-					}
-
-					if expectedChannelId == 0 {
-						logger.Error("Could not find Channel of Channel Creation " + swap.Id)
-						return
-					}
-
-					for _, htlc := range invoice.Htlcs {
-						if htlc.ChanId != expectedChannelId {
-							logger.Error("Not all HTLCs of Channel Creation " + swap.Id + " were sent through the correct channel")
-							return
-						}
-					}
-
-					_, err = nursery.lnd.SettleInvoice(swap.Preimage)
-
-					if err != nil {
-						logger.Error("Could not settle invoice of Channel Creation " + swap.Id + ": " + err.Error())
-					}
+					nursery.handleAcceptedChannelCreationInvoice(&swap, channelCreation, invoice)
 
 				case lnrpc.Invoice_SETTLED:
 					nursery.updateChannelCreationStatus(channelCreation, boltz.ChannelSettled)
@@ -94,11 +72,220 @@ func (nursery *Nursery) subscribeChannelCreationInvoice(swap database.Swap, chan
 	return stopListening
 }
 
+// The HTLCs are only settled when all of them were sent through the channel Boltz opened for the Channel Creation.
+// Otherwise, the Channel Creation fails
+func (nursery *Nursery) handleAcceptedChannelCreationInvoice(swap *database.Swap, channelCreation *database.ChannelCreation, invoice *lnrpc.Invoice) {
+	channelId, err := nursery.findChannelCreationChannel(channelCreation)
+
+	if err != nil {
+		nursery.failChannelCreation(swap, channelCreation, "could not query channels: "+err.Error())
+		return
+	}
+
+	if channelId == 0 {
+		nursery.failChannelCreation(swap, channelCreation, "could not find channel of Channel Creation")
+		return
+	}
+
+	if !areHtlcsInChannel(invoice.Htlcs, channelId) {
+		nursery.failChannelCreation(swap, channelCreation, "not all HTLCs were sent through the channel of the Channel Creation")
+		return
+	}
+
+	_, err = nursery.lnd.SettleInvoice(swap.Preimage)
+
+	if err != nil {
+		logger.Error("Could not settle invoice of Channel Creation " + swap.Id + ": " + err.Error())
+	}
+}
+
+// Returns 0 if LND has no channel with the funding transaction of the Channel Creation
+func (nursery *Nursery) findChannelCreationChannel(channelCreation *database.ChannelCreation) (uint64, error) {
+	channels, err := nursery.listChannels()
+
+	if err != nil {
+		return 0, err
+	}
+
+	for _, channel := range channels.Channels {
+		id, vout, err := parseChannelPoint(channel.ChannelPoint)
+
+		if err != nil {
+			return 0, errors.New("could not parse funding channel point: " + err.Error())
+		}
+
+		if id == channelCreation.FundingTransactionId && vout == channelCreation.FundingTransactionVout {
+			return channel.ChanId, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// The HTLCs of an accepted invoice are locked until it is settled or cancelled, so the query is retried a few times
+// before the Channel Creation is failed because of it
+func (nursery *Nursery) listChannels() (channels *lnrpc.ListChannelsResponse, err error) {
+	for attempt := 1; attempt <= channelQueryAttempts; attempt++ {
+		channels, err = nursery.lnd.ListChannels()
+
+		if err == nil {
+			return channels, nil
+		}
+
+		logger.Warning("Could not query channels: " + err.Error())
+
+		if attempt < channelQueryAttempts {
+			time.Sleep(channelQueryRetryInterval)
+		}
+	}
+
+	return nil, err
+}
+
+func areHtlcsInChannel(htlcs []*lnrpc.InvoiceHTLC, channelId uint64) bool {
+	for _, htlc := range htlcs {
+		if htlc.ChanId != channelId {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Cancels the hold invoice, so that the HTLCs are not locked until it expires, and stops listening to the events of the
+// Channel Creation. The invoice subscription keeps running until the event listener stops it. The Swap is refunded
+// after its timeout
+func (nursery *Nursery) failChannelCreation(swap *database.Swap, channelCreation *database.ChannelCreation, reason string) {
+	logger.Error("Channel Creation " + swap.Id + " failed: " + reason)
+
+	preimageHash := sha256.Sum256(swap.Preimage)
+	_, err := nursery.lnd.CancelInvoice(preimageHash[:])
+
+	if err != nil {
+		logger.Error("Could not cancel invoice of Channel Creation " + swap.Id + ": " + err.Error())
+	}
+
+	err = nursery.database.UpdateSwapState(swap, boltzrpc.SwapState_ERROR, reason)
+
+	if err != nil {
+		logger.Error("Could not update state of Channel Creation " + swap.Id + ": " + err.Error())
+	}
+
+	nursery.updateChannelCreationStatus(channelCreation, boltz.ChannelError)
+
+	go nursery.stopEventListener(swap.Id)
+}
+
 func (nursery *Nursery) subscribeSingleInvoice(swapId string, preimageHash []byte, invoiceChannel chan *lnrpc.Invoice, errorChannel chan error) {
 	logger.Info("Subscribing to invoice events of Channel Creation " + swapId)
 	nursery.lnd.SubscribeSingleInvoice(preimageHash, invoiceChannel, errorChannel)
 }
 
+func (nursery *Nursery) registerChannelAcceptor() {
+	logger.Info("Connecting to LND channel acceptor stream")
+	err := nursery.lnd.RegisterChannelAcceptor(nursery.acceptChannel)
+
+	if err != nil {
+		logger.Error("Lost connection to LND channel acceptor stream: " + err.Error())
+		logger.Info("Retrying LND connection in " + strconv.Itoa(retryInterval) + " seconds")
+
+		time.Sleep(retryInterval * time.Second)
+
+		nursery.registerChannelAcceptor()
+	}
+}
+
+// Channels of other nodes, and the ones of Boltz while no Channel Creation is pending, are not opened for swaps and
+// therefore always accepted
+func (nursery *Nursery) acceptChannel(request *lnrpc.ChannelAcceptRequest) bool {
+	if hex.EncodeToString(request.NodePubkey) != nursery.boltzPubKey {
+		return true
+	}
+
+	expectedChannels, err := nursery.getExpectedChannels()
+
+	if err != nil {
+		logger.Error("Rejected channel of Boltz because the pending Channel Creations could not be queried: " + err.Error())
+		return false
+	}
+
+	if len(expectedChannels) == 0 {
+		return true
+	}
+
+	capacity := int64(request.FundingAmt)
+	private := lnwire.FundingFlag(request.ChannelFlags)&lnwire.FFAnnounceChannel == 0
+
+	expected := findExpectedChannel(expectedChannels, capacity, private)
+
+	if expected == nil {
+		logger.Warning("Rejected channel of Boltz with capacity of " + strconv.FormatInt(capacity, 10) + " satoshis " +
+			"that is " + formatChannelPrivacy(private) + ", because it does not match any pending Channel Creation")
+		return false
+	}
+
+	logger.Info("Accepted channel of Boltz for Channel Creation " + expected.swapId)
+	return true
+}
+
+func findExpectedChannel(expectedChannels []expectedChannel, capacity int64, private bool) *expectedChannel {
+	for i := range expectedChannels {
+		if capacity >= expectedChannels[i].capacity && private == expectedChannels[i].private {
+			return &expectedChannels[i]
+		}
+	}
+
+	return nil
+}
+
+// Returns the channels of the Channel Creations of the node that were not opened yet. Channel Creations without invoice
+// are skipped, because Boltz does not open their channel before the invoice is set
+func (nursery *Nursery) getExpectedChannels() ([]expectedChannel, error) {
+	swaps, err := nursery.database.QueryPendingSwaps()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var expectedChannels []expectedChannel
+
+	for _, swap := range swaps {
+		if swap.Node != nursery.node || swap.Invoice == "" {
+			continue
+		}
+
+		channelCreation, err := nursery.database.QueryChannelCreation(swap.Id)
+
+		if err != nil || channelCreation.Status != boltz.ChannelNone {
+			continue
+		}
+
+		decodedInvoice, err := zpay32.Decode(swap.Invoice, nursery.chainParams)
+
+		if err != nil {
+			return nil, errors.New("could not decode invoice of Channel Creation " + swap.Id + ": " + err.Error())
+		}
+
+		invoiceAmount := decodedInvoice.MilliSat.ToSatoshis().ToUnit(btcutil.AmountSatoshi)
+
+		expectedChannels = append(expectedChannels, expectedChannel{
+			swapId:   swap.Id,
+			capacity: calculateChannelCreationCapacity(invoiceAmount, channelCreation.InboundLiquidity),
+			private:  channelCreation.Private,
+		})
+	}
+
+	return expectedChannels, nil
+}
+
+func formatChannelPrivacy(private bool) string {
+	if private {
+		return "private"
+	}
+
+	return "public"
+}
+
 func (nursery *Nursery) updateChannelCreationStatus(channelCreation *database.ChannelCreation, state boltz.ChannelState) {
 	err := nursery.database.UpdateChannelCreationStatus(channelCreation, state)
 
@@ -0,0 +1,241 @@
+package nursery
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/zpay32"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestInvoice(t *testing.T, amount lnwire.MilliSatoshi) string {
+	nodeKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	var preimageHash [32]byte
+	copy(preimageHash[:], nodeKey.Serialize())
+
+	invoice, err := zpay32.NewInvoice(
+		&chaincfg.RegressionNetParams,
+		preimageHash,
+		time.Now(),
+		zpay32.Amount(amount),
+		zpay32.Description(""),
+	)
+	assert.Nil(t, err)
+
+	encodedInvoice, err := invoice.Encode(zpay32.MessageSigner{
+		SignCompact: func(hash []byte) ([]byte, error) {
+			return btcec.SignCompact(btcec.S256(), nodeKey, hash, true)
+		},
+	})
+	assert.Nil(t, err)
+
+	return encodedInvoice
+}
+
+func TestAcceptChannel(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "boltz-lnd")
+	assert.Nil(t, err)
+
+	defer os.RemoveAll(dataDir)
+
+	db := &database.Database{
+		Path: path.Join(dataDir, "boltz.db"),
+	}
+	assert.Nil(t, db.Connect())
+
+	boltzKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	nursery := &Nursery{
+		boltzPubKey: hex.EncodeToString(boltzKey.PubKey().SerializeCompressed()),
+		chainParams: &chaincfg.RegressionNetParams,
+		database:    db,
+	}
+
+	newRequest := func(nodeKey *btcec.PrivateKey, capacity uint64, private bool) *lnrpc.ChannelAcceptRequest {
+		request := &lnrpc.ChannelAcceptRequest{
+			NodePubkey: nodeKey.PubKey().SerializeCompressed(),
+			FundingAmt: capacity,
+		}
+
+		if !private {
+			request.ChannelFlags = uint32(lnwire.FFAnnounceChannel)
+		}
+
+		return request
+	}
+
+	// Channels of Boltz are accepted while no Channel Creation is pending
+	assert.True(t, nursery.acceptChannel(newRequest(boltzKey, 1, true)))
+
+	createChannelCreation := func(id string, node string, private bool) {
+		privateKey, err := btcec.NewPrivateKey(btcec.S256())
+		assert.Nil(t, err)
+
+		assert.Nil(t, db.CreateSwap(database.Swap{
+			Id:         id,
+			State:      boltzrpc.SwapState_PENDING,
+			Status:     boltz.InvoiceSet,
+			PrivateKey: privateKey,
+			Invoice:    newTestInvoice(t, 750000000),
+			Node:       node,
+		}))
+
+		assert.Nil(t, db.CreateChannelCreation(database.ChannelCreation{
+			SwapId:           id,
+			Status:           boltz.ChannelNone,
+			InboundLiquidity: 25,
+			Private:          private,
+		}))
+	}
+
+	createChannelCreation("private", "", true)
+
+	// Channel Creations of other nodes are ignored
+	createChannelCreation("otherNode", "second", false)
+
+	expectedChannels, err := nursery.getExpectedChannels()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []expectedChannel{{swapId: "private", capacity: 1000000, private: true}}, expectedChannels)
+
+	assert.True(t, nursery.acceptChannel(newRequest(boltzKey, 1000000, true)))
+	assert.True(t, nursery.acceptChannel(newRequest(boltzKey, 1500000, true)))
+
+	assert.False(t, nursery.acceptChannel(newRequest(boltzKey, 999999, true)))
+	assert.False(t, nursery.acceptChannel(newRequest(boltzKey, 1000000, false)))
+
+	// Channels of other nodes are always accepted
+	otherKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	assert.True(t, nursery.acceptChannel(newRequest(otherKey, 1, false)))
+
+	// Channel Creations whose channel was opened already are not pending anymore
+	channelCreation, err := db.QueryChannelCreation("private")
+	assert.Nil(t, err)
+	assert.Nil(t, db.SetChannelFunding(channelCreation, "", 0))
+
+	assert.True(t, nursery.acceptChannel(newRequest(boltzKey, 1, false)))
+}
+
+func TestAreHtlcsInChannel(t *testing.T) {
+	assert.True(t, areHtlcsInChannel([]*lnrpc.InvoiceHTLC{{ChanId: 1}, {ChanId: 1}}, 1))
+	assert.False(t, areHtlcsInChannel([]*lnrpc.InvoiceHTLC{{ChanId: 1}, {ChanId: 2}}, 1))
+}
+
+func TestHandleAcceptedChannelCreationInvoice(t *testing.T) {
+	db, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	channelQueryRetryInterval = 0
+
+	createChannelCreation := func(id string) (*database.Swap, *database.ChannelCreation) {
+		privateKey, err := btcec.NewPrivateKey(btcec.S256())
+		assert.Nil(t, err)
+
+		swap := database.Swap{
+			Id:         id,
+			State:      boltzrpc.SwapState_PENDING,
+			Status:     boltz.InvoiceSet,
+			PrivateKey: privateKey,
+			Preimage:   []byte(id),
+		}
+		assert.Nil(t, db.CreateSwap(swap))
+
+		channelCreation := database.ChannelCreation{
+			SwapId:                 id,
+			Status:                 boltz.ChannelAccepted,
+			FundingTransactionId:   "funding",
+			FundingTransactionVout: 1,
+		}
+		assert.Nil(t, db.CreateChannelCreation(channelCreation))
+
+		return &swap, &channelCreation
+	}
+
+	checkFailed := func(swap *database.Swap, reason string) {
+		queriedSwap, err := db.QuerySwap(swap.Id)
+
+		assert.Nil(t, err)
+		assert.Equal(t, boltzrpc.SwapState_ERROR, queriedSwap.State)
+		assert.Equal(t, reason, queriedSwap.Error)
+
+		channelCreation, err := db.QueryChannelCreation(swap.Id)
+
+		assert.Nil(t, err)
+		assert.Equal(t, boltz.ChannelError, channelCreation.Status)
+	}
+
+	invoice := &lnrpc.Invoice{
+		Htlcs: []*lnrpc.InvoiceHTLC{{ChanId: 7}, {ChanId: 7}},
+	}
+
+	// HTLCs that were sent through the channel of the Channel Creation are settled
+	lightning := &testLightning{
+		channels: &lnrpc.ListChannelsResponse{
+			Channels: []*lnrpc.Channel{
+				{ChannelPoint: "other:1", ChanId: 6},
+				{ChannelPoint: "funding:1", ChanId: 7},
+			},
+		},
+	}
+
+	nursery := &Nursery{
+		lnd:      lightning,
+		database: db,
+	}
+
+	swap, channelCreation := createChannelCreation("settled")
+	nursery.handleAcceptedChannelCreationInvoice(swap, channelCreation, invoice)
+
+	assert.Equal(t, [][]byte{swap.Preimage}, lightning.settledPreimages)
+	assert.Len(t, lightning.cancelledPreimageHashes, 0)
+
+	// The invoice is cancelled when any HTLC was sent through another channel
+	swap, channelCreation = createChannelCreation("otherChannel")
+	nursery.handleAcceptedChannelCreationInvoice(swap, channelCreation, &lnrpc.Invoice{
+		Htlcs: []*lnrpc.InvoiceHTLC{{ChanId: 7}, {ChanId: 6}},
+	})
+
+	preimageHash := sha256.Sum256(swap.Preimage)
+	assert.Equal(t, [][]byte{preimageHash[:]}, lightning.cancelledPreimageHashes)
+	checkFailed(swap, "not all HTLCs were sent through the channel of the Channel Creation")
+
+	// And when the channel cannot be found
+	lightning.channels.Channels = lightning.channels.Channels[:1]
+
+	swap, channelCreation = createChannelCreation("noChannel")
+	nursery.handleAcceptedChannelCreationInvoice(swap, channelCreation, invoice)
+
+	assert.Len(t, lightning.cancelledPreimageHashes, 2)
+	checkFailed(swap, "could not find channel of Channel Creation")
+
+	// And when LND cannot be queried after all attempts
+	lightning.channelsErr = errors.New("unavailable")
+	lightning.listChannelsCalls = 0
+
+	swap, channelCreation = createChannelCreation("lndError")
+	nursery.handleAcceptedChannelCreationInvoice(swap, channelCreation, invoice)
+
+	assert.Equal(t, channelQueryAttempts, lightning.listChannelsCalls)
+	assert.Len(t, lightning.cancelledPreimageHashes, 3)
+	checkFailed(swap, "could not query channels: unavailable")
+
+	assert.Len(t, lightning.settledPreimages, 1)
+}
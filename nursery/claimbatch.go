@@ -0,0 +1,263 @@
+package nursery
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/logger"
+	"github.com/BoltzExchange/boltz-lnd/metrics"
+	"github.com/btcsuite/btcutil"
+)
+
+type ClaimBatchConfig struct {
+	Blocks        uint32 `long:"claimbatch.blocks" description:"Number of blocks confirmed lockups of Reverse Swaps are collected before they are claimed in one transaction. 0 disables batching"`
+	TimeoutMargin uint32 `long:"claimbatch.timeoutmargin" description:"Number of blocks before their timeout at which Reverse Swaps are claimed at the latest"`
+}
+
+type queuedClaim struct {
+	reverseSwap database.ReverseSwap
+
+	// Can be nil in case the lockup transaction has to be fetched when claiming
+	lockupTransaction *btcutil.Tx
+}
+
+// Reverse Swaps whose lockup transaction confirmed and that wait to be claimed together
+type claimBatch struct {
+	lock sync.Mutex
+
+	// Block height at which the first Reverse Swap of the batch was queued
+	startHeight uint32
+	claims      []queuedClaim
+}
+
+// Zero-conf claims and claims somebody is waiting for are not batched. Neither are claims of cross chain Reverse Swaps,
+// which includes the ones on Liquid, because batches are timed with blocks of LND
+func (nursery *Nursery) shouldBatchClaim(reverseSwap *database.ReverseSwap, status boltz.SwapUpdateEvent, claimTransactionIdChan chan string) bool {
+	return nursery.claimBatchConfig.Blocks != 0 &&
+		reverseSwap.Currency == "" &&
+		status == boltz.TransactionConfirmed &&
+		claimTransactionIdChan == nil
+}
+
+func (nursery *Nursery) queueClaim(reverseSwap *database.ReverseSwap, lockupTransaction *btcutil.Tx) {
+	blockHeight, err := nursery.getBlockHeight()
+
+	if err != nil {
+		logger.Warning("Could not get block height from LND: " + err.Error())
+	}
+
+	nursery.claimBatch.lock.Lock()
+
+	if len(nursery.claimBatch.claims) == 0 {
+		nursery.claimBatch.startHeight = blockHeight
+	}
+
+	nursery.claimBatch.claims = append(nursery.claimBatch.claims, queuedClaim{
+		reverseSwap:       *reverseSwap,
+		lockupTransaction: lockupTransaction,
+	})
+
+	claimHeight := nursery.claimBatch.startHeight + nursery.claimBatchConfig.Blocks
+	nursery.claimBatch.lock.Unlock()
+
+	logger.Info("Queued claim of Reverse Swap " + reverseSwap.Id + " for the batch that is claimed at block " +
+		strconv.FormatUint(uint64(claimHeight), 10))
+
+	// In case the Reverse Swap is about to time out already
+	if err == nil {
+		nursery.claimQueuedReverseSwaps(blockHeight)
+	}
+}
+
+// The whole batch is claimed when it has been collected for long enough or when one of its Reverse Swaps is about to
+// time out. Claims that fail are queued again to be retried in the next block
+func (nursery *Nursery) claimQueuedReverseSwaps(blockHeight uint32) {
+	nursery.claimBatch.lock.Lock()
+
+	if !nursery.isClaimBatchDue(blockHeight) {
+		nursery.claimBatch.lock.Unlock()
+		return
+	}
+
+	claims := nursery.claimBatch.claims
+	nursery.claimBatch.claims = nil
+
+	nursery.claimBatch.lock.Unlock()
+
+	claimTransactionId, retryClaims, err := nursery.claimReverseSwaps(claims)
+
+	if len(retryClaims) != 0 {
+		nursery.claimBatch.lock.Lock()
+		nursery.claimBatch.claims = append(retryClaims, nursery.claimBatch.claims...)
+		nursery.claimBatch.lock.Unlock()
+	}
+
+	if err != nil {
+		logger.Error("Could not claim batch of " + strconv.Itoa(len(claims)) + " Reverse Swaps: " + err.Error())
+		return
+	}
+
+	logger.Info("Claimed batch of Reverse Swaps in: " + claimTransactionId)
+}
+
+func (nursery *Nursery) isClaimBatchDue(blockHeight uint32) bool {
+	if len(nursery.claimBatch.claims) == 0 {
+		return false
+	}
+
+	if blockHeight >= nursery.claimBatch.startHeight+nursery.claimBatchConfig.Blocks {
+		return true
+	}
+
+	for _, claim := range nursery.claimBatch.claims {
+		if blockHeight+nursery.claimBatchConfig.TimeoutMargin >= claim.reverseSwap.TimeoutBlockHeight {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns the claims that should be retried in the next block. Those are the ones that could not be claimed because of
+// errors that might be temporary and all claims in case the transaction could not be broadcast. Reverse Swaps that can
+// never be claimed in a batch are set to the error state
+func (nursery *Nursery) claimReverseSwaps(claims []queuedClaim) (string, []queuedClaim, error) {
+	var retryClaims []queuedClaim
+
+	var claimedReverseSwaps []*database.ReverseSwap
+	var claimedClaims []queuedClaim
+	var claimOutputs []boltz.OutputDetails
+	var claimAddresses []btcutil.Address
+
+	feeSatPerVbyte, err := nursery.getFeeEstimation(nursery.lndCurrency)
+
+	if err != nil {
+		return "", claims, errors.New("could not get fee estimation: " + err.Error())
+	}
+
+	for _, claim := range claims {
+		reverseSwap, err := nursery.database.QueryReverseSwap(claim.reverseSwap.Id)
+
+		if err != nil {
+			logger.Error("Could not query Reverse Swap " + claim.reverseSwap.Id + ": " + err.Error())
+			retryClaims = append(retryClaims, claim)
+			continue
+		}
+
+		if reverseSwap.State != boltzrpc.SwapState_PENDING || reverseSwap.ClaimTransactionId != "" {
+			logger.Info("Not claiming Reverse Swap " + reverseSwap.Id + " because it is not pending anymore or was claimed already")
+			continue
+		}
+
+		if claim.lockupTransaction == nil {
+			claim.lockupTransaction, err = nursery.getLockupTransaction(reverseSwap.LockupTransactionId)
+
+			if err != nil {
+				logger.Error("Could not get lockup transaction of Reverse Swap " + reverseSwap.Id + ": " + err.Error())
+				retryClaims = append(retryClaims, claim)
+				continue
+			}
+		}
+
+		claimOutput, err := nursery.getClaimOutput(nursery.lndCurrency, reverseSwap, claim.lockupTransaction)
+
+		if err != nil {
+			nursery.setClaimError(reverseSwap, err)
+			continue
+		}
+
+		claimAddress, err := btcutil.DecodeAddress(reverseSwap.ClaimAddress, nursery.chainParams)
+
+		if err != nil {
+			nursery.setClaimError(reverseSwap, errors.New("could not decode claim address: "+err.Error()))
+			continue
+		}
+
+		// Claiming the output on its own costs at least as much as its share of the fee of the batch, so it is only
+		// added when that would not be dust. The fee could be lower in the next block
+		_, err = boltz.ConstructTransaction([]boltz.OutputDetails{*claimOutput}, claimAddress, feeSatPerVbyte)
+
+		if err != nil {
+			logger.Warning("Not claiming Reverse Swap " + reverseSwap.Id + " at " + strconv.FormatInt(feeSatPerVbyte, 10) +
+				" sat/vbyte: " + err.Error())
+			retryClaims = append(retryClaims, claim)
+			continue
+		}
+
+		claimedReverseSwaps = append(claimedReverseSwaps, reverseSwap)
+		claimedClaims = append(claimedClaims, claim)
+		claimOutputs = append(claimOutputs, *claimOutput)
+		claimAddresses = append(claimAddresses, claimAddress)
+	}
+
+	if len(claimOutputs) == 0 {
+		return "", retryClaims, errors.New("did not find any outputs to claim")
+	}
+
+	logger.Info("Using fee of " + strconv.FormatInt(feeSatPerVbyte, 10) + " sat/vbyte for claim transaction")
+
+	claimTransaction, err := boltz.ConstructBatchTransaction(claimOutputs, claimAddresses, feeSatPerVbyte)
+
+	if err != nil {
+		return "", append(retryClaims, claimedClaims...), errors.New("could not construct claim transaction: " + err.Error())
+	}
+
+	claimTransactionId := claimTransaction.TxHash().String()
+	logger.Info("Constructed claim transaction: " + claimTransactionId)
+
+	err = nursery.broadcastTransaction(nursery.lndCurrency, claimTransaction)
+
+	if err != nil {
+		return "", append(retryClaims, claimedClaims...), errors.New("could not finalize claim transaction: " + err.Error())
+	}
+
+	var claimedIds []string
+
+	for _, reverseSwap := range claimedReverseSwaps {
+		claimedIds = append(claimedIds, reverseSwap.Id)
+	}
+
+	claimFee := getTransactionFee(claimOutputs, claimTransaction)
+
+	metrics.AddMinerFee(database.ClaimTransaction.String(), claimFee)
+	nursery.addLedgerMinerFee(claimedIds, claimFee)
+
+	err = nursery.database.RunTx(func(transaction *database.Transaction) error {
+		for _, reverseSwap := range claimedReverseSwaps {
+			err := transaction.SetReverseSwapClaimTransactionId(reverseSwap, claimTransactionId)
+
+			if err != nil {
+				return errors.New("could not set claim transaction id of Reverse Swap " + reverseSwap.Id + ": " + err.Error())
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		logger.Error(err.Error())
+	}
+
+	nursery.addPendingTransaction(database.PendingTransaction{
+		Id:             claimTransactionId,
+		Type:           database.ClaimTransaction,
+		SwapIds:        claimedIds,
+		FeeSatPerVbyte: feeSatPerVbyte,
+	})
+
+	return claimTransactionId, retryClaims, nil
+}
+
+func (nursery *Nursery) setClaimError(reverseSwap *database.ReverseSwap, claimError error) {
+	logger.Error("Could not claim Reverse Swap " + reverseSwap.Id + ": " + claimError.Error())
+
+	err := nursery.database.UpdateReverseSwapState(reverseSwap, boltzrpc.SwapState_ERROR, claimError.Error())
+
+	if err != nil {
+		logger.Error("Could not update state of Reverse Swap " + reverseSwap.Id + ": " + err.Error())
+	}
+}
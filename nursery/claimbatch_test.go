@@ -0,0 +1,82 @@
+package nursery
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClaimQueuedReverseSwaps(t *testing.T) {
+	db, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	api := &testBoltzApi{
+		transactions: make(map[string]string),
+	}
+
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	nursery := newBoltzBackendNursery(t, server.URL, &testLightning{blockHeight: 100}, db)
+	claimAddress := newTestAddress(t, nursery.chainParams).EncodeAddress()
+
+	newReverseSwap := func(id string, redeemScript []byte, amount int64, claimAddress string) database.ReverseSwap {
+		privateKey, _ := btcec.NewPrivateKey(btcec.S256())
+		_, lockupTransactionId := api.addLockupTransaction(t, nursery.chainParams, redeemScript, amount)
+
+		reverseSwap := database.ReverseSwap{
+			Id:                  id,
+			State:               boltzrpc.SwapState_PENDING,
+			PrivateKey:          privateKey,
+			Preimage:            []byte{1},
+			RedeemScript:        redeemScript,
+			ClaimAddress:        claimAddress,
+			OnchainAmount:       uint64(amount),
+			TimeoutBlockHeight:  200,
+			LockupTransactionId: lockupTransactionId,
+			KeyIndex:            -1,
+		}
+		assert.Nil(t, db.CreateReverseSwap(reverseSwap))
+
+		return reverseSwap
+	}
+
+	claimed := newReverseSwap("claimed", []byte{0x51}, 100000, claimAddress)
+	invalidAddress := newReverseSwap("invalidAddress", []byte{0x52}, 100000, "notAnAddress")
+	dust := newReverseSwap("dust", []byte{0x53}, 1000, claimAddress)
+
+	// The lockup transaction cannot be fetched
+	unknownLockup := newReverseSwap("unknownLockup", []byte{0x54}, 100000, claimAddress)
+	unknownLockup.LockupTransactionId = "unknown"
+	assert.Nil(t, db.SetReverseSwapLockupTransactionId(&unknownLockup, unknownLockup.LockupTransactionId))
+
+	nursery.claimBatch.startHeight = 94
+
+	for _, reverseSwap := range []database.ReverseSwap{claimed, invalidAddress, dust, unknownLockup} {
+		nursery.claimBatch.claims = append(nursery.claimBatch.claims, queuedClaim{reverseSwap: reverseSwap})
+	}
+
+	// The valid claim is broadcast even though the others fail
+	nursery.claimQueuedReverseSwaps(100)
+	assert.Len(t, api.getBroadcast(), 1)
+
+	claimedReverseSwap, err := db.QueryReverseSwap(claimed.Id)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, claimedReverseSwap.ClaimTransactionId)
+
+	// Claims that can never succeed are not lost silently
+	erroredReverseSwap, err := db.QueryReverseSwap(invalidAddress.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, boltzrpc.SwapState_ERROR, erroredReverseSwap.State)
+	assert.Contains(t, erroredReverseSwap.Error, "could not decode claim address")
+
+	// And the ones that could succeed later are retried in the next block
+	assert.Len(t, nursery.claimBatch.claims, 2)
+	assert.Equal(t, dust.Id, nursery.claimBatch.claims[0].reverseSwap.Id)
+	assert.Equal(t, unknownLockup.Id, nursery.claimBatch.claims[1].reverseSwap.Id)
+	assert.True(t, nursery.isClaimBatchDue(101))
+}
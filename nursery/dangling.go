@@ -0,0 +1,225 @@
+package nursery
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/logger"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// How long LND has to return the closing transaction of a dangling channel
+var closeChannelTimeout = 60 * time.Second
+
+type DanglingChannelConfig struct {
+	GracePeriod uint32 `long:"danglingchannels.graceperiod" description:"Number of blocks after which channels of failed Channel Creations are closed. 0 disables closing them automatically"`
+}
+
+// Channels that Boltz opened for Channel Creations which failed afterwards are not used by any swap. They are reported
+// when they are found and closed after the grace period, which gives time to close them manually
+func (nursery *Nursery) checkDanglingChannels(blockHeight uint32) {
+	channels, err := nursery.lnd.ListChannels()
+
+	if err != nil {
+		logger.Error("Could not query channels to check for dangling ones: " + err.Error())
+		return
+	}
+
+	for _, channel := range channels.Channels {
+		if channel.RemotePubkey != nursery.boltzPubKey {
+			continue
+		}
+
+		danglingChannel, err := nursery.findDanglingChannel(channel.ChannelPoint, blockHeight)
+
+		if err != nil {
+			logger.Error("Could not check whether channel " + channel.ChannelPoint + " is dangling: " + err.Error())
+			continue
+		}
+
+		// Closes are not awaited, because a slow cooperative close would delay everything else done on new blocks
+		if danglingChannel != nil && nursery.shouldCloseDanglingChannel(danglingChannel, blockHeight) && nursery.startClosingChannel(danglingChannel) {
+			go func() {
+				nursery.closeDanglingChannel(danglingChannel)
+				nursery.stopClosingChannel(danglingChannel)
+			}()
+		}
+	}
+}
+
+// Returns nil if the channel does not belong to a failed Channel Creation of the node. Channels are saved as dangling
+// the first time they are found
+func (nursery *Nursery) findDanglingChannel(channelPoint string, blockHeight uint32) (*database.DanglingChannel, error) {
+	fundingTransactionId, fundingTransactionVout, err := parseChannelPoint(channelPoint)
+
+	if err != nil {
+		return nil, err
+	}
+
+	channelCreation, err := nursery.database.QueryChannelCreationByFunding(fundingTransactionId, fundingTransactionVout)
+
+	if err != nil || channelCreation.Status == boltz.ChannelSettled {
+		return nil, nil
+	}
+
+	swap, err := nursery.database.QuerySwap(channelCreation.SwapId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if swap.Node != nursery.node || swap.State == boltzrpc.SwapState_PENDING || swap.State == boltzrpc.SwapState_SUCCESSFUL {
+		return nil, nil
+	}
+
+	danglingChannel, err := nursery.database.QueryDanglingChannel(swap.Id)
+
+	if err == nil {
+		return danglingChannel, nil
+	}
+
+	danglingChannel = &database.DanglingChannel{
+		SwapId:          swap.Id,
+		ChannelPoint:    channelPoint,
+		Node:            nursery.node,
+		DetectionHeight: blockHeight,
+	}
+
+	err = nursery.database.CreateDanglingChannel(*danglingChannel)
+
+	if err != nil {
+		return nil, err
+	}
+
+	message := "Found channel " + channelPoint + " of failed Channel Creation " + swap.Id
+
+	if closeHeight := nursery.DanglingChannelCloseHeight(danglingChannel); closeHeight != 0 {
+		message += ". It will be closed at block height " + strconv.FormatUint(uint64(closeHeight), 10)
+	}
+
+	logger.Warning(message)
+
+	return danglingChannel, nil
+}
+
+// DanglingChannelCloseHeight returns the block height after which the channel is closed. 0 if dangling channels are
+// not closed automatically
+func (nursery *Nursery) DanglingChannelCloseHeight(danglingChannel *database.DanglingChannel) uint32 {
+	if nursery.danglingChannelConfig.GracePeriod == 0 {
+		return 0
+	}
+
+	return danglingChannel.DetectionHeight + nursery.danglingChannelConfig.GracePeriod
+}
+
+func (nursery *Nursery) shouldCloseDanglingChannel(danglingChannel *database.DanglingChannel, blockHeight uint32) bool {
+	closeHeight := nursery.DanglingChannelCloseHeight(danglingChannel)
+
+	return closeHeight != 0 && blockHeight >= closeHeight && danglingChannel.ClosingTransactionId == ""
+}
+
+// Returns false if the channel is being closed already
+func (nursery *Nursery) startClosingChannel(danglingChannel *database.DanglingChannel) bool {
+	nursery.closingChannelsLock.Lock()
+	defer nursery.closingChannelsLock.Unlock()
+
+	if nursery.closingChannels == nil {
+		nursery.closingChannels = make(map[string]bool)
+	}
+
+	if nursery.closingChannels[danglingChannel.SwapId] {
+		return false
+	}
+
+	nursery.closingChannels[danglingChannel.SwapId] = true
+	return true
+}
+
+func (nursery *Nursery) stopClosingChannel(danglingChannel *database.DanglingChannel) {
+	nursery.closingChannelsLock.Lock()
+	delete(nursery.closingChannels, danglingChannel.SwapId)
+	nursery.closingChannelsLock.Unlock()
+}
+
+// Channels are closed cooperatively when possible and force closed if Boltz does not cooperate, for example because
+// its node is offline or the negotiation takes too long. Closes that fail altogether are retried on the next block
+func (nursery *Nursery) closeDanglingChannel(danglingChannel *database.DanglingChannel) {
+	logger.Info("Closing dangling channel " + danglingChannel.ChannelPoint + " of Channel Creation " + danglingChannel.SwapId)
+
+	forceClosed := false
+	closingTransactionId, err := readClosingTransaction(nursery.lnd.CloseChannel(danglingChannel.ChannelPoint))
+
+	if err != nil {
+		logger.Warning("Could not close dangling channel " + danglingChannel.ChannelPoint + " cooperatively: " + err.Error())
+		logger.Info("Force closing dangling channel " + danglingChannel.ChannelPoint)
+
+		forceClosed = true
+		closingTransactionId, err = readClosingTransaction(nursery.lnd.ForceCloseChannel(danglingChannel.ChannelPoint))
+
+		if err != nil {
+			logger.Error("Could not force close dangling channel " + danglingChannel.ChannelPoint + ": " + err.Error())
+			return
+		}
+	}
+
+	logger.Info("Closed dangling channel " + danglingChannel.ChannelPoint + " in transaction " + closingTransactionId)
+
+	err = nursery.database.SetDanglingChannelClosed(danglingChannel, closingTransactionId, forceClosed)
+
+	if err != nil {
+		logger.Error("Could not update dangling channel of Channel Creation " + danglingChannel.SwapId + ": " + err.Error())
+	}
+}
+
+type closeUpdate struct {
+	update *lnrpc.CloseStatusUpdate
+	err    error
+}
+
+// The first update of the stream of a channel close contains the closing transaction
+func readClosingTransaction(stream lnrpc.Lightning_CloseChannelClient, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+
+	// Buffered so that the goroutine does not block forever when the update arrives after the timeout
+	updates := make(chan closeUpdate, 1)
+
+	go func() {
+		update, err := stream.Recv()
+		updates <- closeUpdate{update: update, err: err}
+	}()
+
+	var update *lnrpc.CloseStatusUpdate
+
+	select {
+	case received := <-updates:
+		if received.err != nil {
+			return "", received.err
+		}
+
+		update = received.update
+
+	case <-time.After(closeChannelTimeout):
+		return "", errors.New("LND did not return the closing transaction in time")
+	}
+
+	closePending := update.GetClosePending()
+
+	if closePending == nil {
+		return "", errors.New("LND did not return the closing transaction")
+	}
+
+	closingTransactionId, err := chainhash.NewHash(closePending.Txid)
+
+	if err != nil {
+		return "", err
+	}
+
+	return closingTransactionId.String(), nil
+}
@@ -0,0 +1,277 @@
+package nursery
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindDanglingChannel(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "boltz-lnd")
+	assert.Nil(t, err)
+
+	defer os.RemoveAll(dataDir)
+
+	db := &database.Database{
+		Path: path.Join(dataDir, "boltz.db"),
+	}
+	assert.Nil(t, db.Connect())
+
+	nursery := &Nursery{
+		database: db,
+		danglingChannelConfig: &DanglingChannelConfig{
+			GracePeriod: 144,
+		},
+	}
+
+	createChannelCreation := func(id string, state boltzrpc.SwapState, status boltz.ChannelState, node string) {
+		privateKey, err := btcec.NewPrivateKey(btcec.S256())
+		assert.Nil(t, err)
+
+		assert.Nil(t, db.CreateSwap(database.Swap{
+			Id:         id,
+			State:      state,
+			PrivateKey: privateKey,
+			Node:       node,
+		}))
+
+		assert.Nil(t, db.CreateChannelCreation(database.ChannelCreation{
+			SwapId:                 id,
+			Status:                 status,
+			FundingTransactionId:   id,
+			FundingTransactionVout: 1,
+		}))
+	}
+
+	createChannelCreation("failed", boltzrpc.SwapState_SERVER_ERROR, boltz.ChannelAccepted, "")
+	createChannelCreation("pending", boltzrpc.SwapState_PENDING, boltz.ChannelAccepted, "")
+	createChannelCreation("settled", boltzrpc.SwapState_ERROR, boltz.ChannelSettled, "")
+	createChannelCreation("otherNode", boltzrpc.SwapState_ERROR, boltz.ChannelAccepted, "second")
+
+	danglingChannel, err := nursery.findDanglingChannel("failed:1", 100)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &database.DanglingChannel{
+		SwapId:          "failed",
+		ChannelPoint:    "failed:1",
+		DetectionHeight: 100,
+	}, danglingChannel)
+
+	// The detection height is only set when the channel is found for the first time
+	danglingChannel, err = nursery.findDanglingChannel("failed:1", 110)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(100), danglingChannel.DetectionHeight)
+
+	for _, channelPoint := range []string{"pending:1", "settled:1", "otherNode:1", "failed:0", "unknown:1"} {
+		danglingChannel, err = nursery.findDanglingChannel(channelPoint, 100)
+
+		assert.Nil(t, err)
+		assert.Nil(t, danglingChannel)
+	}
+
+	danglingChannels, err := db.QueryDanglingChannels()
+
+	assert.Nil(t, err)
+	assert.Len(t, danglingChannels, 1)
+}
+
+func TestShouldCloseDanglingChannel(t *testing.T) {
+	nursery := &Nursery{
+		danglingChannelConfig: &DanglingChannelConfig{
+			GracePeriod: 144,
+		},
+	}
+
+	danglingChannel := &database.DanglingChannel{
+		DetectionHeight: 100,
+	}
+
+	assert.Equal(t, uint32(244), nursery.DanglingChannelCloseHeight(danglingChannel))
+
+	assert.False(t, nursery.shouldCloseDanglingChannel(danglingChannel, 243))
+	assert.True(t, nursery.shouldCloseDanglingChannel(danglingChannel, 244))
+
+	// Channels are closed only once
+	danglingChannel.ClosingTransactionId = "closing"
+	assert.False(t, nursery.shouldCloseDanglingChannel(danglingChannel, 300))
+
+	danglingChannel.ClosingTransactionId = ""
+	nursery.danglingChannelConfig.GracePeriod = 0
+
+	assert.Equal(t, uint32(0), nursery.DanglingChannelCloseHeight(danglingChannel))
+	assert.False(t, nursery.shouldCloseDanglingChannel(danglingChannel, 300))
+}
+
+func TestCloseDanglingChannel(t *testing.T) {
+	db, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	closeChannelTimeout = 10 * time.Millisecond
+
+	newClosePending := func(transactionId byte) *lnrpc.CloseStatusUpdate {
+		return &lnrpc.CloseStatusUpdate{
+			Update: &lnrpc.CloseStatusUpdate_ClosePending{
+				ClosePending: &lnrpc.PendingUpdate{
+					Txid: bytes.Repeat([]byte{transactionId}, chainhash.HashSize),
+				},
+			},
+		}
+	}
+
+	cooperativeTransactionId := strings.Repeat("01", chainhash.HashSize)
+	forceTransactionId := strings.Repeat("02", chainhash.HashSize)
+
+	lightning := &testLightning{
+		forceCloseStream: &testCloseStream{update: newClosePending(2)},
+	}
+
+	nursery := &Nursery{
+		lnd:      lightning,
+		database: db,
+	}
+
+	closeChannel := func(id string) *database.DanglingChannel {
+		danglingChannel := &database.DanglingChannel{
+			SwapId:       id,
+			ChannelPoint: id + ":0",
+		}
+		assert.Nil(t, db.CreateDanglingChannel(*danglingChannel))
+
+		nursery.closeDanglingChannel(danglingChannel)
+
+		queried, err := db.QueryDanglingChannel(id)
+		assert.Nil(t, err)
+
+		return queried
+	}
+
+	lightning.closeStream = &testCloseStream{update: newClosePending(1)}
+	closed := closeChannel("cooperative")
+
+	assert.Equal(t, cooperativeTransactionId, closed.ClosingTransactionId)
+	assert.False(t, closed.ForceClosed)
+
+	// Channels are force closed when Boltz does not cooperate
+	lightning.closeStream = &testCloseStream{err: errors.New("peer offline")}
+	closed = closeChannel("offline")
+
+	assert.Equal(t, forceTransactionId, closed.ClosingTransactionId)
+	assert.True(t, closed.ForceClosed)
+
+	// Or does not cooperate in time
+	block := make(chan struct{})
+	defer close(block)
+
+	lightning.closeStream = &testCloseStream{block: block, update: newClosePending(1)}
+	closed = closeChannel("slow")
+
+	assert.Equal(t, forceTransactionId, closed.ClosingTransactionId)
+	assert.True(t, closed.ForceClosed)
+
+	// Channels that could not be closed at all stay open to be closed again on the next block
+	lightning.closeStream = &testCloseStream{err: errors.New("peer offline")}
+	lightning.forceCloseStream = &testCloseStream{update: &lnrpc.CloseStatusUpdate{}}
+	closed = closeChannel("failed")
+
+	assert.Equal(t, "", closed.ClosingTransactionId)
+	assert.False(t, closed.ForceClosed)
+}
+
+func TestStartClosingChannel(t *testing.T) {
+	nursery := &Nursery{}
+	danglingChannel := &database.DanglingChannel{SwapId: "channel"}
+
+	assert.True(t, nursery.startClosingChannel(danglingChannel))
+	assert.False(t, nursery.startClosingChannel(danglingChannel))
+
+	nursery.stopClosingChannel(danglingChannel)
+	assert.True(t, nursery.startClosingChannel(danglingChannel))
+}
+
+// Boltz opening a channel with less capacity than expected fails the Channel Creation, but its funding is saved so that
+// the channel is found as dangling
+func TestChannelCapacityMismatch(t *testing.T) {
+	db, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	boltzKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	boltzPubKey := hex.EncodeToString(boltzKey.PubKey().SerializeCompressed())
+
+	lightning := &testLightning{
+		pendingChannels: &lnrpc.PendingChannelsResponse{
+			PendingOpenChannels: []*lnrpc.PendingChannelsResponse_PendingOpenChannel{{
+				Channel: &lnrpc.PendingChannelsResponse_PendingChannel{
+					RemoteNodePub: boltzPubKey,
+					ChannelPoint:  "funding:1",
+					Capacity:      999999,
+				},
+			}},
+		},
+	}
+
+	nursery := &Nursery{
+		boltzPubKey: boltzPubKey,
+		chainParams: &chaincfg.RegressionNetParams,
+		lnd:         lightning,
+		database:    db,
+		danglingChannelConfig: &DanglingChannelConfig{
+			GracePeriod: 144,
+		},
+	}
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	swap := &database.Swap{
+		Id:         "channel",
+		State:      boltzrpc.SwapState_PENDING,
+		Status:     boltz.TransactionConfirmed,
+		PrivateKey: privateKey,
+		Preimage:   []byte("preimage"),
+		Invoice:    newTestInvoice(t, 750000000),
+	}
+	assert.Nil(t, db.CreateSwap(*swap))
+
+	channelCreation := &database.ChannelCreation{
+		SwapId:           swap.Id,
+		Status:           boltz.ChannelNone,
+		InboundLiquidity: 25,
+	}
+	assert.Nil(t, db.CreateChannelCreation(*channelCreation))
+
+	status := boltz.SwapStatusResponse{Status: boltz.ChannelCreated.String()}
+	status.Channel.FundingTransactionId = "funding"
+	status.Channel.FundingTransactionVout = 1
+
+	nursery.handleSwapStatus(swap, channelCreation, status)
+
+	queriedSwap, err := db.QuerySwap(swap.Id)
+
+	assert.Nil(t, err)
+	assert.Equal(t, boltzrpc.SwapState_ERROR, queriedSwap.State)
+	assert.Equal(t, "channel capacity 999999 is less than expected 1000000 satoshis", queriedSwap.Error)
+	assert.Len(t, lightning.cancelledPreimageHashes, 1)
+
+	danglingChannel, err := nursery.findDanglingChannel("funding:1", 100)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, danglingChannel)
+}
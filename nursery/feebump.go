@@ -0,0 +1,489 @@
+package nursery
+
+import (
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/logger"
+	"github.com/BoltzExchange/boltz-lnd/metrics"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+)
+
+// Listens to the confirmation of a claim or refund transaction until it confirmed or the transaction that replaced it did
+type confirmationListener struct {
+	swapIds []string
+	stop    chan bool
+}
+
+type FeeBumpConfig struct {
+	Enabled bool   `long:"feebump.enabled" description:"Whether unconfirmed claim and refund transactions should be replaced with ones that pay a higher fee"`
+	Blocks  uint32 `long:"feebump.blocks" description:"Number of blocks a claim or refund transaction can be unconfirmed before its fee is bumped"`
+	MaxFee  int64  `long:"feebump.maxfee" description:"Maximal fee in satoshis per vbyte to which transactions are bumped automatically"`
+}
+
+// BumpFee replaces a pending claim or refund transaction with one that spends the same lockup outputs to the same
+// address but pays a higher fee. The original transaction signals RBF because the sequence of its inputs is 0. If the
+// fee is 0, it will be estimated
+func (nursery *Nursery) BumpFee(transactionId string, feeSatPerVbyte int64) (string, error) {
+	nursery.feeBumpLock.Lock()
+	defer nursery.feeBumpLock.Unlock()
+
+	pendingTransaction, err := nursery.database.QueryPendingTransaction(transactionId)
+
+	if err != nil {
+		return "", errors.New("transaction " + transactionId + " is not a pending claim or refund transaction")
+	}
+
+	if feeSatPerVbyte == 0 {
+		feeSatPerVbyte, err = nursery.getBumpedFee(pendingTransaction.FeeSatPerVbyte)
+
+		if err != nil {
+			return "", errors.New("could not get fee estimation: " + err.Error())
+		}
+	}
+
+	if feeSatPerVbyte <= pendingTransaction.FeeSatPerVbyte {
+		return "", errors.New("fee has to be higher than the " + strconv.FormatInt(pendingTransaction.FeeSatPerVbyte, 10) +
+			" sat/vbyte of the pending transaction")
+	}
+
+	outputs, addresses, err := nursery.getPendingTransactionOutputs(pendingTransaction)
+
+	if err != nil {
+		return "", err
+	}
+
+	logger.Info("Bumping fee of " + pendingTransaction.Type.String() + " transaction " + transactionId + " to " +
+		strconv.FormatInt(feeSatPerVbyte, 10) + " sat/vbyte")
+
+	transaction, err := boltz.ConstructBatchTransaction(outputs, addresses, feeSatPerVbyte)
+
+	if err != nil {
+		return "", errors.New("could not construct " + pendingTransaction.Type.String() + " transaction: " + err.Error())
+	}
+
+	bumpedTransactionId := transaction.TxHash().String()
+	logger.Info("Constructed " + pendingTransaction.Type.String() + " transaction: " + bumpedTransactionId)
+
+	err = nursery.broadcastTransaction(nursery.lndCurrency, transaction)
+
+	if err != nil {
+		return "", err
+	}
+
+	// The replaced transaction has the same size, so only the share of the fee that exceeds its fee rate is paid
+	// in addition
+	fee := getTransactionFee(outputs, transaction)
+	additionalFee := fee - fee*pendingTransaction.FeeSatPerVbyte/feeSatPerVbyte
+
+	metrics.AddMinerFee(pendingTransaction.Type.String(), additionalFee)
+	nursery.addLedgerMinerFee(pendingTransaction.SwapIds, additionalFee)
+
+	bumpedTransaction := *pendingTransaction
+	bumpedTransaction.Id = bumpedTransactionId
+	bumpedTransaction.FeeSatPerVbyte = feeSatPerVbyte
+	nursery.setBroadcastHeight(&bumpedTransaction)
+
+	// The swaps and the pending transactions are updated together to not lose track of any of the two transactions
+	err = nursery.database.RunTx(func(transaction *database.Transaction) error {
+		err := setBumpedTransactionId(transaction, pendingTransaction, bumpedTransactionId)
+
+		if err != nil {
+			return err
+		}
+
+		err = transaction.DeletePendingTransaction(transactionId)
+
+		if err != nil {
+			return err
+		}
+
+		return transaction.CreatePendingTransaction(bumpedTransaction)
+	})
+
+	if err != nil {
+		logger.Error("Could not replace pending transaction " + transactionId + " in database: " + err.Error())
+	}
+
+	return bumpedTransactionId, nil
+}
+
+// Forgets about pending transactions that confirmed and bumps the fee of the ones that have been unconfirmed for
+// too many blocks
+func (nursery *Nursery) bumpPendingTransactions(blockHeight uint32) {
+	if !nursery.feeBump.Enabled {
+		return
+	}
+
+	pendingTransactions, err := nursery.database.QueryPendingTransactions()
+
+	if err != nil {
+		logger.Error("Could not query pending transactions: " + err.Error())
+		return
+	}
+
+	for _, pendingTransaction := range pendingTransactions {
+		if pendingTransaction.Node != nursery.node {
+			continue
+		}
+
+		transactionType := pendingTransaction.Type.String()
+
+		// LND removes the transactions once they confirmed when the chain backend cannot tell
+		if nursery.chainBackend.CanQueryChain() {
+			isConfirmed, err := nursery.chainBackend.IsConfirmed(pendingTransaction.Id)
+
+			if err != nil {
+				logger.Warning("Could not check whether " + transactionType + " transaction " + pendingTransaction.Id + " confirmed: " + err.Error())
+				continue
+			}
+
+			if isConfirmed {
+				logger.Info("The " + transactionType + " transaction " + pendingTransaction.Id + " confirmed")
+				err = nursery.database.DeletePendingTransaction(pendingTransaction.Id)
+
+				if err != nil {
+					logger.Error("Could not delete pending transaction " + pendingTransaction.Id + ": " + err.Error())
+				}
+
+				continue
+			}
+		} else {
+			nursery.listenForConfirmation(&pendingTransaction, blockHeight)
+		}
+
+		if blockHeight < pendingTransaction.BroadcastHeight+nursery.feeBump.Blocks {
+			continue
+		}
+
+		feeSatPerVbyte, err := nursery.getBumpedFee(pendingTransaction.FeeSatPerVbyte)
+
+		if err != nil {
+			logger.Error("Could not get fee estimation: " + err.Error())
+			continue
+		}
+
+		if feeSatPerVbyte > nursery.feeBump.MaxFee {
+			feeSatPerVbyte = nursery.feeBump.MaxFee
+		}
+
+		if feeSatPerVbyte <= pendingTransaction.FeeSatPerVbyte {
+			logger.Warning("Not bumping fee of " + transactionType + " transaction " + pendingTransaction.Id +
+				" because the maximal fee of " + strconv.FormatInt(nursery.feeBump.MaxFee, 10) + " sat/vbyte was reached")
+			continue
+		}
+
+		_, err = nursery.BumpFee(pendingTransaction.Id, feeSatPerVbyte)
+
+		if err != nil {
+			logger.Error("Could not bump fee of " + transactionType + " transaction " + pendingTransaction.Id + ": " + err.Error())
+		}
+	}
+}
+
+// Starts listening to the confirmation of a pending transaction with LND. The listeners of the transactions it replaced
+// keep running because any of them could confirm
+func (nursery *Nursery) listenForConfirmation(pendingTransaction *database.PendingTransaction, blockHeight uint32) {
+	nursery.confirmationListenersLock.Lock()
+	defer nursery.confirmationListenersLock.Unlock()
+
+	if nursery.confirmationListeners == nil {
+		nursery.confirmationListeners = make(map[string]*confirmationListener)
+	}
+
+	if _, isListening := nursery.confirmationListeners[pendingTransaction.Id]; isListening {
+		return
+	}
+
+	outputScript, err := nursery.getPendingTransactionOutputScript(pendingTransaction)
+
+	if err != nil {
+		logger.Error("Could not get output script of " + pendingTransaction.Type.String() + " transaction " + pendingTransaction.Id + ": " + err.Error())
+		return
+	}
+
+	heightHint := pendingTransaction.BroadcastHeight
+
+	if heightHint == 0 {
+		heightHint = blockHeight
+	}
+
+	listener := &confirmationListener{
+		swapIds: pendingTransaction.SwapIds,
+		stop:    make(chan bool),
+	}
+	nursery.confirmationListeners[pendingTransaction.Id] = listener
+
+	confirmedTransaction := *pendingTransaction
+
+	go func() {
+		for {
+			isConfirmed, err := nursery.lnd.RegisterConfirmationListener(confirmedTransaction.Id, outputScript, heightHint, listener.stop)
+
+			if err == nil {
+				if isConfirmed {
+					nursery.handleConfirmedTransaction(&confirmedTransaction)
+				}
+
+				return
+			}
+
+			logger.Warning("Lost connection to LND confirmation stream of transaction " + confirmedTransaction.Id + ": " + err.Error())
+
+			select {
+			case <-listener.stop:
+				return
+			case <-time.After(retryInterval * time.Second):
+			}
+		}
+	}()
+}
+
+// Forgets the pending transactions of the swaps of the confirmed transaction and stops listening to the confirmations
+// of the ones it replaced or that replaced it
+func (nursery *Nursery) handleConfirmedTransaction(confirmedTransaction *database.PendingTransaction) {
+	nursery.feeBumpLock.Lock()
+	defer nursery.feeBumpLock.Unlock()
+
+	logger.Info("The " + confirmedTransaction.Type.String() + " transaction " + confirmedTransaction.Id + " confirmed")
+
+	pendingTransactions, err := nursery.database.QueryPendingTransactions()
+
+	if err != nil {
+		logger.Error("Could not query pending transactions: " + err.Error())
+		return
+	}
+
+	for _, pendingTransaction := range pendingTransactions {
+		if pendingTransaction.Node != nursery.node || !sharesSwap(pendingTransaction.SwapIds, confirmedTransaction.SwapIds) {
+			continue
+		}
+
+		err = nursery.database.RunTx(func(transaction *database.Transaction) error {
+			// The swaps have to point to the transaction that confirmed, which might have been replaced
+			if pendingTransaction.Id != confirmedTransaction.Id {
+				err := setBumpedTransactionId(transaction, &pendingTransaction, confirmedTransaction.Id)
+
+				if err != nil {
+					return err
+				}
+			}
+
+			return transaction.DeletePendingTransaction(pendingTransaction.Id)
+		})
+
+		if err != nil {
+			logger.Error("Could not delete pending transaction " + pendingTransaction.Id + ": " + err.Error())
+		}
+	}
+
+	nursery.confirmationListenersLock.Lock()
+	defer nursery.confirmationListenersLock.Unlock()
+
+	for transactionId, listener := range nursery.confirmationListeners {
+		if sharesSwap(listener.swapIds, confirmedTransaction.SwapIds) {
+			close(listener.stop)
+			delete(nursery.confirmationListeners, transactionId)
+		}
+	}
+}
+
+// Claim transactions pay to the claim address of their first Reverse Swap and refund transactions to the address of
+// the pending transaction
+func (nursery *Nursery) getPendingTransactionOutputScript(pendingTransaction *database.PendingTransaction) ([]byte, error) {
+	address := pendingTransaction.Address
+
+	if pendingTransaction.Type == database.ClaimTransaction {
+		reverseSwap, err := nursery.database.QueryReverseSwap(pendingTransaction.SwapIds[0])
+
+		if err != nil {
+			return nil, err
+		}
+
+		address = reverseSwap.ClaimAddress
+	}
+
+	decodedAddress, err := btcutil.DecodeAddress(address, nursery.chainParams)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return txscript.PayToAddrScript(decodedAddress)
+}
+
+func sharesSwap(swapIds []string, otherSwapIds []string) bool {
+	for _, swapId := range swapIds {
+		for _, otherSwapId := range otherSwapIds {
+			if swapId == otherSwapId {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// The bumped fee is the current estimation, but at least a quarter and one sat/vbyte more than the previous fee so
+// that the replacement is accepted by the mempool policy of the nodes
+func (nursery *Nursery) getBumpedFee(previousFee int64) (int64, error) {
+	feeEstimation, err := nursery.getFeeEstimation(nursery.lndCurrency)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return calculateBumpedFee(previousFee, feeEstimation), nil
+}
+
+func calculateBumpedFee(previousFee int64, feeEstimation int64) int64 {
+	return maxInt64(feeEstimation, previousFee+maxInt64(previousFee/4, 1))
+}
+
+// Claimed outputs are sent to the claim address of their Reverse Swap and refunded ones to the address of the
+// pending transaction
+func (nursery *Nursery) getPendingTransactionOutputs(
+	pendingTransaction *database.PendingTransaction,
+) ([]boltz.OutputDetails, []btcutil.Address, error) {
+	var outputs []boltz.OutputDetails
+	var addresses []btcutil.Address
+
+	for _, swapId := range pendingTransaction.SwapIds {
+		var address string
+
+		switch pendingTransaction.Type {
+		case database.ClaimTransaction:
+			reverseSwap, err := nursery.database.QueryReverseSwap(swapId)
+
+			if err != nil {
+				return nil, nil, errors.New("could not query Reverse Swap " + swapId + ": " + err.Error())
+			}
+
+			lockupTransaction, err := nursery.getLockupTransaction(reverseSwap.LockupTransactionId)
+
+			if err != nil {
+				return nil, nil, err
+			}
+
+			claimOutput, err := nursery.getClaimOutput(nursery.lndCurrency, reverseSwap, lockupTransaction)
+
+			if err != nil {
+				return nil, nil, err
+			}
+
+			address = reverseSwap.ClaimAddress
+			outputs = append(outputs, *claimOutput)
+
+		case database.RefundTransaction:
+			swap, err := nursery.database.QuerySwap(swapId)
+
+			if err != nil {
+				return nil, nil, errors.New("could not query Swap " + swapId + ": " + err.Error())
+			}
+
+			lockupTransaction, err := nursery.getLockupTransaction(swap.LockupTransactionId)
+
+			if err != nil {
+				return nil, nil, err
+			}
+
+			refundOutput, err := getRefundOutputDetails(nursery.lndCurrency, swap, lockupTransaction)
+
+			if err != nil {
+				return nil, nil, err
+			}
+
+			address = pendingTransaction.Address
+			outputs = append(outputs, *refundOutput)
+		}
+
+		decodedAddress, err := btcutil.DecodeAddress(address, nursery.chainParams)
+
+		if err != nil {
+			return nil, nil, errors.New("could not decode address " + address + ": " + err.Error())
+		}
+
+		addresses = append(addresses, decodedAddress)
+	}
+
+	return outputs, addresses, nil
+}
+
+// The lockup transaction is fetched from the chain backend and from the Boltz API in case that fails
+func (nursery *Nursery) getLockupTransaction(transactionId string) (*btcutil.Tx, error) {
+	lockupTransactionHex, err := nursery.chainBackend.GetTransaction(transactionId)
+
+	if err != nil {
+		logger.Warning("Could not get lockup transaction " + transactionId + " from " + nursery.chainBackend.Name() + ": " + err.Error())
+		response, err := nursery.boltz.GetTransaction(transactionId)
+
+		if err != nil {
+			return nil, errors.New("could not get lockup transaction " + transactionId + ": " + err.Error())
+		}
+
+		lockupTransactionHex = response.TransactionHex
+	}
+
+	lockupTransactionRaw, err := hex.DecodeString(lockupTransactionHex)
+
+	if err != nil {
+		return nil, errors.New("could not decode lockup transaction: " + err.Error())
+	}
+
+	return btcutil.NewTxFromBytes(lockupTransactionRaw)
+}
+
+func setBumpedTransactionId(transaction *database.Transaction, pendingTransaction *database.PendingTransaction, transactionId string) error {
+	for _, swapId := range pendingTransaction.SwapIds {
+		var err error
+
+		switch pendingTransaction.Type {
+		case database.ClaimTransaction:
+			var reverseSwap *database.ReverseSwap
+			reverseSwap, err = transaction.QueryReverseSwap(swapId)
+
+			if err == nil {
+				err = transaction.SetReverseSwapClaimTransactionId(reverseSwap, transactionId)
+			}
+
+		case database.RefundTransaction:
+			var swap *database.Swap
+			swap, err = transaction.QuerySwap(swapId)
+
+			if err == nil {
+				err = transaction.SetSwapRefundTransactionId(swap, transactionId)
+			}
+		}
+
+		if err != nil {
+			return errors.New("could not set " + pendingTransaction.Type.String() + " transaction id of " + swapId + ": " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (nursery *Nursery) addPendingTransaction(pendingTransaction database.PendingTransaction) {
+	nursery.setBroadcastHeight(&pendingTransaction)
+	err := nursery.database.CreatePendingTransaction(pendingTransaction)
+
+	if err != nil {
+		logger.Error("Could not save pending " + pendingTransaction.Type.String() + " transaction " + pendingTransaction.Id + ": " + err.Error())
+	}
+}
+
+func (nursery *Nursery) setBroadcastHeight(pendingTransaction *database.PendingTransaction) {
+	blockHeight, err := nursery.getBlockHeight()
+
+	if err != nil {
+		logger.Warning("Could not get block height from LND: " + err.Error())
+	}
+
+	pendingTransaction.BroadcastHeight = blockHeight
+	pendingTransaction.Node = nursery.node
+}
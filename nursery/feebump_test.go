@@ -0,0 +1,225 @@
+package nursery
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/chain"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// Serves the endpoints of the Boltz API that the Boltz chain backend uses
+type testBoltzApi struct {
+	transactions map[string]string
+
+	broadcast     []string
+	broadcastLock sync.Mutex
+}
+
+func (api *testBoltzApi) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	switch request.URL.Path {
+	case "/getfeeestimation":
+		_ = json.NewEncoder(writer).Encode(map[string]float64{"BTC": 20})
+
+	case "/gettransaction":
+		var transactionRequest boltz.GetTransactionRequest
+		_ = json.NewDecoder(request.Body).Decode(&transactionRequest)
+
+		_ = json.NewEncoder(writer).Encode(boltz.GetTransactionResponse{
+			TransactionHex: api.transactions[transactionRequest.TransactionId],
+		})
+
+	case "/broadcasttransaction":
+		var broadcastRequest boltz.BroadcastTransactionRequest
+		_ = json.NewDecoder(request.Body).Decode(&broadcastRequest)
+
+		api.broadcastLock.Lock()
+		api.broadcast = append(api.broadcast, broadcastRequest.TransactionHex)
+		api.broadcastLock.Unlock()
+
+		_ = json.NewEncoder(writer).Encode(boltz.BroadcastTransactionResponse{})
+
+	default:
+		writer.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// Adds a transaction that locks the amount up in a P2WSH output of the redeem script
+func (api *testBoltzApi) addLockupTransaction(t *testing.T, chainParams *chaincfg.Params, redeemScript []byte, amount int64) (string, string) {
+	lockupAddress, err := boltz.WitnessScriptHashAddress(chainParams, redeemScript)
+	assert.Nil(t, err)
+
+	decodedLockupAddress, err := btcutil.DecodeAddress(lockupAddress, chainParams)
+	assert.Nil(t, err)
+
+	lockupScript, err := txscript.PayToAddrScript(decodedLockupAddress)
+	assert.Nil(t, err)
+
+	lockupTransaction := wire.NewMsgTx(2)
+	lockupTransaction.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+	lockupTransaction.AddTxOut(wire.NewTxOut(amount, lockupScript))
+
+	lockupTransactionHex, err := boltz.SerializeTransaction(lockupTransaction)
+	assert.Nil(t, err)
+
+	lockupTransactionId := lockupTransaction.TxHash().String()
+	api.transactions[lockupTransactionId] = lockupTransactionHex
+
+	return lockupAddress, lockupTransactionId
+}
+
+func (api *testBoltzApi) getBroadcast() []string {
+	api.broadcastLock.Lock()
+	defer api.broadcastLock.Unlock()
+
+	return api.broadcast
+}
+
+// The nursery uses the Boltz API as chain backend of the LND chain
+func newBoltzBackendNursery(t *testing.T, url string, lightning *testLightning, db *database.Database) *Nursery {
+	boltzApi := &boltz.Boltz{URL: url}
+	boltzApi.Init("BTC")
+
+	chainBackend, err := (&chain.Config{Backend: chain.BoltzBackend}).Init(boltzApi)
+	assert.Nil(t, err)
+
+	chainParams := &chaincfg.RegressionNetParams
+
+	return &Nursery{
+		symbol:       "BTC",
+		chainParams:  chainParams,
+		lnd:          lightning,
+		boltz:        boltzApi,
+		chainBackend: chainBackend,
+		lndCurrency: &chain.Currency{
+			Symbol:  "BTC",
+			Params:  chainParams,
+			Backend: chainBackend,
+		},
+		feeBump: &FeeBumpConfig{
+			Enabled: true,
+			Blocks:  3,
+			MaxFee:  100,
+		},
+		claimBatchConfig: &ClaimBatchConfig{
+			Blocks:        6,
+			TimeoutMargin: 10,
+		},
+		database: db,
+	}
+}
+
+func newTestAddress(t *testing.T, chainParams *chaincfg.Params) btcutil.Address {
+	key, _ := btcec.NewPrivateKey(btcec.S256())
+
+	address, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), chainParams)
+	assert.Nil(t, err)
+
+	return address
+}
+
+func TestBumpPendingTransactionsBoltzBackend(t *testing.T) {
+	db, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	api := &testBoltzApi{
+		transactions: make(map[string]string),
+	}
+
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	lightning := &testLightning{blockHeight: 103}
+	nursery := newBoltzBackendNursery(t, server.URL, lightning, db)
+
+	chainParams := nursery.chainParams
+	redeemScript := []byte{txscript.OP_TRUE}
+
+	lockupAddress, lockupTransactionId := api.addLockupTransaction(t, chainParams, redeemScript, 100000)
+
+	privateKey, _ := btcec.NewPrivateKey(btcec.S256())
+	refundAddress := newTestAddress(t, chainParams)
+
+	originalTransactionId := "a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90"
+
+	swap := database.Swap{
+		Id:                  "bump",
+		State:               boltzrpc.SwapState_REFUNDED,
+		PrivateKey:          privateKey,
+		RedeemScript:        redeemScript,
+		Address:             lockupAddress,
+		TimoutBlockHeight:   90,
+		LockupTransactionId: lockupTransactionId,
+		RefundTransactionId: originalTransactionId,
+		OutputType:          boltz.SegWit,
+		KeyIndex:            -1,
+	}
+	assert.Nil(t, db.CreateSwap(swap))
+
+	assert.Nil(t, db.CreatePendingTransaction(database.PendingTransaction{
+		Id:              originalTransactionId,
+		Type:            database.RefundTransaction,
+		SwapIds:         []string{swap.Id},
+		Address:         refundAddress.EncodeAddress(),
+		FeeSatPerVbyte:  10,
+		BroadcastHeight: 100,
+	}))
+
+	// Without asking the Boltz API whether the transaction confirmed, it is not bumped before enough blocks passed
+	nursery.bumpPendingTransactions(102)
+	assert.Empty(t, api.getBroadcast())
+
+	nursery.bumpPendingTransactions(103)
+	assert.Len(t, api.broadcast, 1)
+
+	pendingTransactions, err := db.QueryPendingTransactions()
+	assert.Nil(t, err)
+	assert.Len(t, pendingTransactions, 1)
+
+	bumpedTransaction := pendingTransactions[0]
+	assert.NotEqual(t, originalTransactionId, bumpedTransaction.Id)
+	assert.Equal(t, int64(20), bumpedTransaction.FeeSatPerVbyte)
+	assert.Equal(t, uint32(103), bumpedTransaction.BroadcastHeight)
+
+	refundedSwap, err := db.QuerySwap(swap.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, bumpedTransaction.Id, refundedSwap.RefundTransactionId)
+
+	// The listener of the bumped transaction is started and the one of the replaced transaction keeps running
+	nursery.bumpPendingTransactions(104)
+
+	nursery.confirmationListenersLock.Lock()
+	assert.Len(t, nursery.confirmationListeners, 2)
+	nursery.confirmationListenersLock.Unlock()
+
+	// When the replaced transaction confirms, the bumped one is forgotten and the swap points to the confirmed one
+	lightning.confirm(originalTransactionId)
+
+	assert.Eventually(t, func() bool {
+		pendingTransactions, err := db.QueryPendingTransactions()
+		return err == nil && len(pendingTransactions) == 0
+	}, time.Second, 10*time.Millisecond)
+
+	refundedSwap, err = db.QuerySwap(swap.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, originalTransactionId, refundedSwap.RefundTransactionId)
+
+	assert.Eventually(t, func() bool {
+		nursery.confirmationListenersLock.Lock()
+		defer nursery.confirmationListenersLock.Unlock()
+
+		return len(nursery.confirmationListeners) == 0
+	}, time.Second, 10*time.Millisecond)
+}
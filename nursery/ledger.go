@@ -0,0 +1,64 @@
+package nursery
+
+import (
+	"github.com/BoltzExchange/boltz-lnd/logger"
+)
+
+// Splits the fee of a claim or refund transaction evenly between the swaps whose lockup outputs it spends. The swap
+// of the first output pays the remainder
+func (nursery *Nursery) addLedgerMinerFee(swapIds []string, fee int64) {
+	if len(swapIds) == 0 || fee <= 0 {
+		return
+	}
+
+	share := fee / int64(len(swapIds))
+	remainder := fee % int64(len(swapIds))
+
+	for i, swapId := range swapIds {
+		swapFee := share
+
+		if i == 0 {
+			swapFee += remainder
+		}
+
+		err := nursery.database.AddLedgerMinerFee(swapId, uint64(swapFee))
+
+		if err != nil {
+			logger.Warning("Could not add miner fee of " + swapId + " to ledger: " + err.Error())
+		}
+	}
+}
+
+// The amounts of deposits are only known once the lockup transaction was found
+func (nursery *Nursery) setDepositLedgerAmounts(swapId string, onchainAmount uint64, invoiceAmount uint64) {
+	var boltzMinerFee uint64
+
+	pairs, err := nursery.boltz.GetPairs()
+
+	if err == nil {
+		if pair, hasPair := pairs.Pairs[nursery.symbol+"/"+nursery.symbol]; hasPair {
+			boltzMinerFee = pair.Fees.MinerFees.BaseAsset.Normal
+		}
+	} else {
+		logger.Warning("Could not get pairs to split the fees of deposit " + swapId + ": " + err.Error())
+	}
+
+	var serviceFee uint64
+
+	if onchainAmount > invoiceAmount {
+		serviceFee = onchainAmount - invoiceAmount
+	}
+
+	if serviceFee > boltzMinerFee {
+		serviceFee -= boltzMinerFee
+	} else {
+		boltzMinerFee = serviceFee
+		serviceFee = 0
+	}
+
+	err = nursery.database.SetLedgerAmounts(swapId, onchainAmount, invoiceAmount, serviceFee, boltzMinerFee)
+
+	if err != nil {
+		logger.Warning("Could not set amounts of deposit " + swapId + " in ledger: " + err.Error())
+	}
+}
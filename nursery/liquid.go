@@ -0,0 +1,250 @@
+package nursery
+
+import (
+	"errors"
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/chain"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/logger"
+	"github.com/BoltzExchange/boltz-lnd/metrics"
+	"github.com/vulpemventures/go-elements/elementsutil"
+	"github.com/vulpemventures/go-elements/transaction"
+	"strconv"
+)
+
+// Liquid transactions are cheap and LND cannot estimate their fees
+const liquidFeeEstimation = int64(1)
+
+func (nursery *Nursery) refundLiquidSwaps(currency *chain.Currency, swapsToRefund []database.Swap, refundAddress string, feeSatPerVbyte int64) (string, error) {
+	var refundedSwaps []database.Swap
+	var refundOutputs []boltz.LiquidOutputDetails
+
+	for _, swapToRefund := range swapsToRefund {
+		nursery.stopEventListener(swapToRefund.Id)
+
+		refundOutput := nursery.getLiquidRefundOutput(currency, &swapToRefund)
+
+		if refundOutput != nil {
+			refundedSwaps = append(refundedSwaps, swapToRefund)
+			refundOutputs = append(refundOutputs, *refundOutput)
+		}
+	}
+
+	if len(refundOutputs) == 0 {
+		return "", errors.New("did not find any outputs to refund")
+	}
+
+	if feeSatPerVbyte == 0 {
+		feeSatPerVbyte = liquidFeeEstimation
+	}
+
+	logger.Info("Using fee of " + strconv.FormatInt(feeSatPerVbyte, 10) + " sat/vbyte for refund transaction")
+
+	refundTransaction, err := boltz.ConstructLiquidTransaction(
+		currency.LiquidNetwork,
+		refundOutputs,
+		refundAddress,
+		feeSatPerVbyte,
+	)
+
+	if err != nil {
+		return "", errors.New("could not construct refund transaction: " + err.Error())
+	}
+
+	refundTransactionId := refundTransaction.TxHash().String()
+	logger.Info("Constructed refund transaction: " + refundTransactionId)
+
+	refundTransactionHex, err := boltz.SerializeLiquidTransaction(refundTransaction)
+
+	if err != nil {
+		return "", errors.New("could not serialize refund transaction: " + err.Error())
+	}
+
+	err = nursery.broadcastTransactionHex(currency, refundTransactionHex)
+
+	if err != nil {
+		return "", errors.New("could not finalize refund transaction: " + err.Error())
+	}
+
+	var refundedSwapIds []string
+
+	for _, refundedSwap := range refundedSwaps {
+		refundedSwapIds = append(refundedSwapIds, refundedSwap.Id)
+	}
+
+	refundFee := getLiquidTransactionFee(refundTransaction)
+
+	metrics.AddMinerFee(database.RefundTransaction.String(), refundFee)
+	nursery.addLedgerMinerFee(refundedSwapIds, refundFee)
+
+	nursery.setRefundTransactionId(refundedSwaps, refundTransactionId)
+
+	return refundTransactionId, nil
+}
+
+func (nursery *Nursery) getLiquidRefundOutput(currency *chain.Currency, swap *database.Swap) *boltz.LiquidOutputDetails {
+	lockupTransactionHex, err := nursery.getSwapLockupTransaction(currency, swap)
+
+	if err != nil {
+		logger.Error("Could not get lockup transaction: " + err.Error())
+		err := nursery.database.UpdateSwapState(swap, boltzrpc.SwapState_ABANDONED, "")
+
+		if err != nil {
+			logger.Error("Could not update state of Swap " + swap.Id + ": " + err.Error())
+		}
+
+		return nil
+	}
+
+	lockupTransaction, err := boltz.ParseLiquidTransaction(lockupTransactionHex)
+
+	if err != nil {
+		logger.Error("Could not parse lockup transaction: " + err.Error())
+		return nil
+	}
+
+	lockupTransactionId := lockupTransaction.TxHash().String()
+
+	err = nursery.database.SetSwapLockupTransactionId(swap, lockupTransactionId)
+
+	if err != nil {
+		logger.Error("Could not set lockup transaction id in database: " + err.Error())
+		return nil
+	}
+
+	lockupScript, err := boltz.LiquidOutputScript(currency.LiquidNetwork, swap.Address)
+
+	if err != nil {
+		logger.Error("Could not decode lockup address of Swap " + swap.Id + ": " + err.Error())
+		return nil
+	}
+
+	lockupVout, _, err := boltz.FindLiquidLockupVout(currency.LiquidNetwork, lockupTransaction, lockupScript, swap.BlindingKey)
+
+	if err != nil {
+		logger.Error("Could not find lockup vout of Swap " + swap.Id + ": " + err.Error())
+		return nil
+	}
+
+	if nursery.isOutpointSpent(currency, lockupTransactionId, lockupVout) {
+		logger.Warning("Lockup output of Swap " + swap.Id + " was spent already")
+		return nil
+	}
+
+	return &boltz.LiquidOutputDetails{
+		LockupTransaction:  lockupTransaction,
+		Vout:               lockupVout,
+		OutputType:         swap.OutputType,
+		RedeemScript:       swap.RedeemScript,
+		PrivateKey:         swap.PrivateKey,
+		BlindingKey:        swap.BlindingKey,
+		Preimage:           []byte{},
+		TimeoutBlockHeight: swap.TimoutBlockHeight,
+	}
+}
+
+func (nursery *Nursery) claimLiquidReverseSwap(currency *chain.Currency, reverseSwap *database.ReverseSwap, lockupTransactionHex string) (string, error) {
+	lockupTransaction, err := boltz.ParseLiquidTransaction(lockupTransactionHex)
+
+	if err != nil {
+		return "", errors.New("could not parse lockup transaction: " + err.Error())
+	}
+
+	lockupTransactionId := lockupTransaction.TxHash().String()
+	err = nursery.database.SetReverseSwapLockupTransactionId(reverseSwap, lockupTransactionId)
+
+	if err != nil {
+		return "", errors.New("could not set lockup transaction id in database: " + err.Error())
+	}
+
+	lockupScript, err := boltz.LiquidSwapOutputScript(reverseSwap.RedeemScript, false)
+
+	if err != nil {
+		return "", errors.New("could not derive lockup script: " + err.Error())
+	}
+
+	lockupVout, lockupValue, err := boltz.FindLiquidLockupVout(
+		currency.LiquidNetwork,
+		lockupTransaction,
+		lockupScript,
+		reverseSwap.BlindingKey,
+	)
+
+	if err != nil {
+		return "", errors.New("could not find lockup vout: " + err.Error())
+	}
+
+	if lockupValue < reverseSwap.OnchainAmount {
+		return "", errors.New("boltz locked up less onchain coins than expected")
+	}
+
+	logger.Info("Constructing claim transaction for Reverse Swap " + reverseSwap.Id + " with output: " + lockupTransactionId + ":" + strconv.Itoa(int(lockupVout)))
+	logger.Info("Using fee of " + strconv.FormatInt(liquidFeeEstimation, 10) + " sat/vbyte for claim transaction")
+
+	claimTransaction, err := boltz.ConstructLiquidTransaction(
+		currency.LiquidNetwork,
+		[]boltz.LiquidOutputDetails{
+			{
+				LockupTransaction: lockupTransaction,
+				Vout:              lockupVout,
+				OutputType:        boltz.SegWit,
+				RedeemScript:      reverseSwap.RedeemScript,
+				PrivateKey:        reverseSwap.PrivateKey,
+				BlindingKey:       reverseSwap.BlindingKey,
+				Preimage:          reverseSwap.Preimage,
+			},
+		},
+		reverseSwap.ClaimAddress,
+		liquidFeeEstimation,
+	)
+
+	if err != nil {
+		return "", errors.New("could not construct claim transaction: " + err.Error())
+	}
+
+	claimTransactionId := claimTransaction.TxHash().String()
+	logger.Info("Constructed claim transaction: " + claimTransactionId)
+
+	claimTransactionHex, err := boltz.SerializeLiquidTransaction(claimTransaction)
+
+	if err != nil {
+		return "", errors.New("could not serialize claim transaction: " + err.Error())
+	}
+
+	err = nursery.broadcastTransactionHex(currency, claimTransactionHex)
+
+	if err != nil {
+		return "", errors.New("could not finalize claim transaction: " + err.Error())
+	}
+
+	claimFee := getLiquidTransactionFee(claimTransaction)
+
+	metrics.AddMinerFee(database.ClaimTransaction.String(), claimFee)
+	nursery.addLedgerMinerFee([]string{reverseSwap.Id}, claimFee)
+
+	err = nursery.database.SetReverseSwapClaimTransactionId(reverseSwap, claimTransactionId)
+
+	if err != nil {
+		return "", errors.New("could not set claim transaction id in database: " + err.Error())
+	}
+
+	return claimTransactionId, nil
+}
+
+// The fee of Liquid transactions is an explicit output without a script
+func getLiquidTransactionFee(liquidTransaction *transaction.Transaction) int64 {
+	for _, output := range liquidTransaction.Outputs {
+		if len(output.Script) != 0 {
+			continue
+		}
+
+		fee, err := elementsutil.ElementsToSatoshiValue(output.Value)
+
+		if err == nil {
+			return int64(fee)
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,240 @@
+package nursery
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/chain"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/stretchr/testify/assert"
+	"github.com/vulpemventures/go-elements/address"
+	"github.com/vulpemventures/go-elements/confidential"
+	"github.com/vulpemventures/go-elements/elementsutil"
+	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/transaction"
+)
+
+// Serves transactions from memory and remembers the ones that were broadcast
+type testBackend struct {
+	transactions map[string]string
+	broadcast    []string
+}
+
+func (backend *testBackend) GetTransaction(transactionId string) (string, error) {
+	transactionHex, hasTransaction := backend.transactions[transactionId]
+
+	if !hasTransaction {
+		return "", errors.New("could not find transaction " + transactionId)
+	}
+
+	return transactionHex, nil
+}
+
+func (backend *testBackend) BroadcastTransaction(transactionHex string) (string, error) {
+	backend.broadcast = append(backend.broadcast, transactionHex)
+	return "", nil
+}
+
+func (backend *testBackend) IsSpent(_ string, _ uint32) (bool, error) {
+	return false, nil
+}
+
+func (backend *testBackend) IsConfirmed(_ string) (bool, error) {
+	return false, nil
+}
+
+func (backend *testBackend) EstimateFee(_ int32) (float64, error) {
+	return 0, errors.New("fees of Liquid are not estimated")
+}
+
+func (backend *testBackend) GetBlockHeight() (uint32, error) {
+	return 0, nil
+}
+
+func (backend *testBackend) CanQueryChain() bool {
+	return true
+}
+
+func (backend *testBackend) Name() string {
+	return "test"
+}
+
+func liquidConfidentialAddress(t *testing.T, program []byte, blindingKey *btcec.PublicKey) string {
+	unconfidentialAddress, err := address.ToBech32(&address.Bech32{
+		Prefix:  network.Regtest.Bech32,
+		Version: 0,
+		Program: program,
+	})
+	assert.Nil(t, err)
+
+	confidentialAddress, err := address.ToConfidential(&address.ConfidentialAddress{
+		Address:     unconfidentialAddress,
+		BlindingKey: blindingKey.SerializeCompressed(),
+	})
+	assert.Nil(t, err)
+
+	return confidentialAddress
+}
+
+func randomBytes(t *testing.T, length int) []byte {
+	bytes := make([]byte, length)
+
+	_, err := rand.Read(bytes)
+	assert.Nil(t, err)
+
+	return bytes
+}
+
+// Blinds an L-BTC output like a wallet would when sending to a confidential address
+func blindLiquidOutput(t *testing.T, value uint64, script []byte, blindingKey *btcec.PublicKey) *transaction.TxOutput {
+	assetId, err := hex.DecodeString(network.Regtest.AssetID)
+	assert.Nil(t, err)
+
+	asset := elementsutil.ReverseBytes(assetId)
+	assetBlindingFactor := randomBytes(t, 32)
+
+	var valueBlindingFactor [32]byte
+	copy(valueBlindingFactor[:], randomBytes(t, 32))
+
+	assetCommitment, err := confidential.AssetCommitment(asset, assetBlindingFactor)
+	assert.Nil(t, err)
+
+	valueCommitment, err := confidential.ValueCommitment(value, assetCommitment, valueBlindingFactor[:])
+	assert.Nil(t, err)
+
+	ephemeralKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	nonce, err := confidential.NonceHash(blindingKey.SerializeCompressed(), ephemeralKey.Serialize())
+	assert.Nil(t, err)
+
+	rangeProof, err := confidential.RangeProof(confidential.RangeProofArgs{
+		Value:               value,
+		Nonce:               nonce,
+		Asset:               asset,
+		AssetBlindingFactor: assetBlindingFactor,
+		ValueBlindFactor:    valueBlindingFactor,
+		ValueCommit:         valueCommitment,
+		ScriptPubkey:        script,
+	})
+	assert.Nil(t, err)
+
+	output := transaction.NewTxOutput(assetCommitment, valueCommitment, script)
+	output.Nonce = ephemeralKey.PubKey().SerializeCompressed()
+	output.RangeProof = rangeProof
+
+	return output
+}
+
+func TestRefundLiquidSwap(t *testing.T) {
+	db, cleanup := newTestDatabase(t)
+	defer cleanup()
+
+	backend := &testBackend{
+		transactions: make(map[string]string),
+	}
+
+	currency := &chain.Currency{
+		Symbol:        "L-BTC",
+		Backend:       backend,
+		LiquidNetwork: &network.Regtest,
+	}
+
+	nursery := &Nursery{
+		database: db,
+		currencies: map[string]*chain.Currency{
+			currency.Symbol: currency,
+		},
+	}
+
+	privateKey, _ := btcec.NewPrivateKey(btcec.S256())
+	blindingKey, _ := btcec.NewPrivateKey(btcec.S256())
+	refundBlindingKey, _ := btcec.NewPrivateKey(btcec.S256())
+
+	redeemScript := []byte{txscript.OP_TRUE}
+	redeemScriptHash := sha256.Sum256(redeemScript)
+
+	lockupAddress := liquidConfidentialAddress(t, redeemScriptHash[:], blindingKey.PubKey())
+	refundAddress := liquidConfidentialAddress(t, randomBytes(t, 20), refundBlindingKey.PubKey())
+
+	lockupScript, err := boltz.LiquidSwapOutputScript(redeemScript, false)
+	assert.Nil(t, err)
+
+	// The lockup output is not the first one to make sure that the vout is searched
+	otherKey, _ := btcec.NewPrivateKey(btcec.S256())
+
+	lockupTransaction := transaction.NewTx(2)
+	lockupTransaction.AddInput(transaction.NewTxInput(make([]byte, 32), 0))
+	lockupTransaction.AddOutput(blindLiquidOutput(t, 50000, []byte{txscript.OP_TRUE}, otherKey.PubKey()))
+	lockupTransaction.AddOutput(blindLiquidOutput(t, 100000, lockupScript, blindingKey.PubKey()))
+
+	lockupTransactionHex, err := boltz.SerializeLiquidTransaction(lockupTransaction)
+	assert.Nil(t, err)
+
+	lockupTransactionHash := lockupTransaction.TxHash()
+	lockupTransactionId := lockupTransactionHash.String()
+	backend.transactions[lockupTransactionId] = lockupTransactionHex
+
+	swap := database.Swap{
+		Id:                  "liquid",
+		State:               boltzrpc.SwapState_ERROR,
+		PrivateKey:          privateKey,
+		RedeemScript:        redeemScript,
+		Address:             lockupAddress,
+		TimoutBlockHeight:   321,
+		LockupTransactionId: lockupTransactionId,
+		RefundAddress:       refundAddress,
+		BlindingKey:         blindingKey,
+		OutputType:          boltz.SegWit,
+		Currency:            currency.Symbol,
+	}
+	assert.Nil(t, db.CreateSwap(swap))
+
+	refundTransactionId, err := nursery.RefundSwaps([]database.Swap{swap}, refundAddress, 0)
+	assert.Nil(t, err)
+
+	assert.Len(t, backend.broadcast, 1)
+
+	refundTransaction, err := boltz.ParseLiquidTransaction(backend.broadcast[0])
+	assert.Nil(t, err)
+
+	assert.Equal(t, refundTransactionId, refundTransaction.TxHash().String())
+	assert.Equal(t, uint32(321), refundTransaction.Locktime)
+
+	assert.Len(t, refundTransaction.Inputs, 1)
+	assert.Equal(t, lockupTransactionHash.CloneBytes(), refundTransaction.Inputs[0].Hash)
+	assert.Equal(t, uint32(1), refundTransaction.Inputs[0].Index)
+	assert.Equal(t, redeemScript, refundTransaction.Inputs[0].Witness[2])
+
+	// The refund pays the unblinded value of the lockup output minus the explicit fee output to the refund address
+	refundScript, err := boltz.LiquidOutputScript(&network.Regtest, refundAddress)
+	assert.Nil(t, err)
+
+	assert.Len(t, refundTransaction.Outputs, 2)
+	assert.Equal(t, refundScript, refundTransaction.Outputs[0].Script)
+
+	refundOutput, err := boltz.UnblindLiquidOutput(refundTransaction.Outputs[0], nil)
+	assert.Nil(t, err)
+	assert.Equal(t, network.Regtest.AssetID, hex.EncodeToString(refundOutput.Asset))
+
+	fee := getLiquidTransactionFee(refundTransaction)
+
+	assert.Equal(t, int64(refundTransaction.VirtualSize())*liquidFeeEstimation, fee)
+	assert.Equal(t, uint64(100000), refundOutput.Value+uint64(fee))
+
+	refundedSwap, err := db.QuerySwap(swap.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, boltzrpc.SwapState_REFUNDED, refundedSwap.State)
+	assert.Equal(t, refundTransactionId, refundedSwap.RefundTransactionId)
+
+	// Without the blinding key of Boltz, the lockup output cannot be unblinded and nothing is refunded
+	_, _, err = boltz.FindLiquidLockupVout(&network.Regtest, lockupTransaction, lockupScript, otherKey)
+	assert.NotNil(t, err)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/chain"
 	"github.com/BoltzExchange/boltz-lnd/database"
 	"github.com/BoltzExchange/boltz-lnd/lnd"
 	"github.com/BoltzExchange/boltz-lnd/logger"
@@ -18,14 +19,42 @@ import (
 )
 
 type Nursery struct {
+	// Name of the LND node of the nursery. Empty for the node of the [LND] section
+	node string
+
 	symbol      string
 	boltzPubKey string
 
 	chainParams *chaincfg.Params
 
-	lnd      *lnd.LND
-	boltz    *boltz.Boltz
-	database *database.Database
+	lnd          lnd.LightningClient
+	boltz        *boltz.Boltz
+	chainBackend chain.Backend
+	database     *database.Database
+
+	// Onchain currency on the chain of the LND node and the ones of cross chain swaps
+	lndCurrency *chain.Currency
+	currencies  map[string]*chain.Currency
+
+	feeBump               *FeeBumpConfig
+	claimBatchConfig      *ClaimBatchConfig
+	danglingChannelConfig *DanglingChannelConfig
+
+	claimBatch claimBatch
+
+	// Prevents the same Swap from being refunded by the block listener and a manual refund at the same time
+	refundLock sync.Mutex
+
+	// Prevents the same transaction from being bumped by the block listener and a manual bump at the same time
+	feeBumpLock sync.Mutex
+
+	// Pending transactions whose confirmation is watched with LND because the chain backend cannot query it
+	confirmationListeners     map[string]*confirmationListener
+	confirmationListenersLock sync.Mutex
+
+	// IDs of the Channel Creations whose dangling channel is being closed
+	closingChannels     map[string]bool
+	closingChannelsLock sync.Mutex
 }
 
 const retryInterval = 15
@@ -35,13 +64,20 @@ var eventListeners = make(map[string]chan bool)
 var eventListenersLock sync.RWMutex
 
 func (nursery *Nursery) Init(
+	node string,
 	symbol string,
 	boltzPubKey string,
 	chainParams *chaincfg.Params,
-	lnd *lnd.LND,
+	lnd lnd.LightningClient,
 	boltz *boltz.Boltz,
+	chainBackend chain.Backend,
+	currencies map[string]*chain.Currency,
+	feeBump *FeeBumpConfig,
+	claimBatchConfig *ClaimBatchConfig,
+	danglingChannelConfig *DanglingChannelConfig,
 	database *database.Database,
 ) error {
+	nursery.node = node
 	nursery.symbol = symbol
 	nursery.boltzPubKey = boltzPubKey
 
@@ -49,11 +85,29 @@ func (nursery *Nursery) Init(
 
 	nursery.lnd = lnd
 	nursery.boltz = boltz
+	nursery.chainBackend = chainBackend
+	nursery.lndCurrency = &chain.Currency{
+		Symbol:  symbol,
+		Params:  chainParams,
+		Backend: chainBackend,
+	}
+	nursery.currencies = currencies
+	nursery.feeBump = feeBump
+	nursery.claimBatchConfig = claimBatchConfig
+	nursery.danglingChannelConfig = danglingChannelConfig
 	nursery.database = database
 
-	logger.Info("Starting nursery")
+	if node == "" {
+		logger.Info("Starting nursery")
+	} else {
+		logger.Info("Starting nursery of node " + node)
+	}
+
+	if !chainBackend.CanQueryChain() {
+		logger.Warning(chainBackend.Name() + " cannot check whether lockup outputs were spent already before they are refunded")
+	}
 
-	// TODO: use channel acceptor to prevent invalid channel openings from happening
+	go nursery.registerChannelAcceptor()
 
 	blockNotifier := make(chan *chainrpc.BlockEpoch)
 	go nursery.registerBlockListener(blockNotifier)
@@ -83,9 +137,24 @@ func (nursery *Nursery) registerBlockListener(blockNotifier chan *chainrpc.Block
 	}
 }
 
-func (nursery *Nursery) findLockupVout(addressToFind string, outputs []*wire.TxOut) (uint32, error) {
+// getCurrency returns the onchain currency of a swap. An empty symbol refers to the chain of the LND node
+func (nursery *Nursery) getCurrency(symbol string) (*chain.Currency, error) {
+	if symbol == "" {
+		return nursery.lndCurrency, nil
+	}
+
+	currency, hasCurrency := nursery.currencies[symbol]
+
+	if !hasCurrency {
+		return nil, errors.New("no chain backend configured for " + symbol)
+	}
+
+	return currency, nil
+}
+
+func findLockupVout(chainParams *chaincfg.Params, addressToFind string, outputs []*wire.TxOut) (uint32, error) {
 	for vout, output := range outputs {
-		_, outputAddresses, _, err := txscript.ExtractPkScriptAddrs(output.PkScript, nursery.chainParams)
+		_, outputAddresses, _, err := txscript.ExtractPkScriptAddrs(output.PkScript, chainParams)
 
 		// Just ignore outputs we can't decode
 		if err != nil {
@@ -102,8 +171,39 @@ func (nursery *Nursery) findLockupVout(addressToFind string, outputs []*wire.TxO
 	return 0, errors.New("could not find lockup vout")
 }
 
+// EstimateFee returns the fee rate in satoshis per vbyte with which claim and refund transactions of the onchain
+// currency would be broadcast. An empty symbol refers to the chain of the LND node
+func (nursery *Nursery) EstimateFee(symbol string) (int64, error) {
+	currency, err := nursery.getCurrency(symbol)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return nursery.getFeeEstimation(currency)
+}
+
 // TODO: test behaviour on testnet / mainnet
-func (nursery *Nursery) getFeeEstimation() (int64, error) {
+func (nursery *Nursery) getFeeEstimation(currency *chain.Currency) (int64, error) {
+	if currency.LiquidNetwork != nil {
+		return liquidFeeEstimation, nil
+	}
+
+	feeEstimation, err := currency.Backend.EstimateFee(2)
+
+	if err == nil {
+		return maxInt64(int64(math.Round(feeEstimation)), 2), nil
+	}
+
+	logger.Warning("Could not get fee estimation from " + currency.Backend.Name() + ": " + err.Error())
+
+	// LND can only estimate fees for its own chain
+	if currency != nursery.lndCurrency {
+		return 0, err
+	}
+
+	logger.Info("Falling back to fee estimation of LND")
+
 	feeResponse, err := nursery.lnd.EstimateFee(2)
 
 	if err != nil {
@@ -114,24 +214,107 @@ func (nursery *Nursery) getFeeEstimation() (int64, error) {
 	return maxInt64(int64(math.Round(float64(feeResponse.SatPerKw)/4000)), 2), nil
 }
 
-func (nursery *Nursery) broadcastTransaction(transaction *wire.MsgTx) error {
+func (nursery *Nursery) broadcastTransaction(currency *chain.Currency, transaction *wire.MsgTx) error {
 	transactionHex, err := boltz.SerializeTransaction(transaction)
 
 	if err != nil {
 		return errors.New("could not serialize transaction: " + err.Error())
 	}
 
-	_, err = nursery.boltz.BroadcastTransaction(transactionHex)
+	return nursery.broadcastTransactionHex(currency, transactionHex)
+}
+
+func (nursery *Nursery) broadcastTransactionHex(currency *chain.Currency, transactionHex string) error {
+	_, err := currency.Backend.BroadcastTransaction(transactionHex)
 
 	if err != nil {
 		return errors.New("could not broadcast transaction: " + err.Error())
 	}
 
-	logger.Info("Broadcast transaction with Boltz API")
+	logger.Info("Broadcast " + currency.Symbol + " transaction with " + currency.Backend.Name())
 
 	return nil
 }
 
+// The fee of a claim or refund transaction is the value of the spent lockup outputs minus the value of its outputs
+func getTransactionFee(outputs []boltz.OutputDetails, transaction *wire.MsgTx) int64 {
+	var fee int64
+
+	for _, output := range outputs {
+		fee += output.LockupTransaction.MsgTx().TxOut[output.Vout].Value
+	}
+
+	for _, txOut := range transaction.TxOut {
+		fee -= txOut.Value
+	}
+
+	return fee
+}
+
+// The lockup transaction is fetched from the chain backend if its id is known and from the Boltz API otherwise
+func (nursery *Nursery) getSwapLockupTransaction(currency *chain.Currency, swap *database.Swap) (string, error) {
+	if swap.LockupTransactionId != "" {
+		transactionHex, err := currency.Backend.GetTransaction(swap.LockupTransactionId)
+
+		if err == nil {
+			logger.Info("Got lockup transaction of Swap " + swap.Id + " from " + currency.Backend.Name())
+			return transactionHex, nil
+		}
+
+		logger.Warning("Could not get lockup transaction of Swap " + swap.Id + " from " + currency.Backend.Name() + ": " + err.Error())
+	}
+
+	swapTransactionResponse, err := nursery.boltz.GetSwapTransaction(swap.Id)
+
+	if err != nil {
+		return "", err
+	}
+
+	logger.Info("Got lockup transaction of Swap " + swap.Id + " from Boltz")
+
+	return swapTransactionResponse.TransactionHex, nil
+}
+
+// Outpoints that cannot be checked are assumed to be unspent
+func (nursery *Nursery) isOutpointSpent(currency *chain.Currency, transactionId string, vout uint32) bool {
+	if !currency.Backend.CanQueryChain() {
+		return false
+	}
+
+	isSpent, err := currency.Backend.IsSpent(transactionId, vout)
+
+	if err != nil {
+		logger.Warning("Could not check whether output " + transactionId + ":" + strconv.FormatUint(uint64(vout), 10) + " was spent: " + err.Error())
+		return false
+	}
+
+	return isSpent
+}
+
+func (nursery *Nursery) getBlockHeight() (uint32, error) {
+	lndInfo, err := nursery.lnd.GetInfo()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return lndInfo.BlockHeight, nil
+}
+
+func (nursery *Nursery) stopEventListener(id string) {
+	eventListenersLock.RLock()
+	stopListening, hasListener := eventListeners[id]
+	eventListenersLock.RUnlock()
+
+	if hasListener {
+		stopListening <- true
+
+		eventListenersLock.Lock()
+		delete(eventListeners, id)
+		eventListenersLock.Unlock()
+	}
+}
+
 func maxInt64(a int64, b int64) int64 {
 	if a > b {
 		return a
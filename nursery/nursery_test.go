@@ -1,12 +1,177 @@
 package nursery
 
 import (
+	"io/ioutil"
+	"os"
+	"path"
+	"sync"
 	"testing"
 
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/lnd"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	"github.com/stretchr/testify/assert"
 )
 
+// Implements the calls to LND with which channels and pending transactions are handled. All others panic
+type testLightning struct {
+	lnd.LightningClient
+
+	blockHeight uint32
+
+	// Closed when the transaction confirmed
+	confirmations     map[string]chan bool
+	confirmationsLock sync.Mutex
+
+	channels          *lnrpc.ListChannelsResponse
+	channelsErr       error
+	listChannelsCalls int
+
+	settledPreimages        [][]byte
+	cancelledPreimageHashes [][]byte
+
+	pendingChannels *lnrpc.PendingChannelsResponse
+
+	closeStream      lnrpc.Lightning_CloseChannelClient
+	forceCloseStream lnrpc.Lightning_CloseChannelClient
+}
+
+// Returns the update after the block channel, if set, was closed
+type testCloseStream struct {
+	lnrpc.Lightning_CloseChannelClient
+
+	block  chan struct{}
+	update *lnrpc.CloseStatusUpdate
+	err    error
+}
+
+func (stream *testCloseStream) Recv() (*lnrpc.CloseStatusUpdate, error) {
+	if stream.block != nil {
+		<-stream.block
+	}
+
+	return stream.update, stream.err
+}
+
+func (lightning *testLightning) GetInfo() (*lnrpc.GetInfoResponse, error) {
+	return &lnrpc.GetInfoResponse{
+		BlockHeight: lightning.blockHeight,
+	}, nil
+}
+
+func (lightning *testLightning) RegisterConfirmationListener(transactionId string, _ []byte, _ uint32, stop chan bool) (bool, error) {
+	select {
+	case <-lightning.getConfirmation(transactionId):
+		return true, nil
+	case <-stop:
+		return false, nil
+	}
+}
+
+func (lightning *testLightning) confirm(transactionId string) {
+	close(lightning.getConfirmation(transactionId))
+}
+
+func (lightning *testLightning) getConfirmation(transactionId string) chan bool {
+	lightning.confirmationsLock.Lock()
+	defer lightning.confirmationsLock.Unlock()
+
+	if lightning.confirmations == nil {
+		lightning.confirmations = make(map[string]chan bool)
+	}
+
+	confirmation, hasConfirmation := lightning.confirmations[transactionId]
+
+	if !hasConfirmation {
+		confirmation = make(chan bool)
+		lightning.confirmations[transactionId] = confirmation
+	}
+
+	return confirmation
+}
+
+func (lightning *testLightning) ListChannels() (*lnrpc.ListChannelsResponse, error) {
+	lightning.listChannelsCalls++
+	return lightning.channels, lightning.channelsErr
+}
+
+func (lightning *testLightning) SettleInvoice(preimage []byte) (*invoicesrpc.SettleInvoiceResp, error) {
+	lightning.settledPreimages = append(lightning.settledPreimages, preimage)
+	return &invoicesrpc.SettleInvoiceResp{}, nil
+}
+
+func (lightning *testLightning) CancelInvoice(preimageHash []byte) (*invoicesrpc.CancelInvoiceResp, error) {
+	lightning.cancelledPreimageHashes = append(lightning.cancelledPreimageHashes, preimageHash)
+	return &invoicesrpc.CancelInvoiceResp{}, nil
+}
+
+func (lightning *testLightning) PendingChannels() (*lnrpc.PendingChannelsResponse, error) {
+	return lightning.pendingChannels, nil
+}
+
+func (lightning *testLightning) CloseChannel(_ string) (lnrpc.Lightning_CloseChannelClient, error) {
+	return lightning.closeStream, nil
+}
+
+func (lightning *testLightning) ForceCloseChannel(_ string) (lnrpc.Lightning_CloseChannelClient, error) {
+	return lightning.forceCloseStream, nil
+}
+
+func newTestDatabase(t *testing.T) (*database.Database, func()) {
+	dataDir, err := ioutil.TempDir("", "boltz-lnd")
+	assert.Nil(t, err)
+
+	db := &database.Database{
+		Path: path.Join(dataDir, "boltz.db"),
+	}
+	assert.Nil(t, db.Connect())
+
+	return db, func() {
+		_ = os.RemoveAll(dataDir)
+	}
+}
+
 func TestMaxInt64(t *testing.T) {
 	assert.Equal(t, maxInt64(0, 2), int64(2))
 	assert.Equal(t, maxInt64(12, 2), int64(12))
 }
+
+func TestCalculateBumpedFee(t *testing.T) {
+	// At least one sat/vbyte more
+	assert.Equal(t, int64(2), calculateBumpedFee(1, 1))
+	assert.Equal(t, int64(4), calculateBumpedFee(3, 2))
+
+	// At least a quarter more
+	assert.Equal(t, int64(25), calculateBumpedFee(20, 10))
+
+	// The estimation in case it is higher
+	assert.Equal(t, int64(40), calculateBumpedFee(20, 40))
+}
+
+func TestIsClaimBatchDue(t *testing.T) {
+	nursery := &Nursery{
+		claimBatchConfig: &ClaimBatchConfig{
+			Blocks:        6,
+			TimeoutMargin: 10,
+		},
+	}
+
+	assert.False(t, nursery.isClaimBatchDue(100))
+
+	nursery.claimBatch.startHeight = 100
+	nursery.claimBatch.claims = []queuedClaim{
+		{reverseSwap: database.ReverseSwap{TimeoutBlockHeight: 200}},
+	}
+
+	assert.False(t, nursery.isClaimBatchDue(105))
+	assert.True(t, nursery.isClaimBatchDue(106))
+
+	// Reverse Swaps that are about to time out are claimed before the batch is complete
+	nursery.claimBatch.claims = append(nursery.claimBatch.claims, queuedClaim{
+		reverseSwap: database.ReverseSwap{TimeoutBlockHeight: 112},
+	})
+
+	assert.False(t, nursery.isClaimBatchDue(101))
+	assert.True(t, nursery.isClaimBatchDue(102))
+}
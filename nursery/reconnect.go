@@ -3,6 +3,7 @@ package nursery
 import (
 	"github.com/BoltzExchange/boltz-lnd/boltz"
 	"github.com/BoltzExchange/boltz-lnd/logger"
+	"github.com/BoltzExchange/boltz-lnd/metrics"
 	"strconv"
 	"time"
 )
@@ -35,7 +36,8 @@ func (nursery *Nursery) streamSwapStatus(
 					if err == nil {
 						ticker.Stop()
 
-						logger.Info("Reconnected to event stream of " + swapType + "" + id)
+						logger.Info("Reconnected to event stream of " + swapType + " " + id)
+						metrics.AddEventStreamReconnect(swapType)
 
 						eventStream <- latestStatus
 						nursery.streamSwapStatus(id, swapType, eventStream, stopListening, stopHandler)
@@ -2,10 +2,13 @@ package nursery
 
 import (
 	"encoding/hex"
+	"errors"
 	"github.com/BoltzExchange/boltz-lnd/boltz"
 	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/chain"
 	"github.com/BoltzExchange/boltz-lnd/database"
 	"github.com/BoltzExchange/boltz-lnd/logger"
+	"github.com/BoltzExchange/boltz-lnd/metrics"
 	"github.com/btcsuite/btcutil"
 	"strconv"
 )
@@ -20,6 +23,10 @@ func (nursery *Nursery) recoverReverseSwaps() error {
 	}
 
 	for _, reverseSwap := range reverseSwaps {
+		if reverseSwap.Node != nursery.node {
+			continue
+		}
+
 		logger.Info("Recovering Reverse Swap " + reverseSwap.Id + " at state: " + reverseSwap.Status.String())
 
 		// TODO: handle race condition when status is updated between the POST request and the time the streaming starts
@@ -42,6 +49,13 @@ func (nursery *Nursery) recoverReverseSwaps() error {
 		}
 
 		logger.Info("Reverse Swap " + reverseSwap.Id + " status did not change")
+
+		// The claim of Reverse Swaps with a confirmed lockup transaction but without a claim transaction was queued
+		// for a batch that was not claimed before the daemon stopped
+		if reverseSwap.Currency == "" && reverseSwap.Status == boltz.TransactionConfirmed && reverseSwap.ClaimTransactionId == "" {
+			nursery.queueClaim(&reverseSwap, nil)
+		}
+
 		nursery.RegisterReverseSwap(reverseSwap, nil)
 	}
 
@@ -103,6 +117,34 @@ func (nursery *Nursery) handleReverseSwapStatus(reverseSwap *database.ReverseSwa
 			break
 		}
 
+		// Zero-conf Reverse Swaps were claimed when the lockup transaction was in the mempool already
+		if reverseSwap.ClaimTransactionId != "" {
+			logger.Info("Reverse Swap " + reverseSwap.Id + " was claimed already in: " + reverseSwap.ClaimTransactionId)
+			break
+		}
+
+		currency, err := nursery.getCurrency(reverseSwap.Currency)
+
+		if err != nil {
+			logger.Error("Could not claim Reverse Swap " + reverseSwap.Id + ": " + err.Error())
+			return
+		}
+
+		if currency.LiquidNetwork != nil {
+			claimTransactionId, err := nursery.claimLiquidReverseSwap(currency, reverseSwap, event.Transaction.Hex)
+
+			if err != nil {
+				logger.Error("Could not claim Reverse Swap " + reverseSwap.Id + ": " + err.Error())
+				return
+			}
+
+			if claimTransactionIdChan != nil {
+				claimTransactionIdChan <- claimTransactionId
+			}
+
+			break
+		}
+
 		lockupTransactionRaw, err := hex.DecodeString(event.Transaction.Hex)
 
 		if err != nil {
@@ -124,35 +166,28 @@ func (nursery *Nursery) handleReverseSwapStatus(reverseSwap *database.ReverseSwa
 			return
 		}
 
-		lockupAddress, err := boltz.WitnessScriptHashAddress(nursery.chainParams, reverseSwap.RedeemScript)
+		claimOutput, err := nursery.getClaimOutput(currency, reverseSwap, lockupTransaction)
 
 		if err != nil {
-			logger.Error("Could not derive lockup address: " + err.Error())
+			logger.Error("Could not claim Reverse Swap " + reverseSwap.Id + ": " + err.Error())
 			return
 		}
 
-		lockupVout, err := nursery.findLockupVout(lockupAddress, lockupTransaction.MsgTx().TxOut)
-
-		if err != nil {
-			logger.Error("Could not find lockup vout of Reverse Swap " + reverseSwap.Id)
-			return
-		}
-
-		if lockupTransaction.MsgTx().TxOut[lockupVout].Value < int64(reverseSwap.OnchainAmount) {
-			logger.Warning("Boltz locked up less onchain coins than expected. Abandoning Reverse Swap")
-			return
+		if nursery.shouldBatchClaim(reverseSwap, parsedStatus, claimTransactionIdChan) {
+			nursery.queueClaim(reverseSwap, lockupTransaction)
+			break
 		}
 
-		logger.Info("Constructing claim transaction for Reverse Swap " + reverseSwap.Id + " with output: " + lockupTransaction.Hash().String() + ":" + strconv.Itoa(int(lockupVout)))
+		logger.Info("Constructing claim transaction for Reverse Swap " + reverseSwap.Id + " with output: " + lockupTransaction.Hash().String() + ":" + strconv.Itoa(int(claimOutput.Vout)))
 
-		claimAddress, err := btcutil.DecodeAddress(reverseSwap.ClaimAddress, nursery.chainParams)
+		claimAddress, err := btcutil.DecodeAddress(reverseSwap.ClaimAddress, currency.Params)
 
 		if err != nil {
 			logger.Error("Could not decode claim address of Reverse Swap: " + err.Error())
 			return
 		}
 
-		feeSatPerVbyte, err := nursery.getFeeEstimation()
+		feeSatPerVbyte, err := nursery.getFeeEstimation(currency)
 
 		if err != nil {
 			logger.Error("Could not get LND fee estimation: " + err.Error())
@@ -161,20 +196,8 @@ func (nursery *Nursery) handleReverseSwapStatus(reverseSwap *database.ReverseSwa
 
 		logger.Info("Using fee of " + strconv.FormatInt(feeSatPerVbyte, 10) + " sat/vbyte for claim transaction")
 
-		claimTransaction, err := boltz.ConstructTransaction(
-			[]boltz.OutputDetails{
-				{
-					LockupTransaction: lockupTransaction,
-					Vout:              lockupVout,
-					OutputType:        boltz.SegWit,
-					RedeemScript:      reverseSwap.RedeemScript,
-					PrivateKey:        reverseSwap.PrivateKey,
-					Preimage:          reverseSwap.Preimage,
-				},
-			},
-			claimAddress,
-			feeSatPerVbyte,
-		)
+		claimOutputs := []boltz.OutputDetails{*claimOutput}
+		claimTransaction, err := boltz.ConstructTransaction(claimOutputs, claimAddress, feeSatPerVbyte)
 
 		if err != nil {
 			logger.Error("Could not construct claim transaction: " + err.Error())
@@ -184,13 +207,18 @@ func (nursery *Nursery) handleReverseSwapStatus(reverseSwap *database.ReverseSwa
 		claimTransactionId := claimTransaction.TxHash().String()
 		logger.Info("Constructed claim transaction: " + claimTransactionId)
 
-		err = nursery.broadcastTransaction(claimTransaction)
+		err = nursery.broadcastTransaction(currency, claimTransaction)
 
 		if err != nil {
 			logger.Error("Could not finalize claim transaction: " + err.Error())
 			return
 		}
 
+		claimFee := getTransactionFee(claimOutputs, claimTransaction)
+
+		metrics.AddMinerFee(database.ClaimTransaction.String(), claimFee)
+		nursery.addLedgerMinerFee([]string{reverseSwap.Id}, claimFee)
+
 		err = nursery.database.SetReverseSwapClaimTransactionId(reverseSwap, claimTransactionId)
 
 		if err != nil {
@@ -198,26 +226,84 @@ func (nursery *Nursery) handleReverseSwapStatus(reverseSwap *database.ReverseSwa
 			return
 		}
 
+		// Fee bumping uses the block height and chain backend of the LND node
+		if reverseSwap.Currency == "" {
+			nursery.addPendingTransaction(database.PendingTransaction{
+				Id:             claimTransactionId,
+				Type:           database.ClaimTransaction,
+				SwapIds:        []string{reverseSwap.Id},
+				FeeSatPerVbyte: feeSatPerVbyte,
+			})
+		}
+
 		if claimTransactionIdChan != nil {
 			claimTransactionIdChan <- claimTransactionId
 		}
 	}
 
-	err := nursery.database.UpdateReverseSwapStatus(reverseSwap, parsedStatus)
+	err := nursery.updateReverseSwapStatus(reverseSwap, parsedStatus)
 
 	if err != nil {
-		logger.Error("Could not update status of Reverse Swap " + reverseSwap.Id + ": " + err.Error())
+		logger.Error("Could not update Reverse Swap " + reverseSwap.Id + ": " + err.Error())
 	}
+}
+
+// Saves the status and resulting state of a Reverse Swap in a single database transaction
+func (nursery *Nursery) updateReverseSwapStatus(reverseSwap *database.ReverseSwap, status boltz.SwapUpdateEvent) error {
+	updatedReverseSwap := *reverseSwap
 
-	if parsedStatus.IsCompletedStatus() {
-		err = nursery.database.UpdateReverseSwapState(reverseSwap, boltzrpc.SwapState_SUCCESSFUL, "")
-	} else if parsedStatus.IsFailedStatus() {
-		if reverseSwap.State == boltzrpc.SwapState_PENDING {
-			err = nursery.database.UpdateReverseSwapState(reverseSwap, boltzrpc.SwapState_SERVER_ERROR, "")
+	err := nursery.database.RunTx(func(transaction *database.Transaction) error {
+		err := transaction.UpdateReverseSwapStatus(&updatedReverseSwap, status)
+
+		if err != nil {
+			return errors.New("could not update status: " + err.Error())
 		}
+
+		if status.IsCompletedStatus() {
+			err = transaction.UpdateReverseSwapState(&updatedReverseSwap, boltzrpc.SwapState_SUCCESSFUL, "")
+		} else if status.IsFailedStatus() && updatedReverseSwap.State == boltzrpc.SwapState_PENDING {
+			err = transaction.UpdateReverseSwapState(&updatedReverseSwap, boltzrpc.SwapState_SERVER_ERROR, "")
+		}
+
+		if err != nil {
+			return errors.New("could not update state: " + err.Error())
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	*reverseSwap = updatedReverseSwap
+
+	return nil
+}
+
+func (nursery *Nursery) getClaimOutput(currency *chain.Currency, reverseSwap *database.ReverseSwap, lockupTransaction *btcutil.Tx) (*boltz.OutputDetails, error) {
+	lockupAddress, err := boltz.WitnessScriptHashAddress(currency.Params, reverseSwap.RedeemScript)
+
+	if err != nil {
+		return nil, errors.New("could not derive lockup address: " + err.Error())
 	}
 
+	lockupVout, err := findLockupVout(currency.Params, lockupAddress, lockupTransaction.MsgTx().TxOut)
+
 	if err != nil {
-		logger.Error("Could not update state of Reverse Swap " + reverseSwap.Id + ": " + err.Error())
+		return nil, err
 	}
+
+	if lockupTransaction.MsgTx().TxOut[lockupVout].Value < int64(reverseSwap.OnchainAmount) {
+		return nil, errors.New("boltz locked up less onchain coins than expected")
+	}
+
+	return &boltz.OutputDetails{
+		LockupTransaction: lockupTransaction,
+		Vout:              lockupVout,
+		OutputType:        boltz.SegWit,
+		RedeemScript:      reverseSwap.RedeemScript,
+		PrivateKey:        reverseSwap.PrivateKey,
+		Preimage:          reverseSwap.Preimage,
+	}, nil
 }
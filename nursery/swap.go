@@ -2,10 +2,13 @@ package nursery
 
 import (
 	"encoding/hex"
+	"errors"
 	"github.com/BoltzExchange/boltz-lnd/boltz"
 	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/chain"
 	"github.com/BoltzExchange/boltz-lnd/database"
 	"github.com/BoltzExchange/boltz-lnd/logger"
+	"github.com/BoltzExchange/boltz-lnd/metrics"
 	"github.com/BoltzExchange/boltz-lnd/utils"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -15,113 +18,234 @@ import (
 	"strings"
 )
 
-// TODO: abstract interactions with chain (querying and broadcasting transactions) into interface to be able to switch between Boltz API and bitcoin core
-
 func (nursery *Nursery) startBlockListener(blockNotifier chan *chainrpc.BlockEpoch) {
 	go func() {
 		for {
 			newBlock := <-blockNotifier
 
-			swapsToRefund, err := nursery.database.QueryRefundableSwaps(newBlock.Height)
+			nursery.claimQueuedReverseSwaps(newBlock.Height)
+			nursery.bumpPendingTransactions(newBlock.Height)
+			nursery.checkDanglingChannels(newBlock.Height)
+
+			nursery.refundTimedOutSwaps(nursery.lndCurrency, "", newBlock.Height)
+
+			// The chains of cross chain Swaps are checked whenever LND sees a new block
+			for symbol, currency := range nursery.currencies {
+				blockHeight, err := currency.Backend.GetBlockHeight()
+
+				if err != nil {
+					logger.Warning("Could not get block height of " + symbol + ": " + err.Error())
+					continue
+				}
+
+				nursery.refundTimedOutSwaps(currency, symbol, blockHeight)
+			}
+		}
+	}()
+}
+
+func (nursery *Nursery) refundTimedOutSwaps(currency *chain.Currency, symbol string, blockHeight uint32) {
+	swapsToRefund, err := nursery.database.QueryRefundableSwaps(nursery.node, symbol, blockHeight)
+
+	if err != nil {
+		logger.Error("Could not query refundable Swaps: " + err.Error())
+		return
+	}
+
+	if len(swapsToRefund) == 0 {
+		return
+	}
+
+	logger.Info("Found " + strconv.Itoa(len(swapsToRefund)) + " " + currency.Symbol + " Swaps to refund at height " + strconv.FormatUint(uint64(blockHeight), 10))
+
+	// Swaps that have the same refund address are refunded in a single transaction
+	var refundAddresses []string
+	swapsByRefundAddress := make(map[string][]database.Swap)
+
+	for _, swapToRefund := range swapsToRefund {
+		if _, hasAddress := swapsByRefundAddress[swapToRefund.RefundAddress]; !hasAddress {
+			refundAddresses = append(refundAddresses, swapToRefund.RefundAddress)
+		}
+
+		swapsByRefundAddress[swapToRefund.RefundAddress] = append(swapsByRefundAddress[swapToRefund.RefundAddress], swapToRefund)
+	}
+
+	for _, refundAddress := range refundAddresses {
+		swaps := swapsByRefundAddress[refundAddress]
+
+		if refundAddress == "" {
+			refundAddress, err = nursery.lnd.NewAddress()
 
 			if err != nil {
-				logger.Error("Could not query refundable Swaps: " + err.Error())
+				logger.Error("Could not get new address from LND: " + err.Error())
 				continue
 			}
+		}
 
-			if len(swapsToRefund) > 0 {
-				logger.Info("Found " + strconv.Itoa(len(swapsToRefund)) + " Swaps to refund at height " + strconv.FormatUint(uint64(newBlock.Height), 10))
+		_, err = nursery.RefundSwaps(swaps, refundAddress, 0)
 
-				addressString, err := nursery.lnd.NewAddress()
+		if err != nil {
+			logger.Error("Could not refund Swaps: " + err.Error())
+		}
+	}
+}
 
-				if err != nil {
-					logger.Error("Could not get new address from LND: " + err.Error())
-					continue
-				}
+// RefundSwaps refunds the lockup outputs of the Swaps in a single transaction to the refund address and returns the id
+// of that transaction. All Swaps have to be of the same currency. If the fee is 0, it will be estimated
+func (nursery *Nursery) RefundSwaps(swapsToRefund []database.Swap, refundAddress string, feeSatPerVbyte int64) (string, error) {
+	nursery.refundLock.Lock()
+	defer nursery.refundLock.Unlock()
 
-				address, err := btcutil.DecodeAddress(addressString, nursery.chainParams)
+	swapsToRefund = nursery.filterRefundedSwaps(swapsToRefund)
 
-				if err != nil {
-					logger.Error("Could not decode destination address from LND: " + err.Error())
-					continue
-				}
+	if len(swapsToRefund) == 0 {
+		return "", errors.New("did not find any outputs to refund")
+	}
 
-				var refundedSwaps []database.Swap
-				var refundOutputs []boltz.OutputDetails
+	currencySymbol := swapsToRefund[0].Currency
 
-				for _, swapToRefund := range swapsToRefund {
-					eventListenersLock.RLock()
-					stopListening, hasListener := eventListeners[swapToRefund.Id]
-					eventListenersLock.RUnlock()
+	for _, swapToRefund := range swapsToRefund {
+		if swapToRefund.Currency != currencySymbol {
+			return "", errors.New("Swaps of different currencies cannot be refunded in one transaction")
+		}
+	}
 
-					if hasListener {
-						stopListening <- true
+	currency, err := nursery.getCurrency(currencySymbol)
 
-						eventListenersLock.Lock()
-						delete(eventListeners, swapToRefund.Id)
-						eventListenersLock.Unlock()
-					}
+	if err != nil {
+		return "", err
+	}
 
-					refundOutput := nursery.getRefundOutput(&swapToRefund)
+	if currency.LiquidNetwork != nil {
+		return nursery.refundLiquidSwaps(currency, swapsToRefund, refundAddress, feeSatPerVbyte)
+	}
 
-					if refundOutput != nil {
-						refundedSwaps = append(refundedSwaps, swapToRefund)
-						refundOutputs = append(refundOutputs, *refundOutput)
-					}
-				}
+	address, err := btcutil.DecodeAddress(refundAddress, currency.Params)
 
-				if len(refundOutputs) == 0 {
-					logger.Info("Did not find any outputs to refund")
-					continue
-				}
+	if err != nil {
+		return "", errors.New("could not decode refund address: " + err.Error())
+	}
 
-				feeSatPerVbyte, err := nursery.getFeeEstimation()
+	var refundedSwaps []database.Swap
+	var refundOutputs []boltz.OutputDetails
 
-				if err != nil {
-					logger.Error("Could not get LND fee estimation: " + err.Error())
-					continue
-				}
+	for _, swapToRefund := range swapsToRefund {
+		nursery.stopEventListener(swapToRefund.Id)
 
-				logger.Info("Using fee of " + strconv.FormatInt(feeSatPerVbyte, 10) + " sat/vbyte for refund transaction")
+		refundOutput := nursery.getRefundOutput(currency, &swapToRefund)
 
-				refundTransaction, err := boltz.ConstructTransaction(
-					refundOutputs,
-					address,
-					feeSatPerVbyte,
-				)
+		if refundOutput != nil {
+			refundedSwaps = append(refundedSwaps, swapToRefund)
+			refundOutputs = append(refundOutputs, *refundOutput)
+		}
+	}
 
-				if err != nil {
-					logger.Error("Could not construct refund transaction: " + err.Error())
-					continue
-				}
+	if len(refundOutputs) == 0 {
+		return "", errors.New("did not find any outputs to refund")
+	}
 
-				refundTransactionId := refundTransaction.TxHash().String()
-				logger.Info("Constructed refund transaction: " + refundTransactionId)
+	if feeSatPerVbyte == 0 {
+		feeSatPerVbyte, err = nursery.getFeeEstimation(currency)
 
-				err = nursery.broadcastTransaction(refundTransaction)
+		if err != nil {
+			return "", errors.New("could not get fee estimation: " + err.Error())
+		}
+	}
 
-				if err != nil {
-					logger.Error("Could not finalize refund transaction: " + err.Error())
-					continue
-				}
+	logger.Info("Using fee of " + strconv.FormatInt(feeSatPerVbyte, 10) + " sat/vbyte for refund transaction")
 
-				for _, refundedSwap := range refundedSwaps {
-					err = nursery.database.SetSwapRefundTransactionId(&refundedSwap, refundTransactionId)
+	refundTransaction, err := boltz.ConstructTransaction(
+		refundOutputs,
+		address,
+		feeSatPerVbyte,
+	)
 
-					if err != nil {
-						logger.Error("Could not set refund transaction id in database: " + err.Error())
-					}
-				}
+	if err != nil {
+		return "", errors.New("could not construct refund transaction: " + err.Error())
+	}
+
+	refundTransactionId := refundTransaction.TxHash().String()
+	logger.Info("Constructed refund transaction: " + refundTransactionId)
+
+	err = nursery.broadcastTransaction(currency, refundTransaction)
+
+	if err != nil {
+		return "", errors.New("could not finalize refund transaction: " + err.Error())
+	}
+
+	var refundedSwapIds []string
+
+	for _, refundedSwap := range refundedSwaps {
+		refundedSwapIds = append(refundedSwapIds, refundedSwap.Id)
+	}
+
+	refundFee := getTransactionFee(refundOutputs, refundTransaction)
+
+	metrics.AddMinerFee(database.RefundTransaction.String(), refundFee)
+	nursery.addLedgerMinerFee(refundedSwapIds, refundFee)
+	nursery.setRefundTransactionId(refundedSwaps, refundTransactionId)
+
+	// Fee bumping uses the block height and chain backend of the LND node
+	if currency != nursery.lndCurrency {
+		return refundTransactionId, nil
+	}
+
+	nursery.addPendingTransaction(database.PendingTransaction{
+		Id:             refundTransactionId,
+		Type:           database.RefundTransaction,
+		SwapIds:        refundedSwapIds,
+		Address:        refundAddress,
+		FeeSatPerVbyte: feeSatPerVbyte,
+	})
+
+	return refundTransactionId, nil
+}
+
+func (nursery *Nursery) filterRefundedSwaps(swaps []database.Swap) []database.Swap {
+	var notRefunded []database.Swap
+
+	for _, swap := range swaps {
+		currentSwap, err := nursery.database.QuerySwap(swap.Id)
+
+		if err != nil {
+			logger.Error("Could not query Swap " + swap.Id + ": " + err.Error())
+			continue
+		}
+
+		if currentSwap.State == boltzrpc.SwapState_REFUNDED {
+			logger.Info("Swap " + swap.Id + " was refunded already")
+			continue
+		}
+
+		notRefunded = append(notRefunded, *currentSwap)
+	}
+
+	return notRefunded
+}
+
+func (nursery *Nursery) setRefundTransactionId(refundedSwaps []database.Swap, refundTransactionId string) {
+	err := nursery.database.RunTx(func(transaction *database.Transaction) error {
+		for _, refundedSwap := range refundedSwaps {
+			err := transaction.SetSwapRefundTransactionId(&refundedSwap, refundTransactionId)
+
+			if err != nil {
+				return err
 			}
 		}
-	}()
+
+		return nil
+	})
+
+	if err != nil {
+		logger.Error("Could not set refund transaction id in database: " + err.Error())
+	}
 }
 
-func (nursery *Nursery) getRefundOutput(swap *database.Swap) *boltz.OutputDetails {
-	swapTransactionResponse, err := nursery.boltz.GetSwapTransaction(swap.Id)
+func (nursery *Nursery) getRefundOutput(currency *chain.Currency, swap *database.Swap) *boltz.OutputDetails {
+	lockupTransactionHex, err := nursery.getSwapLockupTransaction(currency, swap)
 
 	if err != nil {
-		logger.Error("Could not get lockup transaction from Boltz: " + err.Error())
+		logger.Error("Could not get lockup transaction: " + err.Error())
 		err := nursery.database.UpdateSwapState(swap, boltzrpc.SwapState_ABANDONED, "")
 
 		if err != nil {
@@ -131,22 +255,20 @@ func (nursery *Nursery) getRefundOutput(swap *database.Swap) *boltz.OutputDetail
 		return nil
 	}
 
-	lockupTransactionRaw, err := hex.DecodeString(swapTransactionResponse.TransactionHex)
+	lockupTransactionRaw, err := hex.DecodeString(lockupTransactionHex)
 
 	if err != nil {
-		logger.Error("Could not decode lockup transaction from Boltz: " + err.Error())
+		logger.Error("Could not decode lockup transaction: " + err.Error())
 		return nil
 	}
 
 	lockupTransaction, err := btcutil.NewTxFromBytes(lockupTransactionRaw)
 
 	if err != nil {
-		logger.Error("Could not parse lockup transaction from Boltz: " + err.Error())
+		logger.Error("Could not parse lockup transaction: " + err.Error())
 		return nil
 	}
 
-	logger.Info("Got lockup transaction of Swap " + swap.Id + " from Boltz: " + lockupTransaction.Hash().String())
-
 	err = nursery.database.SetSwapLockupTransactionId(swap, lockupTransaction.Hash().String())
 
 	if err != nil {
@@ -154,22 +276,37 @@ func (nursery *Nursery) getRefundOutput(swap *database.Swap) *boltz.OutputDetail
 		return nil
 	}
 
-	lockupVout, err := nursery.findLockupVout(swap.Address, lockupTransaction.MsgTx().TxOut)
+	refundOutput, err := getRefundOutputDetails(currency, swap, lockupTransaction)
 
 	if err != nil {
-		logger.Error("Could not find lockup vout of Swap " + swap.Id)
+		logger.Error(err.Error())
 		return nil
 	}
 
+	if nursery.isOutpointSpent(currency, lockupTransaction.Hash().String(), refundOutput.Vout) {
+		logger.Warning("Lockup output of Swap " + swap.Id + " was spent already")
+		return nil
+	}
+
+	return refundOutput
+}
+
+func getRefundOutputDetails(currency *chain.Currency, swap *database.Swap, lockupTransaction *btcutil.Tx) (*boltz.OutputDetails, error) {
+	lockupVout, err := findLockupVout(currency.Params, swap.Address, lockupTransaction.MsgTx().TxOut)
+
+	if err != nil {
+		return nil, errors.New("could not find lockup vout of Swap " + swap.Id)
+	}
+
 	return &boltz.OutputDetails{
 		LockupTransaction:  lockupTransaction,
 		Vout:               lockupVout,
-		OutputType:         boltz.Compatibility,
+		OutputType:         swap.OutputType,
 		RedeemScript:       swap.RedeemScript,
 		PrivateKey:         swap.PrivateKey,
 		Preimage:           []byte{},
 		TimeoutBlockHeight: uint32(swap.TimoutBlockHeight),
-	}
+	}, nil
 }
 
 func (nursery *Nursery) recoverSwaps(blockNotifier chan *chainrpc.BlockEpoch) error {
@@ -182,6 +319,11 @@ func (nursery *Nursery) recoverSwaps(blockNotifier chan *chainrpc.BlockEpoch) er
 	}
 
 	for _, swap := range swaps {
+		// Swaps of other nodes are recovered by their nurseries
+		if swap.Node != nursery.node {
+			continue
+		}
+
 		channelCreation, err := nursery.database.QueryChannelCreation(swap.Id)
 		isChannelCreation := err == nil
 
@@ -276,11 +418,19 @@ func (nursery *Nursery) handleSwapStatus(swap *database.Swap, channelCreation *d
 		return
 	}
 
+	// Remember the lockup transaction so that it can be queried from the chain backend when refunding. It is saved
+	// together with the new status
+	lockupTransactionId := ""
+
 	switch parsedStatus {
 	case boltz.TransactionMempool:
 		fallthrough
 
 	case boltz.TransactionConfirmed:
+		if swap.LockupTransactionId == "" && status.Transaction.Id != "" {
+			lockupTransactionId = status.Transaction.Id
+		}
+
 		// Connect to the LND node of Boltz to allow for channels to be opened and to gossip our channels
 		// to increase the chances that the provided invoice can be paid
 		_, _ = utils.ConnectBoltzLnd(nursery.lnd, nursery.boltz, nursery.symbol)
@@ -339,6 +489,8 @@ func (nursery *Nursery) handleSwapStatus(swap *database.Swap, channelCreation *d
 			return
 		}
 
+		nursery.setDepositLedgerAmounts(swap.Id, swapRates.OnchainAmount, swapRates.SubmarineSwap.InvoiceAmount)
+
 	case boltz.ChannelCreated:
 		if !isChannelCreation {
 			break
@@ -374,15 +526,7 @@ func (nursery *Nursery) handleSwapStatus(swap *database.Swap, channelCreation *d
 				invoiceAmount := decodedInvoice.MilliSat.ToSatoshis().ToUnit(btcutil.AmountSatoshi)
 				expectedCapacity := calculateChannelCreationCapacity(invoiceAmount, channelCreation.InboundLiquidity)
 
-				if pendingChannel.Channel.Capacity < expectedCapacity {
-					logger.Error("Channel capacity of " + swapType + " " + swap.Id + " " +
-						strconv.FormatInt(pendingChannel.Channel.Capacity, 10) +
-						" is less than than expected " + strconv.FormatInt(expectedCapacity, 10) + " satoshis")
-					return
-				}
-
-				// TODO: how to verify public / private channel?
-
+				// The funding is saved even if the capacity is too low, so that the channel is found as dangling
 				err = nursery.database.SetChannelFunding(channelCreation, id, vout)
 
 				if err != nil {
@@ -390,6 +534,16 @@ func (nursery *Nursery) handleSwapStatus(swap *database.Swap, channelCreation *d
 					return
 				}
 
+				if pendingChannel.Channel.Capacity < expectedCapacity {
+					nursery.failChannelCreation(swap, channelCreation, "channel capacity "+
+						strconv.FormatInt(pendingChannel.Channel.Capacity, 10)+" is less than expected "+
+						strconv.FormatInt(expectedCapacity, 10)+" satoshis")
+					return
+				}
+
+				// Whether the channel is private is not part of pending channels, so it is verified by the channel
+				// acceptor when the channel is opened
+
 				logger.Info("Channel for " + swapType + " " + swap.Id + " was opened: " + pendingChannel.Channel.ChannelPoint)
 
 				break
@@ -420,23 +574,53 @@ func (nursery *Nursery) handleSwapStatus(swap *database.Swap, channelCreation *d
 		logger.Info(swapType + " " + swap.Id + " succeeded")
 	}
 
-	err := nursery.database.UpdateSwapStatus(swap, parsedStatus)
+	err := nursery.updateSwapStatus(swap, parsedStatus, lockupTransactionId)
 
 	if err != nil {
-		logger.Error("Could not update status of " + swapType + " " + swap.Id + ": " + err.Error())
+		logger.Error("Could not update " + swapType + " " + swap.Id + ": " + err.Error())
 	}
+}
+
+// Saves the lockup transaction, status and resulting state of a Swap in a single database transaction, so that a
+// crash cannot leave the Swap half updated
+func (nursery *Nursery) updateSwapStatus(swap *database.Swap, status boltz.SwapUpdateEvent, lockupTransactionId string) error {
+	updatedSwap := *swap
 
-	if parsedStatus.IsCompletedStatus() {
-		err = nursery.database.UpdateSwapState(swap, boltzrpc.SwapState_SUCCESSFUL, "")
-	} else if parsedStatus.IsFailedStatus() {
-		if swap.State == boltzrpc.SwapState_PENDING {
-			err = nursery.database.UpdateSwapState(swap, boltzrpc.SwapState_SERVER_ERROR, "")
+	err := nursery.database.RunTx(func(transaction *database.Transaction) error {
+		if lockupTransactionId != "" {
+			err := transaction.SetSwapLockupTransactionId(&updatedSwap, lockupTransactionId)
+
+			if err != nil {
+				return errors.New("could not set lockup transaction id: " + err.Error())
+			}
+		}
+
+		err := transaction.UpdateSwapStatus(&updatedSwap, status)
+
+		if err != nil {
+			return errors.New("could not update status: " + err.Error())
+		}
+
+		if status.IsCompletedStatus() {
+			err = transaction.UpdateSwapState(&updatedSwap, boltzrpc.SwapState_SUCCESSFUL, "")
+		} else if status.IsFailedStatus() && updatedSwap.State == boltzrpc.SwapState_PENDING {
+			err = transaction.UpdateSwapState(&updatedSwap, boltzrpc.SwapState_SERVER_ERROR, "")
 		}
-	}
+
+		if err != nil {
+			return errors.New("could not update state: " + err.Error())
+		}
+
+		return nil
+	})
 
 	if err != nil {
-		logger.Error("Could not update state of " + swapType + " " + swap.Id + ": " + err.Error())
+		return err
 	}
+
+	*swap = updatedSwap
+
+	return nil
 }
 
 func parseChannelPoint(channelPoint string) (string, uint32, error) {
@@ -0,0 +1,89 @@
+package rpcserver
+
+import (
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+)
+
+type FeePolicy struct {
+	MaxFeePercent float64 `long:"feepolicy.maxfeepercent" description:"Maximal percentage of the invoice amount Boltz can charge in fees for a swap. 0 disables the check"`
+	MaxFee        uint64  `long:"feepolicy.maxfee" description:"Maximal amount of satoshis Boltz can charge in fees for a swap. 0 disables the check"`
+}
+
+func (policy *FeePolicy) Validate() error {
+	if policy.MaxFeePercent < 0 {
+		return errors.New("maximal fee percentage cannot be negative")
+	}
+
+	return nil
+}
+
+// Values that are set in the request take precedence over the configured ones
+func (policy *FeePolicy) withRequest(request *boltzrpc.FeePolicy) (*FeePolicy, error) {
+	requestPolicy := *policy
+
+	if request == nil {
+		return &requestPolicy, nil
+	}
+
+	if request.MaxFeePercent != 0 {
+		requestPolicy.MaxFeePercent = request.MaxFeePercent
+	}
+
+	if request.MaxFee != 0 {
+		requestPolicy.MaxFee = request.MaxFee
+	}
+
+	return &requestPolicy, requestPolicy.Validate()
+}
+
+func (policy *FeePolicy) checkFee(invoiceAmount uint64, fee uint64) error {
+	if policy.MaxFee != 0 && fee > policy.MaxFee {
+		return errors.New("fee of " + strconv.FormatUint(fee, 10) + " satoshis is more than the maximal " +
+			strconv.FormatUint(policy.MaxFee, 10))
+	}
+
+	if policy.MaxFeePercent != 0 && float64(fee) > float64(invoiceAmount)*policy.MaxFeePercent/100 {
+		return errors.New("fee of " + strconv.FormatUint(fee, 10) + " satoshis is more than " +
+			strconv.FormatFloat(policy.MaxFeePercent, 'f', -1, 64) + "% of the invoice amount")
+	}
+
+	return nil
+}
+
+// Checks the fees of a Swap, which are what Boltz expects onchain on top of the value of the invoice
+func (policy *FeePolicy) checkSwapFee(pair *swapPair, rate float64, invoiceAmount uint64, expectedAmount uint64) error {
+	fee := pair.toLightningAmount(float64(expectedAmount), rate) - float64(invoiceAmount)
+
+	return policy.checkFee(invoiceAmount, roundFee(fee))
+}
+
+// Checks the fees Boltz would charge for a Swap according to its pair information. This is done before the invoice of
+// the Swap is added so that swaps which would be rejected anyway leave nothing behind in LND
+func (policy *FeePolicy) checkQuotedSwapFee(pair *swapPair, info *pairInfo, invoiceAmount uint64) error {
+	swapQuote, err := quoteSwap(pair, info, invoiceAmount, boltzrpc.QuoteDirection_RECEIVE, 0)
+
+	if err != nil {
+		return err
+	}
+
+	return policy.checkSwapFee(pair, info.rate, invoiceAmount, swapQuote.sendAmount)
+}
+
+// Checks the fees of a Reverse Swap, which are what is missing onchain from the value of the invoice
+func (policy *FeePolicy) checkReverseSwapFee(pair *swapPair, rate float64, invoiceAmount uint64, onchainAmount uint64) error {
+	fee := float64(invoiceAmount) - pair.toLightningAmount(float64(onchainAmount), rate)
+
+	return policy.checkFee(invoiceAmount, roundFee(fee))
+}
+
+func roundFee(fee float64) uint64 {
+	if fee <= 0 {
+		return 0
+	}
+
+	return uint64(math.Ceil(fee))
+}
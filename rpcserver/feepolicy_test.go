@@ -0,0 +1,71 @@
+package rpcserver
+
+import (
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/chain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeePolicyWithRequest(t *testing.T) {
+	policy := &FeePolicy{
+		MaxFeePercent: 1,
+		MaxFee:        1000,
+	}
+
+	requestPolicy, err := policy.withRequest(nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, policy, requestPolicy)
+
+	requestPolicy, err = policy.withRequest(&boltzrpc.FeePolicy{MaxFee: 2000})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &FeePolicy{MaxFeePercent: 1, MaxFee: 2000}, requestPolicy)
+
+	// The configured policy must not be changed by requests
+	assert.Equal(t, uint64(1000), policy.MaxFee)
+
+	_, err = policy.withRequest(&boltzrpc.FeePolicy{MaxFeePercent: -1})
+	assert.Equal(t, "maximal fee percentage cannot be negative", err.Error())
+}
+
+func TestCheckSwapFee(t *testing.T) {
+	policy := &FeePolicy{
+		MaxFeePercent: 2,
+		MaxFee:        1500,
+	}
+
+	pair := &swapPair{id: "BTC/BTC"}
+
+	assert.Nil(t, policy.checkSwapFee(pair, 1, 100000, 101500))
+	assert.Equal(t, "fee of 1501 satoshis is more than the maximal 1500", policy.checkSwapFee(pair, 1, 100000, 101501).Error())
+	assert.Equal(t, "fee of 1001 satoshis is more than 2% of the invoice amount", policy.checkSwapFee(pair, 1, 50000, 51001).Error())
+
+	assert.Nil(t, policy.checkReverseSwapFee(pair, 1, 100000, 98500))
+	assert.Equal(t, "fee of 1501 satoshis is more than the maximal 1500", policy.checkReverseSwapFee(pair, 1, 100000, 98499).Error())
+
+	// 1 LTC is worth 0.005 BTC and the onchain amount is in LTC
+	pair = &swapPair{id: "LTC/BTC", currency: &chain.Currency{Symbol: "LTC"}, onchainIsBase: true}
+
+	assert.Nil(t, policy.checkSwapFee(pair, 0.005, 100000, 20300000))
+	assert.NotNil(t, policy.checkSwapFee(pair, 0.005, 100000, 20300200))
+
+	assert.Nil(t, policy.checkReverseSwapFee(pair, 0.005, 100000, 19700000))
+	assert.NotNil(t, policy.checkReverseSwapFee(pair, 0.005, 100000, 19699800))
+
+	// Disabled caps do not reject anything
+	policy = &FeePolicy{}
+
+	assert.Nil(t, policy.checkSwapFee(&swapPair{id: "BTC/BTC"}, 1, 100000, 200000))
+}
+
+func TestCheckQuotedSwapFee(t *testing.T) {
+	pair := &swapPair{id: "BTC/BTC"}
+	info := getQuotePairInfo(1)
+
+	// 1% of the invoice and 500 satoshis of miner fees
+	assert.Nil(t, (&FeePolicy{MaxFee: 1500}).checkQuotedSwapFee(pair, info, 100000))
+	assert.Equal(t, "fee of 1500 satoshis is more than the maximal 1499", (&FeePolicy{MaxFee: 1499}).checkQuotedSwapFee(pair, info, 100000).Error())
+}
@@ -0,0 +1,148 @@
+package rpcserver
+
+import (
+	"math"
+	"time"
+
+	"github.com/BoltzExchange/boltz-lnd/accounting"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/logger"
+)
+
+// Boltz charges the difference between the value of the invoice and the onchain amount. Everything but its miner fee
+// is the percentage fee of the service
+func splitBoltzFee(boltzFee float64, boltzMinerFee uint64) (uint64, uint64) {
+	if boltzFee <= float64(boltzMinerFee) {
+		return 0, uint64(math.Max(boltzFee, 0))
+	}
+
+	return uint64(math.Round(boltzFee)) - boltzMinerFee, boltzMinerFee
+}
+
+func newSwapLedgerEntry(pair *swapPair, info *pairInfo, invoiceAmount uint64, expectedAmount uint64) database.LedgerEntry {
+	boltzFee := float64(expectedAmount) - pair.toOnchainAmount(invoiceAmount, info.rate)
+	serviceFee, boltzMinerFee := splitBoltzFee(boltzFee, uint64(info.fees.Miner.Normal))
+
+	return database.LedgerEntry{
+		Type:           database.SubmarineSwap,
+		AmountSent:     expectedAmount,
+		AmountReceived: invoiceAmount,
+		ServiceFee:     serviceFee,
+		BoltzMinerFee:  boltzMinerFee,
+	}
+}
+
+func newReverseSwapLedgerEntry(pair *swapPair, info *pairInfo, invoiceAmount uint64, onchainAmount uint64) database.LedgerEntry {
+	boltzFee := pair.toOnchainAmount(invoiceAmount, info.rate) - float64(onchainAmount)
+	serviceFee, boltzMinerFee := splitBoltzFee(boltzFee, uint64(info.fees.Miner.Reverse))
+
+	return database.LedgerEntry{
+		Type:           database.ReverseSubmarineSwap,
+		AmountSent:     invoiceAmount,
+		AmountReceived: onchainAmount,
+		ServiceFee:     serviceFee,
+		BoltzMinerFee:  boltzMinerFee,
+	}
+}
+
+// The ledger is only used for accounting, so swaps are not failed when their entry cannot be saved
+func (server *routedBoltzServer) createLedgerEntry(node *Node, pair *swapPair, swapId string, entry database.LedgerEntry) {
+	entry.SwapId = swapId
+	entry.Node = server.getDatabaseNode(node)
+	entry.OnchainCurrency = node.Symbol
+	entry.LightningCurrency = node.Symbol
+	entry.CreatedAt = time.Now()
+
+	if pair != nil && pair.currency != nil {
+		entry.OnchainCurrency = pair.currency.Symbol
+	}
+
+	err := server.database.CreateLedgerEntry(entry)
+
+	if err != nil {
+		logger.Warning("Could not create ledger entry of " + swapId + ": " + err.Error())
+	}
+}
+
+// Boltz is not paid for swaps that failed
+func isBoltzPaid(state boltzrpc.SwapState) bool {
+	return state == boltzrpc.SwapState_PENDING || state == boltzrpc.SwapState_SUCCESSFUL
+}
+
+// Sums up the fees per currency and converts them to the fiat currency if prices are set
+func buildFeeReport(entries []database.LedgerEntry, getNodeName func(string) string, prices *accounting.Prices) (*boltzrpc.GetFeeReportResponse, error) {
+	response := &boltzrpc.GetFeeReportResponse{}
+	totals := make(map[string]*boltzrpc.FeeReportTotal)
+
+	getTotal := func(currency string) *boltzrpc.FeeReportTotal {
+		total, hasTotal := totals[currency]
+
+		if !hasTotal {
+			total = &boltzrpc.FeeReportTotal{Currency: currency}
+			totals[currency] = total
+			response.Totals = append(response.Totals, total)
+		}
+
+		return total
+	}
+
+	toFiat := func(currency string, satoshis float64, at time.Time) (float64, error) {
+		if prices == nil || satoshis == 0 {
+			return 0, nil
+		}
+
+		return prices.ToFiat(currency, satoshis, at)
+	}
+
+	for _, entry := range entries {
+		onchainFee := entry.MinerFee
+		onchainTotal := getTotal(entry.OnchainCurrency)
+		onchainTotal.MinerFee += int64(entry.MinerFee)
+
+		if isBoltzPaid(entry.State) {
+			onchainFee += entry.ServiceFee + entry.BoltzMinerFee
+			onchainTotal.ServiceFee += int64(entry.ServiceFee)
+			onchainTotal.BoltzMinerFee += int64(entry.BoltzMinerFee)
+		}
+
+		lightningTotal := getTotal(entry.LightningCurrency)
+		lightningTotal.RoutingFeeMsat += int64(entry.RoutingFeeMsat)
+
+		onchainFiat, err := toFiat(entry.OnchainCurrency, float64(onchainFee), entry.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		lightningFiat, err := toFiat(entry.LightningCurrency, float64(entry.RoutingFeeMsat)/1000, entry.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		onchainTotal.FiatFees += onchainFiat
+		lightningTotal.FiatFees += lightningFiat
+		response.FiatFees += onchainFiat + lightningFiat
+
+		response.Entries = append(response.Entries, &boltzrpc.FeeReportEntry{
+			Id:                entry.SwapId,
+			Type:              serializeSwapType(entry.Type),
+			State:             entry.State,
+			Node:              getNodeName(entry.Node),
+			OnchainCurrency:   entry.OnchainCurrency,
+			LightningCurrency: entry.LightningCurrency,
+			AmountSent:        int64(entry.AmountSent),
+			AmountReceived:    int64(entry.AmountReceived),
+			ServiceFee:        int64(entry.ServiceFee),
+			BoltzMinerFee:     int64(entry.BoltzMinerFee),
+			MinerFee:          int64(entry.MinerFee),
+			RoutingFeeMsat:    int64(entry.RoutingFeeMsat),
+			CreatedAt:         entry.CreatedAt.Unix(),
+			UpdatedAt:         entry.UpdatedAt.Unix(),
+			FiatFees:          onchainFiat + lightningFiat,
+		})
+	}
+
+	return response, nil
+}
@@ -0,0 +1,127 @@
+package rpcserver
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-lnd/accounting"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLedgerEntry(t *testing.T) {
+	info := &pairInfo{
+		fees: &boltzrpc.Fees{
+			Percentage: 1,
+			Miner: &boltzrpc.MinerFees{
+				Normal:  500,
+				Reverse: 700,
+			},
+		},
+		rate: 1,
+	}
+
+	pair := &swapPair{id: "BTC/BTC"}
+
+	entry := newSwapLedgerEntry(pair, info, 100000, 101500)
+
+	assert.Equal(t, database.SubmarineSwap, entry.Type)
+	assert.Equal(t, uint64(101500), entry.AmountSent)
+	assert.Equal(t, uint64(100000), entry.AmountReceived)
+	assert.Equal(t, uint64(1000), entry.ServiceFee)
+	assert.Equal(t, uint64(500), entry.BoltzMinerFee)
+
+	entry = newReverseSwapLedgerEntry(pair, info, 100000, 98300)
+
+	assert.Equal(t, database.ReverseSubmarineSwap, entry.Type)
+	assert.Equal(t, uint64(1000), entry.ServiceFee)
+	assert.Equal(t, uint64(700), entry.BoltzMinerFee)
+
+	// Fees that are lower than the miner fee of Boltz are not attributed to its service
+	entry = newSwapLedgerEntry(pair, info, 100000, 100200)
+
+	assert.Equal(t, uint64(0), entry.ServiceFee)
+	assert.Equal(t, uint64(200), entry.BoltzMinerFee)
+}
+
+func TestBuildFeeReport(t *testing.T) {
+	createdAt := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	entries := []database.LedgerEntry{
+		{
+			SwapId:            "successful",
+			Type:              database.ReverseSubmarineSwap,
+			State:             boltzrpc.SwapState_SUCCESSFUL,
+			OnchainCurrency:   "LTC",
+			LightningCurrency: "BTC",
+			ServiceFee:        1000,
+			BoltzMinerFee:     500,
+			MinerFee:          200,
+			RoutingFeeMsat:    10000,
+			CreatedAt:         createdAt,
+		},
+		{
+			SwapId:            "refunded",
+			Type:              database.SubmarineSwap,
+			State:             boltzrpc.SwapState_REFUNDED,
+			Node:              "second",
+			OnchainCurrency:   "BTC",
+			LightningCurrency: "BTC",
+			ServiceFee:        1000,
+			BoltzMinerFee:     500,
+			MinerFee:          300,
+			CreatedAt:         createdAt,
+		},
+	}
+
+	getNodeName := func(databaseNode string) string {
+		if databaseNode == "" {
+			return "lnd"
+		}
+
+		return databaseNode
+	}
+
+	report, err := buildFeeReport(entries, getNodeName, nil)
+	assert.Nil(t, err)
+
+	assert.Len(t, report.Entries, 2)
+	assert.Equal(t, "lnd", report.Entries[0].Node)
+	assert.Equal(t, boltzrpc.SwapType_REVERSE_SUBMARINE, report.Entries[0].Type)
+	assert.Equal(t, "second", report.Entries[1].Node)
+
+	// Boltz is not paid for the refunded swap
+	assert.Equal(t, []*boltzrpc.FeeReportTotal{
+		{
+			Currency:      "LTC",
+			ServiceFee:    1000,
+			BoltzMinerFee: 500,
+			MinerFee:      200,
+		},
+		{
+			Currency:       "BTC",
+			MinerFee:       300,
+			RoutingFeeMsat: 10000,
+		},
+	}, report.Totals)
+
+	prices, err := accounting.ParsePrices(strings.NewReader("2021-03-01,BTC,50000\n2021-03-01,LTC,200\n"))
+	assert.Nil(t, err)
+
+	report, err = buildFeeReport(entries, getNodeName, prices)
+	assert.Nil(t, err)
+
+	// 1700 LTC satoshis are worth 0.0034 and 10 BTC satoshis 0.005
+	assert.InDelta(t, 0.0084, report.Entries[0].FiatFees, 0.0000001)
+	// 300 BTC satoshis are worth 0.15
+	assert.InDelta(t, 0.15, report.Entries[1].FiatFees, 0.0000001)
+	assert.InDelta(t, 0.1584, report.FiatFees, 0.0000001)
+
+	prices, err = accounting.ParsePrices(strings.NewReader("2021-03-01,BTC,50000\n"))
+	assert.Nil(t, err)
+
+	_, err = buildFeeReport(entries, getNodeName, prices)
+	assert.Equal(t, "no price of LTC found for 2021-03-01", err.Error())
+}
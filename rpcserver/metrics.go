@@ -0,0 +1,157 @@
+package rpcserver
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/logger"
+	"github.com/BoltzExchange/boltz-lnd/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	swapCountDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "swaps"),
+		"Number of Swaps and Reverse Swaps by state",
+		[]string{"type", "state"},
+		nil,
+	)
+
+	swapAmountDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "swap_amount_satoshis"),
+		"Sum of the onchain amounts of Swaps and Reverse Swaps by state",
+		[]string{"type", "state"},
+		nil,
+	)
+
+	timingOutSwapsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "swaps_nearing_timeout"),
+		"Number of pending Swaps and Reverse Swaps that are close to or past their timeout block height",
+		[]string{"node", "currency", "type"},
+		nil,
+	)
+
+	blockHeightDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "block_height"),
+		"Block height of the chains on which the swaps of a node are",
+		[]string{"node", "currency"},
+		nil,
+	)
+
+	lndUpDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "lnd_up"),
+		"Whether the LND node is reachable",
+		[]string{"node"},
+		nil,
+	)
+)
+
+// The metrics that reflect the state of the database and the LND nodes are collected when they are scraped
+type metricsCollector struct {
+	router   *routedBoltzServer
+	database *database.Database
+
+	timeoutMargin uint32
+}
+
+func (collector *metricsCollector) Describe(descs chan<- *prometheus.Desc) {
+	descs <- swapCountDesc
+	descs <- swapAmountDesc
+	descs <- timingOutSwapsDesc
+	descs <- blockHeightDesc
+	descs <- lndUpDesc
+}
+
+func (collector *metricsCollector) Collect(ch chan<- prometheus.Metric) {
+	statistics, err := collector.database.QuerySwapStatistics()
+
+	if err != nil {
+		logger.Warning("Could not query swap statistics: " + err.Error())
+	} else {
+		for _, stateStatistics := range statistics {
+			swapType := formatSwapType(stateStatistics.Type)
+			state := strings.ToLower(stateStatistics.State.String())
+
+			ch <- prometheus.MustNewConstMetric(swapCountDesc, prometheus.GaugeValue, float64(stateStatistics.Count), swapType, state)
+			ch <- prometheus.MustNewConstMetric(swapAmountDesc, prometheus.GaugeValue, float64(stateStatistics.Amount), swapType, state)
+		}
+	}
+
+	for _, node := range collector.router.nodes {
+		collector.collectNode(ch, node)
+	}
+}
+
+// collectNode collects the metrics of an LND node and of the swaps created with it. Swaps are compared to the block
+// height of the chain they are on, which is not the chain of the LND node for cross chain swaps
+func (collector *metricsCollector) collectNode(ch chan<- prometheus.Metric, node *Node) {
+	lndInfo, err := node.LND.GetInfo()
+
+	if err != nil {
+		ch <- prometheus.MustNewConstMetric(lndUpDesc, prometheus.GaugeValue, 0, node.Name)
+	} else {
+		ch <- prometheus.MustNewConstMetric(lndUpDesc, prometheus.GaugeValue, 1, node.Name)
+		collector.collectChain(ch, node, node.Symbol, "", lndInfo.BlockHeight)
+	}
+
+	for symbol, currency := range node.Currencies {
+		blockHeight, err := currency.Backend.GetBlockHeight()
+
+		if err != nil {
+			logger.Warning("Could not get block height of " + symbol + ": " + err.Error())
+			continue
+		}
+
+		collector.collectChain(ch, node, symbol, symbol, blockHeight)
+	}
+}
+
+func (collector *metricsCollector) collectChain(ch chan<- prometheus.Metric, node *Node, symbol string, databaseCurrency string, blockHeight uint32) {
+	ch <- prometheus.MustNewConstMetric(blockHeightDesc, prometheus.GaugeValue, float64(blockHeight), node.Name, symbol)
+
+	swaps, reverseSwaps, err := collector.database.QueryTimingOutSwapCount(
+		collector.router.getDatabaseNode(node),
+		databaseCurrency,
+		blockHeight+collector.timeoutMargin,
+	)
+
+	if err != nil {
+		logger.Warning("Could not query swaps nearing their timeout: " + err.Error())
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(timingOutSwapsDesc, prometheus.GaugeValue, float64(swaps), node.Name, symbol, formatSwapType(database.SubmarineSwap))
+	ch <- prometheus.MustNewConstMetric(timingOutSwapsDesc, prometheus.GaugeValue, float64(reverseSwaps), node.Name, symbol, formatSwapType(database.ReverseSubmarineSwap))
+}
+
+func formatSwapType(swapType database.SwapType) string {
+	if swapType == database.ReverseSubmarineSwap {
+		return "reverse"
+	}
+
+	return "submarine"
+}
+
+// The metrics endpoint is served without TLS and authentication, which is why it listens on localhost by default
+func (server *RpcServer) startMetricsServer(router *routedBoltzServer, database *database.Database) error {
+	err := metrics.Registry.Register(&metricsCollector{
+		router:        router,
+		database:      database,
+		timeoutMargin: server.MetricsTimeoutMargin,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	metricsUrl := server.MetricsHost + ":" + strconv.Itoa(server.MetricsPort)
+	logger.Info("Starting metrics server on: " + metricsUrl)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{}))
+
+	return http.ListenAndServe(metricsUrl, mux)
+}
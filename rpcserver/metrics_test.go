@@ -0,0 +1,85 @@
+package rpcserver
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectChain(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "boltz-lnd")
+	assert.Nil(t, err)
+
+	defer os.RemoveAll(dataDir)
+
+	db := &database.Database{
+		Path: path.Join(dataDir, "boltz.db"),
+	}
+	assert.Nil(t, db.Connect())
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	// The same timeout block height is near on the chain of L-BTC but not on the one of the LND node
+	swaps := []database.Swap{
+		{Id: "btc", State: boltzrpc.SwapState_PENDING, TimoutBlockHeight: 1000, Node: "second"},
+		{Id: "liquid", State: boltzrpc.SwapState_PENDING, TimoutBlockHeight: 1000, Currency: "L-BTC", Node: "second"},
+		{Id: "first", State: boltzrpc.SwapState_PENDING, TimoutBlockHeight: 1000, Currency: "L-BTC"},
+	}
+
+	for _, swap := range swaps {
+		swap.PrivateKey = privateKey
+		assert.Nil(t, db.CreateSwap(swap))
+	}
+
+	router := &routedBoltzServer{
+		nodes: []*Node{{Name: "first", Symbol: "BTC"}, {Name: "second", Symbol: "BTC"}},
+	}
+
+	collector := &metricsCollector{
+		router:        router,
+		database:      db,
+		timeoutMargin: 10,
+	}
+
+	collect := func(symbol string, databaseCurrency string, blockHeight uint32) map[string]float64 {
+		ch := make(chan prometheus.Metric, 3)
+		collector.collectChain(ch, router.nodes[1], symbol, databaseCurrency, blockHeight)
+		close(ch)
+
+		values := make(map[string]float64)
+
+		for metric := range ch {
+			var written dto.Metric
+			assert.Nil(t, metric.Write(&written))
+
+			labels := make(map[string]string)
+
+			for _, label := range written.Label {
+				labels[label.GetName()] = label.GetValue()
+			}
+
+			assert.Equal(t, "second", labels["node"])
+			assert.Equal(t, symbol, labels["currency"])
+
+			if labels["type"] == "" {
+				values["height"] = written.Gauge.GetValue()
+			} else {
+				values[labels["type"]] = written.Gauge.GetValue()
+			}
+		}
+
+		return values
+	}
+
+	assert.Equal(t, map[string]float64{"height": 900, "submarine": 0, "reverse": 0}, collect("BTC", "", 900))
+	assert.Equal(t, map[string]float64{"height": 995, "submarine": 1, "reverse": 0}, collect("L-BTC", "L-BTC", 995))
+}
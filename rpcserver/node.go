@@ -0,0 +1,203 @@
+package rpcserver
+
+import (
+	"errors"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/chain"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/lnd"
+	"github.com/BoltzExchange/boltz-lnd/logger"
+	"github.com/BoltzExchange/boltz-lnd/metrics"
+	"github.com/BoltzExchange/boltz-lnd/nursery"
+	"github.com/BoltzExchange/boltz-lnd/utils"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/vulpemventures/go-elements/network"
+)
+
+// Node is an LND node through which swaps can be created. Every node has its own nursery and client of the Boltz API
+type Node struct {
+	Name string
+
+	Symbol      string
+	ChainParams *chaincfg.Params
+
+	// Onchain currencies of cross chain swaps
+	Currencies map[string]*chain.Currency
+
+	LND     *lnd.LND
+	Boltz   *boltz.Boltz
+	Nursery *nursery.Nursery
+}
+
+// getNode returns the node with the name. The node of the [LND] section is returned if the name is empty
+func (server *routedBoltzServer) getNode(name string) (*Node, error) {
+	if name == "" {
+		return server.nodes[0], nil
+	}
+
+	for _, node := range server.nodes {
+		if node.Name == name {
+			return node, nil
+		}
+	}
+
+	return nil, errors.New("could not find node " + name)
+}
+
+// getSwapNode returns the node with which a swap was created from the node name in the database
+func (server *routedBoltzServer) getSwapNode(databaseNode string) (*Node, error) {
+	if databaseNode == "" {
+		return server.nodes[0], nil
+	}
+
+	return server.getNode(databaseNode)
+}
+
+// Swaps of the node of the [LND] section are saved without a node name to stay compatible with databases that were
+// created before multiple nodes were supported
+func (server *routedBoltzServer) getDatabaseNode(node *Node) string {
+	if node == server.nodes[0] {
+		return ""
+	}
+
+	return node.Name
+}
+
+func (server *routedBoltzServer) getNodeName(databaseNode string) string {
+	if databaseNode == "" {
+		return server.nodes[0].Name
+	}
+
+	return databaseNode
+}
+
+func (node *Node) payInvoice(invoice string, id string, channelId uint64) (int64, error) {
+	var outgoingChannelIds []uint64
+
+	if channelId != 0 {
+		outgoingChannelIds = []uint64{channelId}
+	}
+
+	payment, err := node.LND.PayInvoice(invoice, 3, 30, outgoingChannelIds)
+
+	if err != nil {
+		return 0, err
+	}
+
+	logger.Info("Paid invoice of Reverse Swap " + id + " with fee of " + utils.FormatMilliSat(payment.FeeMsat) + " satoshis")
+	metrics.AddRoutingFee(payment.FeeMsat)
+
+	return payment.FeeMsat, nil
+}
+
+// Returns the chain params and current block height of the chain of the onchain currency of a Swap
+func (node *Node) getSwapChain(swap *database.Swap) (*chaincfg.Params, uint32, error) {
+	if swap.Currency == "" {
+		lndInfo, err := node.LND.GetInfo()
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return node.ChainParams, lndInfo.BlockHeight, nil
+	}
+
+	currency, hasCurrency := node.Currencies[swap.Currency]
+
+	if !hasCurrency {
+		return nil, 0, errors.New("no chain backend configured for " + swap.Currency)
+	}
+
+	blockHeight, err := currency.Backend.GetBlockHeight()
+
+	if err != nil {
+		return nil, 0, errors.New("could not get block height of " + swap.Currency + ": " + err.Error())
+	}
+
+	return currency.Params, blockHeight, nil
+}
+
+// Returns the chain params of the onchain currency of a swap. An empty currency is the one of the chain of LND
+func (node *Node) getChainParams(currency string) (*chaincfg.Params, error) {
+	if currency == "" {
+		return node.ChainParams, nil
+	}
+
+	chainCurrency, hasCurrency := node.Currencies[currency]
+
+	if !hasCurrency {
+		return nil, errors.New("no chain backend configured for " + currency)
+	}
+
+	return chainCurrency.Params, nil
+}
+
+func (node *Node) getCurrencySymbol(currency string) string {
+	if currency == "" {
+		return node.Symbol
+	}
+
+	return currency
+}
+
+// Returns the Liquid network of the onchain currency of a swap or nil if the swap is not on Liquid
+func (node *Node) getLiquidNetwork(currency string) *network.Network {
+	chainCurrency, hasCurrency := node.Currencies[currency]
+
+	if !hasCurrency {
+		return nil
+	}
+
+	return chainCurrency.LiquidNetwork
+}
+
+// Lockup addresses on Liquid are confidential and need the blinding key of Boltz to be verified
+func checkSwapAddress(
+	chainParams *chaincfg.Params,
+	liquidNetwork *network.Network,
+	swap *database.Swap,
+	response *boltz.CreateSwapResponse,
+) error {
+	// Taproot outputs could not be refunded, because they cannot be spent yet
+	if len(response.SwapTree) != 0 {
+		return boltz.ErrTaprootSwap
+	}
+
+	var err error
+
+	if liquidNetwork == nil {
+		swap.OutputType, err = boltz.FindSwapOutputType(chainParams, swap.Address, swap.RedeemScript)
+		return err
+	}
+
+	swap.BlindingKey, err = parseBlindingKey(response.BlindingKey)
+
+	if err != nil {
+		return err
+	}
+
+	swap.OutputType, err = boltz.FindLiquidSwapOutputType(liquidNetwork, swap.Address, swap.RedeemScript, swap.BlindingKey)
+	return err
+}
+
+// Checks that claim and refund addresses can be decoded and belong to the network of the onchain currency
+func checkAddress(chainParams *chaincfg.Params, liquidNetwork *network.Network, address string) error {
+	if liquidNetwork == nil {
+		decodedAddress, err := btcutil.DecodeAddress(address, chainParams)
+
+		if err != nil {
+			return err
+		}
+
+		if !decodedAddress.IsForNet(chainParams) {
+			return errors.New("address " + address + " is not for network " + chainParams.Name)
+		}
+
+		return nil
+	}
+
+	_, err := boltz.LiquidOutputScript(liquidNetwork, address)
+	return err
+}
@@ -0,0 +1,84 @@
+package rpcserver
+
+import (
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNode(t *testing.T) {
+	lndNode := &Node{Name: "lnd"}
+	secondNode := &Node{Name: "second"}
+
+	server := &routedBoltzServer{
+		nodes: []*Node{lndNode, secondNode},
+	}
+
+	node, err := server.getNode("")
+
+	assert.Nil(t, err)
+	assert.Equal(t, lndNode, node)
+
+	node, err = server.getNode("second")
+
+	assert.Nil(t, err)
+	assert.Equal(t, secondNode, node)
+
+	_, err = server.getNode("third")
+	assert.Equal(t, "could not find node third", err.Error())
+
+	// Swaps of the node of the [LND] section have no node name in the database
+	node, err = server.getSwapNode("")
+
+	assert.Nil(t, err)
+	assert.Equal(t, lndNode, node)
+
+	assert.Equal(t, "", server.getDatabaseNode(lndNode))
+	assert.Equal(t, "second", server.getDatabaseNode(secondNode))
+
+	assert.Equal(t, "lnd", server.getNodeName(""))
+	assert.Equal(t, "second", server.getNodeName("second"))
+
+	assert.True(t, server.isRequestedNode("", "second"))
+	assert.True(t, server.isRequestedNode("lnd", ""))
+	assert.False(t, server.isRequestedNode("lnd", "second"))
+
+	nodes, err := server.filterNodes("")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*Node{lndNode, secondNode}, nodes)
+
+	nodes, err = server.filterNodes("second")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*Node{secondNode}, nodes)
+}
+
+func TestCheckSwapAddress(t *testing.T) {
+	node := &Node{
+		Symbol:      "BTC",
+		ChainParams: &chaincfg.RegressionNetParams,
+	}
+
+	redeemScript := []byte{txscript.OP_TRUE}
+	address, err := boltz.WitnessScriptHashAddress(node.ChainParams, redeemScript)
+	assert.Nil(t, err)
+
+	swap := &database.Swap{
+		Address:      address,
+		RedeemScript: redeemScript,
+		OutputType:   boltz.Compatibility,
+	}
+
+	assert.Nil(t, checkSwapAddress(node.ChainParams, nil, swap, &boltz.CreateSwapResponse{}))
+	assert.Equal(t, boltz.SegWit, swap.OutputType)
+
+	// Taproot swaps are rejected before they are saved, because their outputs could not be refunded
+	assert.Equal(t, boltz.ErrTaprootSwap, checkSwapAddress(node.ChainParams, nil, swap, &boltz.CreateSwapResponse{
+		SwapTree: []byte(`{"claimLeaf":{"version":192,"output":"a914"}}`),
+	}))
+}
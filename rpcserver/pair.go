@@ -0,0 +1,221 @@
+package rpcserver
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/chain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/vulpemventures/go-elements/network"
+)
+
+// Share by which the rate of a cross chain pair can change between querying the pairs and creating a swap
+const rateTolerance = 0.01
+
+// swapPair is the pair of the Boltz API between the onchain currency of a swap and the Lightning currency of LND
+type swapPair struct {
+	id string
+
+	// Nil when the swap is on the chain of the LND node
+	currency *chain.Currency
+
+	// Whether the onchain currency is the base currency of the pair
+	onchainIsBase bool
+}
+
+type pairInfo struct {
+	fees   *boltzrpc.Fees
+	limits *boltzrpc.Limits
+
+	// Price of the base currency in the quote currency
+	rate float64
+
+	// Part of the reverse miner fee that Boltz charges for its lockup transaction. The rest is its estimation of the
+	// claim transaction
+	reverseLockupFee uint64
+}
+
+func (node *Node) parsePair(pairId string) (*swapPair, error) {
+	if pairId == "" {
+		pairId = node.Symbol + "/" + node.Symbol
+	}
+
+	split := strings.Split(pairId, "/")
+
+	if len(split) != 2 {
+		return nil, errors.New("invalid pair " + pairId)
+	}
+
+	base, quote := split[0], split[1]
+	pair := &swapPair{
+		id: pairId,
+	}
+
+	var symbol string
+
+	switch node.Symbol {
+	case base:
+		if quote == node.Symbol {
+			return pair, nil
+		}
+
+		symbol = quote
+
+	case quote:
+		symbol = base
+		pair.onchainIsBase = true
+
+	default:
+		return nil, errors.New("pair " + pairId + " does not include the currency " + node.Symbol + " of LND")
+	}
+
+	currency, hasCurrency := node.Currencies[symbol]
+
+	if !hasCurrency {
+		return nil, errors.New("no chain backend configured for " + symbol)
+	}
+
+	pair.currency = currency
+
+	return pair, nil
+}
+
+func (node *Node) getPairInfo(pair *swapPair) (*pairInfo, error) {
+	pairsResponse, err := node.Boltz.GetPairs()
+
+	if err != nil {
+		return nil, err
+	}
+
+	boltzPair, hasPair := pairsResponse.Pairs[pair.id]
+
+	if !hasPair {
+		return nil, errors.New("could not find pair with symbol " + pair.id)
+	}
+
+	minerFees := boltzPair.Fees.MinerFees.BaseAsset
+
+	if pair.currency != nil && !pair.onchainIsBase {
+		minerFees = boltzPair.Fees.MinerFees.QuoteAsset
+	}
+
+	return &pairInfo{
+		fees: &boltzrpc.Fees{
+			Percentage: boltzPair.Fees.Percentage,
+			Miner: &boltzrpc.MinerFees{
+				Normal:  uint32(minerFees.Normal),
+				Reverse: uint32(minerFees.Reverse.Lockup + minerFees.Reverse.Claim),
+			},
+		},
+		limits: &boltzrpc.Limits{
+			Minimal: int64(boltzPair.Limits.Minimal),
+			Maximal: int64(boltzPair.Limits.Maximal),
+		},
+		rate:             float64(boltzPair.Rate),
+		reverseLockupFee: minerFees.Reverse.Lockup,
+	}, nil
+}
+
+// Symbol of the onchain currency that is saved in the database
+func (pair *swapPair) currencySymbol() string {
+	if pair.currency == nil {
+		return ""
+	}
+
+	return pair.currency.Symbol
+}
+
+func (pair *swapPair) liquidNetwork() *network.Network {
+	if pair.currency == nil {
+		return nil
+	}
+
+	return pair.currency.LiquidNetwork
+}
+
+func (pair *swapPair) chainParams(lndChainParams *chaincfg.Params) *chaincfg.Params {
+	if pair.currency == nil {
+		return lndChainParams
+	}
+
+	return pair.currency.Params
+}
+
+// Boltz infers the onchain currency of a swap from the order side. Swaps sell the onchain currency for the Lightning
+// one and Reverse Swaps the other way around
+func (pair *swapPair) orderSide(isReverse bool) string {
+	if pair.currency != nil && pair.onchainIsBase != isReverse {
+		return "sell"
+	}
+
+	return "buy"
+}
+
+// Converts an amount of the Lightning currency to the onchain currency
+func (pair *swapPair) toOnchainAmount(amount uint64, rate float64) float64 {
+	if pair.currency == nil {
+		return float64(amount)
+	}
+
+	if pair.onchainIsBase {
+		return float64(amount) / rate
+	}
+
+	return float64(amount) * rate
+}
+
+// Converts an amount of the onchain currency to the Lightning currency
+func (pair *swapPair) toLightningAmount(amount float64, rate float64) float64 {
+	if pair.currency == nil {
+		return amount
+	}
+
+	if pair.onchainIsBase {
+		return amount * rate
+	}
+
+	return amount / rate
+}
+
+func (pair *swapPair) rateTolerance() float64 {
+	if pair.currency == nil {
+		return 0
+	}
+
+	return rateTolerance
+}
+
+// Checks that Boltz does not ask for more onchain coins than the invoice is worth plus fees
+func checkSwapAmount(pair *swapPair, info *pairInfo, invoiceAmount uint64, expectedAmount uint64) error {
+	maxAmount := pair.toOnchainAmount(invoiceAmount, info.rate) *
+		(1 + float64(info.fees.Percentage)/100) *
+		(1 + pair.rateTolerance())
+
+	maxExpectedAmount := uint64(math.Ceil(maxAmount)) + uint64(info.fees.Miner.Normal)
+
+	if expectedAmount > maxExpectedAmount {
+		return errors.New("expected amount of " + strconv.FormatUint(expectedAmount, 10) +
+			" is more than the maximal " + strconv.FormatUint(maxExpectedAmount, 10))
+	}
+
+	return nil
+}
+
+// Checks that Boltz does not lock up fewer onchain coins than the invoice is worth minus fees
+func checkReverseSwapAmount(pair *swapPair, info *pairInfo, invoiceAmount uint64, onchainAmount uint64) error {
+	minAmount := pair.toOnchainAmount(invoiceAmount, info.rate) *
+		(1 - float64(info.fees.Percentage)/100) *
+		(1 - pair.rateTolerance())
+
+	minOnchainAmount := int64(math.Floor(minAmount)) - int64(info.fees.Miner.Reverse)
+
+	if int64(onchainAmount) < minOnchainAmount {
+		return errors.New("onchain amount of " + strconv.FormatUint(onchainAmount, 10) +
+			" is less than the minimal " + strconv.FormatInt(minOnchainAmount, 10))
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package rpcserver
+
+import (
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/chain"
+	"github.com/stretchr/testify/assert"
+	"github.com/vulpemventures/go-elements/network"
+)
+
+func TestParsePair(t *testing.T) {
+	litecoin := &chain.Currency{Symbol: "LTC"}
+	node := &Node{
+		Symbol: "BTC",
+		Currencies: map[string]*chain.Currency{
+			"LTC": litecoin,
+		},
+	}
+
+	pair, err := node.parsePair("")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "BTC/BTC", pair.id)
+	assert.Nil(t, pair.currency)
+	assert.Equal(t, "", pair.currencySymbol())
+	assert.Equal(t, "buy", pair.orderSide(false))
+	assert.Equal(t, "buy", pair.orderSide(true))
+
+	pair, err = node.parsePair("LTC/BTC")
+
+	assert.Nil(t, err)
+	assert.Equal(t, litecoin, pair.currency)
+	assert.True(t, pair.onchainIsBase)
+	assert.Equal(t, "LTC", pair.currencySymbol())
+	assert.Equal(t, "sell", pair.orderSide(false))
+	assert.Equal(t, "buy", pair.orderSide(true))
+
+	pair, err = node.parsePair("BTC/LTC")
+
+	assert.Nil(t, err)
+	assert.False(t, pair.onchainIsBase)
+	assert.Equal(t, "buy", pair.orderSide(false))
+	assert.Equal(t, "sell", pair.orderSide(true))
+
+	assert.Nil(t, pair.liquidNetwork())
+	assert.Equal(t, uint64(refundTransactionVsize), pair.transactionVsize(refundTransactionVsize))
+
+	// Transactions on Liquid are bigger because of the proofs of their confidential outputs
+	node.Currencies["L-BTC"] = &chain.Currency{Symbol: "L-BTC", LiquidNetwork: &network.Regtest}
+	pair, err = node.parsePair("L-BTC/BTC")
+
+	assert.Nil(t, err)
+	assert.True(t, pair.onchainIsBase)
+	assert.Equal(t, &network.Regtest, pair.liquidNetwork())
+	assert.Equal(t, uint64(liquidTransactionVsize), pair.transactionVsize(refundTransactionVsize))
+
+	_, err = node.parsePair("LTC")
+	assert.Equal(t, "invalid pair LTC", err.Error())
+
+	_, err = node.parsePair("LTC/LBTC")
+	assert.Equal(t, "pair LTC/LBTC does not include the currency BTC of LND", err.Error())
+
+	_, err = node.parsePair("BTC/DOGE")
+	assert.Equal(t, "no chain backend configured for DOGE", err.Error())
+}
+
+func TestCheckSwapAmount(t *testing.T) {
+	info := &pairInfo{
+		fees: &boltzrpc.Fees{
+			Percentage: 1,
+			Miner: &boltzrpc.MinerFees{
+				Normal:  500,
+				Reverse: 700,
+			},
+		},
+		rate: 1,
+	}
+
+	pair := &swapPair{id: "BTC/BTC"}
+
+	assert.Nil(t, checkSwapAmount(pair, info, 100000, 101500))
+	assert.Equal(t, "expected amount of 101501 is more than the maximal 101500", checkSwapAmount(pair, info, 100000, 101501).Error())
+
+	assert.Nil(t, checkReverseSwapAmount(pair, info, 100000, 98300))
+	assert.Equal(t, "onchain amount of 98299 is less than the minimal 98300", checkReverseSwapAmount(pair, info, 100000, 98299).Error())
+
+	// 1 LTC is worth 0.005 BTC and the onchain amount is in LTC
+	info.rate = 0.005
+	pair = &swapPair{id: "LTC/BTC", currency: &chain.Currency{Symbol: "LTC"}, onchainIsBase: true}
+
+	assert.Nil(t, checkSwapAmount(pair, info, 100000, 20402500))
+	assert.NotNil(t, checkSwapAmount(pair, info, 100000, 20402501))
+
+	assert.Nil(t, checkReverseSwapAmount(pair, info, 100000, 19601300))
+	assert.NotNil(t, checkReverseSwapAmount(pair, info, 100000, 19601299))
+
+	// 1 BTC is worth 200 LTC and the onchain amount is in the quote currency LTC
+	info.rate = 200
+	pair = &swapPair{id: "BTC/LTC", currency: &chain.Currency{Symbol: "LTC"}, onchainIsBase: false}
+
+	assert.Nil(t, checkSwapAmount(pair, info, 100000, 20402500))
+	assert.NotNil(t, checkSwapAmount(pair, info, 100000, 20402501))
+}
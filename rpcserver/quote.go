@@ -0,0 +1,148 @@
+package rpcserver
+
+import (
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+)
+
+// Approximate virtual sizes of claim and refund transactions that spend a single lockup output to a single address
+const (
+	claimTransactionVsize  = 140
+	refundTransactionVsize = 130
+
+	// The range and surjection proofs of confidential outputs make transactions on Liquid a lot bigger
+	liquidTransactionVsize = 1300
+)
+
+// Rounding of the fees is compensated for by adjusting the invoice amount at most this number of times
+const maxQuoteAdjustments = 100
+
+var errAmountTooSmall = errors.New("amount is too small to cover the fees")
+
+type quote struct {
+	invoiceAmount uint64
+
+	sendAmount    uint64
+	receiveAmount uint64
+
+	percentageFee uint64
+	boltzMinerFee uint64
+	minerFee      uint64
+}
+
+// Calculates the onchain amount Boltz expects for the invoice of a Swap. If the onchain amount that is sent is quoted,
+// the largest invoice that can be paid with it is used
+func quoteSwap(pair *swapPair, info *pairInfo, amount uint64, direction boltzrpc.QuoteDirection, refundFee uint64) (*quote, error) {
+	minerFee := uint64(info.fees.Miner.Normal)
+
+	calculate := func(invoiceAmount uint64) *quote {
+		onchainAmount := pair.toOnchainAmount(invoiceAmount, info.rate)
+		percentageFee := uint64(math.Ceil(onchainAmount * float64(info.fees.Percentage) / 100))
+
+		return &quote{
+			invoiceAmount: invoiceAmount,
+			sendAmount:    uint64(math.Ceil(onchainAmount)) + percentageFee + minerFee,
+			receiveAmount: invoiceAmount,
+			percentageFee: percentageFee,
+			boltzMinerFee: minerFee,
+			minerFee:      refundFee,
+		}
+	}
+
+	if direction == boltzrpc.QuoteDirection_RECEIVE {
+		return calculate(amount), nil
+	}
+
+	if amount <= minerFee {
+		return nil, errAmountTooSmall
+	}
+
+	onchainAmount := float64(amount-minerFee) / (1 + float64(info.fees.Percentage)/100)
+	swapQuote := calculate(uint64(math.Floor(pair.toLightningAmount(onchainAmount, info.rate))))
+
+	// Rounding up the fees can make the amount exceed the one that should be sent by a few satoshis
+	for i := 0; i < maxQuoteAdjustments && swapQuote.invoiceAmount > 0; i++ {
+		if swapQuote.sendAmount <= amount {
+			return swapQuote, nil
+		}
+
+		swapQuote = calculate(swapQuote.invoiceAmount - 1)
+	}
+
+	return nil, errAmountTooSmall
+}
+
+// Calculates the onchain amount Boltz locks up for the invoice of a Reverse Swap and what is left of it after it was
+// claimed. If the amount that is received is quoted, the smallest invoice that yields it is used
+func quoteReverseSwap(pair *swapPair, info *pairInfo, amount uint64, direction boltzrpc.QuoteDirection, claimFee uint64) (*quote, error) {
+	calculate := func(invoiceAmount uint64) (*quote, error) {
+		onchainAmount := pair.toOnchainAmount(invoiceAmount, info.rate)
+		percentageFee := uint64(math.Ceil(onchainAmount * float64(info.fees.Percentage) / 100))
+
+		fees := percentageFee + info.reverseLockupFee + claimFee
+
+		if uint64(math.Floor(onchainAmount)) <= fees {
+			return nil, errAmountTooSmall
+		}
+
+		return &quote{
+			invoiceAmount: invoiceAmount,
+			sendAmount:    invoiceAmount,
+			receiveAmount: uint64(math.Floor(onchainAmount)) - fees,
+			percentageFee: percentageFee,
+			boltzMinerFee: info.reverseLockupFee,
+			minerFee:      claimFee,
+		}, nil
+	}
+
+	if direction == boltzrpc.QuoteDirection_SEND {
+		return calculate(amount)
+	}
+
+	if info.fees.Percentage >= 100 {
+		return nil, errors.New("percentage fee of " + strconv.FormatFloat(float64(info.fees.Percentage), 'f', -1, 32) + "% is too high")
+	}
+
+	onchainAmount := float64(amount+info.reverseLockupFee+claimFee) / (1 - float64(info.fees.Percentage)/100)
+	invoiceAmount := uint64(math.Floor(pair.toLightningAmount(onchainAmount, info.rate)))
+
+	// Rounding the fees can leave the received amount a few satoshis short
+	for i := 0; i < maxQuoteAdjustments; i++ {
+		reverseQuote, err := calculate(invoiceAmount)
+
+		if err == nil && reverseQuote.receiveAmount >= amount {
+			return reverseQuote, nil
+		}
+
+		invoiceAmount++
+	}
+
+	return nil, errors.New("could not find an invoice amount that yields " + strconv.FormatUint(amount, 10))
+}
+
+func checkQuoteLimits(swapQuote *quote, limits *boltzrpc.Limits) error {
+	invoiceAmount := int64(swapQuote.invoiceAmount)
+
+	if invoiceAmount < limits.Minimal {
+		return errors.New("invoice amount of " + strconv.FormatInt(invoiceAmount, 10) + " is less than the minimal " +
+			strconv.FormatInt(limits.Minimal, 10))
+	}
+
+	if invoiceAmount > limits.Maximal {
+		return errors.New("invoice amount of " + strconv.FormatInt(invoiceAmount, 10) + " is more than the maximal " +
+			strconv.FormatInt(limits.Maximal, 10))
+	}
+
+	return nil
+}
+
+func (pair *swapPair) transactionVsize(vsize uint64) uint64 {
+	if pair.liquidNetwork() != nil {
+		return liquidTransactionVsize
+	}
+
+	return vsize
+}
@@ -0,0 +1,116 @@
+package rpcserver
+
+import (
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/chain"
+	"github.com/stretchr/testify/assert"
+)
+
+func getQuotePairInfo(rate float64) *pairInfo {
+	return &pairInfo{
+		fees: &boltzrpc.Fees{
+			Percentage: 1,
+			Miner: &boltzrpc.MinerFees{
+				Normal:  500,
+				Reverse: 700,
+			},
+		},
+		limits: &boltzrpc.Limits{
+			Minimal: 10000,
+			Maximal: 1000000,
+		},
+		rate:             rate,
+		reverseLockupFee: 300,
+	}
+}
+
+func TestQuoteSwap(t *testing.T) {
+	info := getQuotePairInfo(1)
+	pair := &swapPair{id: "BTC/BTC"}
+
+	swapQuote, err := quoteSwap(pair, info, 100000, boltzrpc.QuoteDirection_RECEIVE, 260)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &quote{
+		invoiceAmount: 100000,
+		sendAmount:    101500,
+		receiveAmount: 100000,
+		percentageFee: 1000,
+		boltzMinerFee: 500,
+		minerFee:      260,
+	}, swapQuote)
+
+	swapQuote, err = quoteSwap(pair, info, 101500, boltzrpc.QuoteDirection_SEND, 260)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(100000), swapQuote.invoiceAmount)
+	assert.Equal(t, uint64(101500), swapQuote.sendAmount)
+
+	// The rounded up percentage fee has to fit into the amount that is sent
+	swapQuote, err = quoteSwap(pair, info, 101499, boltzrpc.QuoteDirection_SEND, 260)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(99999), swapQuote.invoiceAmount)
+	assert.LessOrEqual(t, swapQuote.sendAmount, uint64(101499))
+
+	_, err = quoteSwap(pair, info, 500, boltzrpc.QuoteDirection_SEND, 260)
+	assert.Equal(t, errAmountTooSmall, err)
+}
+
+func TestQuoteReverseSwap(t *testing.T) {
+	info := getQuotePairInfo(1)
+	pair := &swapPair{id: "BTC/BTC"}
+
+	reverseQuote, err := quoteReverseSwap(pair, info, 100000, boltzrpc.QuoteDirection_SEND, 280)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &quote{
+		invoiceAmount: 100000,
+		sendAmount:    100000,
+		receiveAmount: 98420,
+		percentageFee: 1000,
+		boltzMinerFee: 300,
+		minerFee:      280,
+	}, reverseQuote)
+
+	reverseQuote, err = quoteReverseSwap(pair, info, 98420, boltzrpc.QuoteDirection_RECEIVE, 280)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(100000), reverseQuote.invoiceAmount)
+
+	// The percentage fee of the next satoshi is rounded up which is why two more have to be paid
+	reverseQuote, err = quoteReverseSwap(pair, info, 98421, boltzrpc.QuoteDirection_RECEIVE, 280)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(100002), reverseQuote.invoiceAmount)
+	assert.GreaterOrEqual(t, reverseQuote.receiveAmount, uint64(98421))
+
+	_, err = quoteReverseSwap(pair, info, 500, boltzrpc.QuoteDirection_SEND, 280)
+	assert.Equal(t, errAmountTooSmall, err)
+
+	// 1 LTC is worth 0.005 BTC and the onchain amount is in LTC
+	info = getQuotePairInfo(0.005)
+	pair = &swapPair{id: "LTC/BTC", currency: &chain.Currency{Symbol: "LTC"}, onchainIsBase: true}
+
+	reverseQuote, err = quoteReverseSwap(pair, info, 100000, boltzrpc.QuoteDirection_SEND, 280)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(19799420), reverseQuote.receiveAmount)
+
+	reverseQuote, err = quoteReverseSwap(pair, info, 19799420, boltzrpc.QuoteDirection_RECEIVE, 280)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(100000), reverseQuote.invoiceAmount)
+}
+
+func TestCheckQuoteLimits(t *testing.T) {
+	limits := getQuotePairInfo(1).limits
+
+	assert.Nil(t, checkQuoteLimits(&quote{invoiceAmount: 10000}, limits))
+	assert.Nil(t, checkQuoteLimits(&quote{invoiceAmount: 1000000}, limits))
+
+	assert.Equal(t, "invoice amount of 9999 is less than the minimal 10000", checkQuoteLimits(&quote{invoiceAmount: 9999}, limits).Error())
+	assert.Equal(t, "invoice amount of 1000001 is more than the maximal 1000000", checkQuoteLimits(&quote{invoiceAmount: 1000001}, limits).Error())
+}
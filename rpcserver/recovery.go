@@ -0,0 +1,427 @@
+package rpcserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"strconv"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/keys"
+	"github.com/BoltzExchange/boltz-lnd/logger"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/input"
+)
+
+// Every index of the range is derived before the swaps are matched, so the range is limited to keep that quick
+const maxRecoveryRange = 100000
+
+var errNoMatchingKey = errors.New("none of the keys of the index range is in the redeem script")
+
+// Keys and preimage that were derived from the seed for an index or imported from a rescue file
+type recoveryKey struct {
+	// -1 for imported keys
+	index int64
+
+	privateKey *btcec.PrivateKey
+	publicKey  []byte
+
+	preimage []byte
+	// RIPEMD160 of the SHA256 hash of the preimage as it is used in redeem scripts
+	preimageHash160 []byte
+}
+
+func (server *routedBoltzServer) RecoverSwaps(_ context.Context, request *boltzrpc.RecoverSwapsRequest) (*boltzrpc.RecoverSwapsResponse, error) {
+	if request.FromIndex > request.ToIndex {
+		return nil, handleError(errors.New("first index cannot be larger than the last one"))
+	}
+
+	if request.ToIndex-request.FromIndex >= maxRecoveryRange {
+		return nil, handleError(errors.New("index range cannot be larger than " + strconv.Itoa(maxRecoveryRange)))
+	}
+
+	if request.ToIndex > keys.MaxIndex {
+		return nil, handleError(errors.New("last index cannot be larger than " + strconv.FormatUint(keys.MaxIndex, 10)))
+	}
+
+	node, err := server.getNode(request.Node)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	logger.Info("Recovering " + strconv.Itoa(len(request.Swaps)) + " swaps with the keys of index " +
+		strconv.FormatUint(uint64(request.FromIndex), 10) + " to " + strconv.FormatUint(uint64(request.ToIndex), 10))
+
+	recoveryKeys, err := server.deriveRecoveryKeys(request.FromIndex, request.ToIndex)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	response := &boltzrpc.RecoverSwapsResponse{}
+
+	for _, lostSwap := range request.Swaps {
+		recoveredSwap := &boltzrpc.RecoveredSwap{
+			Id: lostSwap.Id,
+		}
+
+		err := server.recoverSwap(node, recoveryKeys, lostSwap, recoveredSwap)
+
+		if err != nil {
+			logger.Warning("Could not recover swap " + lostSwap.Id + ": " + err.Error())
+			recoveredSwap.Error = err.Error()
+		}
+
+		response.Swaps = append(response.Swaps, recoveredSwap)
+	}
+
+	// The key index counter of a seed that was imported into a new database starts from zero again
+	err = server.database.ReserveKeyIndexes(request.ToIndex)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return response, nil
+}
+
+func (server *routedBoltzServer) ExportSeed(_ context.Context, _ *boltzrpc.ExportSeedRequest) (*boltzrpc.ExportSeedResponse, error) {
+	logger.Info("Exported mnemonic of the seed")
+
+	return &boltzrpc.ExportSeedResponse{
+		Mnemonic: server.seed.Mnemonic(),
+	}, nil
+}
+
+func (server *routedBoltzServer) deriveRecoveryKeys(fromIndex uint32, toIndex uint32) ([]recoveryKey, error) {
+	var recoveryKeys []recoveryKey
+
+	for index := fromIndex; ; index++ {
+		privateKey, err := server.seed.DeriveKey(index)
+
+		if err != nil {
+			return nil, err
+		}
+
+		preimage, preimageHash, err := server.newPreimage(index)
+
+		if err != nil {
+			return nil, err
+		}
+
+		recoveryKeys = append(recoveryKeys, recoveryKey{
+			index:           int64(index),
+			privateKey:      privateKey,
+			publicKey:       privateKey.PubKey().SerializeCompressed(),
+			preimage:        preimage,
+			preimageHash160: input.Ripemd160H(preimageHash),
+		})
+
+		// Checked at the end of the loop to not overflow when the range ends at the largest index
+		if index == toIndex {
+			return recoveryKeys, nil
+		}
+	}
+}
+
+func (key *recoveryKey) setKeyIndex(recoveredSwap *boltzrpc.RecoveredSwap) {
+	if key.index >= 0 {
+		recoveredSwap.KeyIndex = uint32(key.index)
+	}
+}
+
+func findRecoveryKey(recoveryKeys []recoveryKey, publicKey *btcec.PublicKey) *recoveryKey {
+	serializedKey := publicKey.SerializeCompressed()
+
+	for i := range recoveryKeys {
+		if bytes.Equal(recoveryKeys[i].publicKey, serializedKey) {
+			return &recoveryKeys[i]
+		}
+	}
+
+	return nil
+}
+
+func (server *routedBoltzServer) recoverSwap(node *Node, recoveryKeys []recoveryKey, lostSwap *boltzrpc.LostSwap, recoveredSwap *boltzrpc.RecoveredSwap) error {
+	redeemScript, err := hex.DecodeString(lostSwap.RedeemScript)
+
+	if err != nil {
+		return errors.New("could not decode redeem script: " + err.Error())
+	}
+
+	pair, err := node.parsePair(lostSwap.PairId)
+
+	if err != nil {
+		return err
+	}
+
+	if pair.liquidNetwork() != nil {
+		return errors.New("swaps on Liquid cannot be recovered because their blinding keys are not known")
+	}
+
+	if pair.currency != nil && lostSwap.Address == "" {
+		return errors.New("an address is required for cross chain swaps")
+	}
+
+	if lostSwap.Address != "" {
+		err = checkAddress(pair.chainParams(node.ChainParams), nil, lostSwap.Address)
+
+		if err != nil {
+			return errors.New("invalid address: " + err.Error())
+		}
+	}
+
+	if _, err := server.database.QuerySwap(lostSwap.Id); err == nil {
+		return errors.New("Swap " + lostSwap.Id + " exists already")
+	}
+
+	if _, err := server.database.QueryReverseSwap(lostSwap.Id); err == nil {
+		return errors.New("Reverse Swap " + lostSwap.Id + " exists already")
+	}
+
+	status, err := node.Boltz.SwapStatus(lostSwap.Id)
+
+	if err != nil {
+		return errors.New("could not get status from Boltz: " + err.Error())
+	}
+
+	recoveredSwap.Status = status.Status
+
+	if values, err := boltz.ParseSwapScript(redeemScript); err == nil {
+		recoveredSwap.Type = boltzrpc.SwapType_SUBMARINE
+		return server.recoverSubmarineSwap(node, pair, recoveryKeys, lostSwap, redeemScript, values, status, recoveredSwap)
+	}
+
+	if values, err := boltz.ParseReverseSwapScript(redeemScript); err == nil {
+		recoveredSwap.Type = boltzrpc.SwapType_REVERSE_SUBMARINE
+		return server.recoverReverseSwap(node, pair, recoveryKeys, lostSwap, redeemScript, values, status, recoveredSwap)
+	}
+
+	return errors.New("redeem script is neither the one of a Swap nor the one of a Reverse Swap")
+}
+
+func (server *routedBoltzServer) recoverSubmarineSwap(
+	node *Node,
+	pair *swapPair,
+	recoveryKeys []recoveryKey,
+	lostSwap *boltzrpc.LostSwap,
+	redeemScript []byte,
+	values *boltz.RedeemScriptValues,
+	status *boltz.SwapStatusResponse,
+	recoveredSwap *boltzrpc.RecoveredSwap,
+) error {
+	key := findRecoveryKey(recoveryKeys, values.RefundPublicKey)
+
+	if key == nil {
+		return errNoMatchingKey
+	}
+
+	key.setKeyIndex(recoveredSwap)
+
+	parsedStatus := boltz.ParseEvent(status.Status)
+
+	if parsedStatus == boltz.TransactionClaimed {
+		return errors.New("Boltz claimed the Swap already")
+	}
+
+	swap := database.Swap{
+		Id:                  lostSwap.Id,
+		State:               boltzrpc.SwapState_PENDING,
+		Status:              parsedStatus,
+		PrivateKey:          key.privateKey,
+		RedeemScript:        redeemScript,
+		TimoutBlockHeight:   values.TimeoutBlockHeight,
+		LockupTransactionId: status.Transaction.Id,
+		RefundAddress:       lostSwap.Address,
+		Currency:            pair.currencySymbol(),
+		Node:                server.getDatabaseNode(node),
+		KeyIndex:            key.index,
+	}
+
+	// Only Deposits and Channel Creations were created with a preimage of the daemon
+	if bytes.Equal(key.preimageHash160, values.PreimageHash160) {
+		swap.Preimage = key.preimage
+	}
+
+	chainParams := pair.chainParams(node.ChainParams)
+
+	// Boltz knows the lockup transaction of the Swap if it was sent already
+	var lockupTransaction *btcutil.Tx
+
+	swapTransaction, err := node.Boltz.GetSwapTransaction(swap.Id)
+
+	if err == nil {
+		lockupTransaction, err = parseLockupTransaction(swapTransaction.TransactionHex)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	swap.Address, swap.OutputType, swap.ExpectedAmount, err = findRecoveredLockupOutput(chainParams, redeemScript, lockupTransaction)
+
+	if err != nil {
+		return err
+	}
+
+	if lockupTransaction != nil {
+		swap.LockupTransactionId = lockupTransaction.Hash().String()
+	}
+
+	err = server.database.CreateSwap(swap)
+
+	if err != nil {
+		return err
+	}
+
+	server.createLedgerEntry(node, pair, swap.Id, database.LedgerEntry{
+		Type:       database.SubmarineSwap,
+		AmountSent: swap.ExpectedAmount,
+	})
+
+	node.Nursery.RegisterSwap(&swap, nil)
+
+	logger.Info("Recovered Swap " + swap.Id + ": " + marshalJson(swap.Serialize()))
+
+	return nil
+}
+
+func (server *routedBoltzServer) recoverReverseSwap(
+	node *Node,
+	pair *swapPair,
+	recoveryKeys []recoveryKey,
+	lostSwap *boltzrpc.LostSwap,
+	redeemScript []byte,
+	values *boltz.RedeemScriptValues,
+	status *boltz.SwapStatusResponse,
+	recoveredSwap *boltzrpc.RecoveredSwap,
+) error {
+	key := findRecoveryKey(recoveryKeys, values.ClaimPublicKey)
+
+	if key == nil {
+		return errNoMatchingKey
+	}
+
+	key.setKeyIndex(recoveredSwap)
+
+	if !bytes.Equal(key.preimageHash160, values.PreimageHash160) {
+		return errors.New("preimage is not the one of the redeem script")
+	}
+
+	parsedStatus := boltz.ParseEvent(status.Status)
+
+	if parsedStatus.IsCompletedStatus() || parsedStatus.IsFailedStatus() {
+		return errors.New("Reverse Swap cannot be claimed anymore because its status is " + status.Status)
+	}
+
+	claimAddress := lostSwap.Address
+
+	if claimAddress == "" {
+		var err error
+		claimAddress, err = node.LND.NewAddress()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	// The status is set to the initial one, so that the nursery claims the lockup transaction when it gets the
+	// current status from Boltz
+	reverseSwap := database.ReverseSwap{
+		Id:                 lostSwap.Id,
+		State:              boltzrpc.SwapState_PENDING,
+		Status:             boltz.SwapCreated,
+		PrivateKey:         key.privateKey,
+		Preimage:           key.preimage,
+		RedeemScript:       redeemScript,
+		ClaimAddress:       claimAddress,
+		TimeoutBlockHeight: values.TimeoutBlockHeight,
+		Currency:           pair.currencySymbol(),
+		Node:               server.getDatabaseNode(node),
+		KeyIndex:           key.index,
+	}
+
+	if status.Transaction.Hex != "" {
+		lockupTransaction, err := parseLockupTransaction(status.Transaction.Hex)
+
+		if err != nil {
+			return err
+		}
+
+		_, _, reverseSwap.OnchainAmount, err = findRecoveredLockupOutput(pair.chainParams(node.ChainParams), redeemScript, lockupTransaction)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	err := server.database.CreateReverseSwap(reverseSwap)
+
+	if err != nil {
+		return err
+	}
+
+	server.createLedgerEntry(node, pair, reverseSwap.Id, database.LedgerEntry{
+		Type:           database.ReverseSubmarineSwap,
+		AmountReceived: reverseSwap.OnchainAmount,
+	})
+
+	node.Nursery.RegisterReverseSwap(reverseSwap, nil)
+
+	logger.Info("Recovered Reverse Swap " + reverseSwap.Id + ": " + marshalJson(reverseSwap.Serialize()))
+
+	return nil
+}
+
+func parseLockupTransaction(transactionHex string) (*btcutil.Tx, error) {
+	transactionRaw, err := hex.DecodeString(transactionHex)
+
+	if err != nil {
+		return nil, errors.New("could not decode lockup transaction: " + err.Error())
+	}
+
+	transaction, err := btcutil.NewTxFromBytes(transactionRaw)
+
+	if err != nil {
+		return nil, errors.New("could not parse lockup transaction: " + err.Error())
+	}
+
+	return transaction, nil
+}
+
+// The lockup address is not known anymore, but it is either the native or the nested SegWit address of the redeem
+// script. Which one it is, and the amount that was locked up, is read from the lockup transaction. Swaps without one
+// are assumed to use the native address
+func findRecoveredLockupOutput(chainParams *chaincfg.Params, redeemScript []byte, lockupTransaction *btcutil.Tx) (string, boltz.OutputType, uint64, error) {
+	if lockupTransaction == nil {
+		segWitAddress, err := boltz.WitnessScriptHashAddress(chainParams, redeemScript)
+		return segWitAddress, boltz.SegWit, 0, err
+	}
+
+	outputs := lockupTransaction.MsgTx().TxOut
+	vout, outputType, err := boltz.FindLockupOutput(chainParams, redeemScript, outputs)
+
+	if err != nil {
+		return "", 0, 0, errors.New("could not find lockup output in transaction " + lockupTransaction.Hash().String())
+	}
+
+	var address string
+
+	if outputType == boltz.Compatibility {
+		address, err = boltz.NestedScriptHashAddress(chainParams, redeemScript)
+	} else {
+		address, err = boltz.WitnessScriptHashAddress(chainParams, redeemScript)
+	}
+
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	return address, outputType, uint64(outputs[vout].Value), nil
+}
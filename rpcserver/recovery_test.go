@@ -0,0 +1,118 @@
+package rpcserver
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/keys"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRecoveryServer(t *testing.T) *routedBoltzServer {
+	seed, err := keys.NewSeed()
+	assert.Nil(t, err)
+
+	return &routedBoltzServer{
+		nodes: []*Node{{Name: "lnd"}},
+		seed:  seed,
+	}
+}
+
+func TestRecoverSwapsRange(t *testing.T) {
+	server := newTestRecoveryServer(t)
+
+	_, err := server.RecoverSwaps(context.Background(), &boltzrpc.RecoverSwapsRequest{FromIndex: 2, ToIndex: 1})
+	assert.Equal(t, "first index cannot be larger than the last one", err.Error())
+
+	_, err = server.RecoverSwaps(context.Background(), &boltzrpc.RecoverSwapsRequest{FromIndex: 0, ToIndex: maxRecoveryRange})
+	assert.Equal(t, "index range cannot be larger than 100000", err.Error())
+
+	_, err = server.RecoverSwaps(context.Background(), &boltzrpc.RecoverSwapsRequest{FromIndex: keys.MaxIndex, ToIndex: keys.MaxIndex + 1})
+	assert.Equal(t, "last index cannot be larger than 2147483647", err.Error())
+}
+
+func TestDeriveRecoveryKeys(t *testing.T) {
+	server := newTestRecoveryServer(t)
+
+	recoveryKeys, err := server.deriveRecoveryKeys(5, 9)
+
+	assert.Nil(t, err)
+	assert.Len(t, recoveryKeys, 5)
+
+	privateKey, _, err := server.newKeys(7)
+	assert.Nil(t, err)
+
+	key := findRecoveryKey(recoveryKeys, privateKey.PubKey())
+
+	assert.NotNil(t, key)
+	assert.Equal(t, int64(7), key.index)
+
+	preimage, _, err := server.newPreimage(7)
+	assert.Nil(t, err)
+	assert.Equal(t, preimage, key.preimage)
+
+	privateKey, _, err = server.newKeys(10)
+	assert.Nil(t, err)
+	assert.Nil(t, findRecoveryKey(recoveryKeys, privateKey.PubKey()))
+
+	// The range can end at the largest index
+	recoveryKeys, err = server.deriveRecoveryKeys(keys.MaxIndex-1, keys.MaxIndex)
+
+	assert.Nil(t, err)
+	assert.Len(t, recoveryKeys, 2)
+}
+
+func TestFindRecoveredLockupOutput(t *testing.T) {
+	chainParams := &chaincfg.RegressionNetParams
+	redeemScript, _ := hex.DecodeString("a9140d90b94f98198ea9ba3a94a34d27897c27024305876321037c7980160182adad9eaea06c1b1cdf9dfdce5ef865c386a112bff4a62196caf66702f800b1752103de7f16653d93ff6ceac681050e75692d7a6fa05ea473d7df90aeac40fa11e28d68ac")
+
+	segWitAddress, _ := boltz.WitnessScriptHashAddress(chainParams, redeemScript)
+	compatibilityAddress, _ := boltz.NestedScriptHashAddress(chainParams, redeemScript)
+
+	// Without lockup transaction the native SegWit address is assumed
+	address, outputType, amount, err := findRecoveredLockupOutput(chainParams, redeemScript, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, segWitAddress, address)
+	assert.Equal(t, boltz.SegWit, outputType)
+	assert.Equal(t, uint64(0), amount)
+
+	newLockupTransaction := func(address string, value int64) *btcutil.Tx {
+		decodedAddress, err := btcutil.DecodeAddress(address, chainParams)
+		assert.Nil(t, err)
+
+		outputScript, err := txscript.PayToAddrScript(decodedAddress)
+		assert.Nil(t, err)
+
+		transaction := wire.NewMsgTx(wire.TxVersion)
+		transaction.AddTxOut(wire.NewTxOut(value, outputScript))
+
+		return btcutil.NewTx(transaction)
+	}
+
+	address, outputType, amount, err = findRecoveredLockupOutput(chainParams, redeemScript, newLockupTransaction(compatibilityAddress, 100000))
+
+	assert.Nil(t, err)
+	assert.Equal(t, compatibilityAddress, address)
+	assert.Equal(t, boltz.Compatibility, outputType)
+	assert.Equal(t, uint64(100000), amount)
+
+	address, outputType, amount, err = findRecoveredLockupOutput(chainParams, redeemScript, newLockupTransaction(segWitAddress, 200000))
+
+	assert.Nil(t, err)
+	assert.Equal(t, segWitAddress, address)
+	assert.Equal(t, boltz.SegWit, outputType)
+	assert.Equal(t, uint64(200000), amount)
+
+	otherAddress, _ := boltz.WitnessScriptHashAddress(chainParams, []byte{txscript.OP_TRUE})
+
+	_, _, _, err = findRecoveredLockupOutput(chainParams, redeemScript, newLockupTransaction(otherAddress, 1))
+	assert.NotNil(t, err)
+}
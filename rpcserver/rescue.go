@@ -0,0 +1,240 @@
+package rpcserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/logger"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/input"
+)
+
+func (server *routedBoltzServer) ExportRescueFile(_ context.Context, request *boltzrpc.ExportRescueFileRequest) (*boltzrpc.ExportRescueFileResponse, error) {
+	swaps, reverseSwaps, err := server.queryRescueSwaps(request.Ids)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	response := &boltzrpc.ExportRescueFileResponse{}
+
+	for _, swap := range swaps {
+		rescueSwap, err := server.newRescueSwap(&swap)
+
+		if err != nil {
+			return nil, handleError(errors.New("could not export Swap " + swap.Id + ": " + err.Error()))
+		}
+
+		response.Swaps = append(response.Swaps, rescueSwap)
+	}
+
+	for _, reverseSwap := range reverseSwaps {
+		rescueSwap, err := server.newRescueReverseSwap(&reverseSwap)
+
+		if err != nil {
+			return nil, handleError(errors.New("could not export Reverse Swap " + reverseSwap.Id + ": " + err.Error()))
+		}
+
+		response.Swaps = append(response.Swaps, rescueSwap)
+	}
+
+	logger.Info("Exported rescue file with " + strconv.Itoa(len(response.Swaps)) + " swaps")
+
+	return response, nil
+}
+
+func (server *routedBoltzServer) ImportSwaps(_ context.Context, request *boltzrpc.ImportSwapsRequest) (*boltzrpc.ImportSwapsResponse, error) {
+	logger.Info("Importing " + strconv.Itoa(len(request.Swaps)) + " swaps")
+
+	response := &boltzrpc.ImportSwapsResponse{}
+
+	for _, rescueSwap := range request.Swaps {
+		importedSwap := &boltzrpc.RecoveredSwap{
+			Id: rescueSwap.Id,
+		}
+
+		err := server.importSwap(request.Node, rescueSwap, importedSwap)
+
+		if err != nil {
+			logger.Warning("Could not import swap " + rescueSwap.Id + ": " + err.Error())
+			importedSwap.Error = err.Error()
+		}
+
+		response.Swaps = append(response.Swaps, importedSwap)
+	}
+
+	return response, nil
+}
+
+// Returns the pending Swaps and Reverse Swaps if no IDs are specified
+func (server *routedBoltzServer) queryRescueSwaps(ids []string) (swaps []database.Swap, reverseSwaps []database.ReverseSwap, err error) {
+	if len(ids) == 0 {
+		swaps, err = server.database.QueryPendingSwaps()
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		reverseSwaps, err = server.database.QueryPendingReverseSwaps()
+		return swaps, reverseSwaps, err
+	}
+
+	for _, id := range ids {
+		swap, err := server.database.QuerySwap(id)
+
+		if err == nil {
+			swaps = append(swaps, *swap)
+			continue
+		}
+
+		reverseSwap, err := server.database.QueryReverseSwap(id)
+
+		if err != nil {
+			return nil, nil, errors.New("could not find Swap or Reverse Swap " + id)
+		}
+
+		reverseSwaps = append(reverseSwaps, *reverseSwap)
+	}
+
+	return swaps, reverseSwaps, nil
+}
+
+func (server *routedBoltzServer) newRescueSwap(swap *database.Swap) (*boltzrpc.RescueSwap, error) {
+	node, err := server.getSwapNode(swap.Node)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &boltzrpc.RescueSwap{
+		Id:                 swap.Id,
+		Type:               boltzrpc.SwapType_SUBMARINE,
+		Currency:           node.getCurrencySymbol(swap.Currency),
+		PrivateKey:         hex.EncodeToString(swap.PrivateKey.Serialize()),
+		Preimage:           hex.EncodeToString(swap.Preimage),
+		RedeemScript:       hex.EncodeToString(swap.RedeemScript),
+		LockupAddress:      swap.Address,
+		TimeoutBlockHeight: swap.TimoutBlockHeight,
+		BlindingKey:        formatRescueBlindingKey(swap.BlindingKey),
+		Address:            swap.RefundAddress,
+		Node:               server.getNodeName(swap.Node),
+	}, nil
+}
+
+func (server *routedBoltzServer) newRescueReverseSwap(reverseSwap *database.ReverseSwap) (*boltzrpc.RescueSwap, error) {
+	node, err := server.getSwapNode(reverseSwap.Node)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The lockup address is not saved and the confidential one of Liquid cannot be derived from the redeem script
+	var lockupAddress string
+
+	if reverseSwap.BlindingKey == nil {
+		chainParams, err := node.getChainParams(reverseSwap.Currency)
+
+		if err != nil {
+			return nil, err
+		}
+
+		lockupAddress, err = boltz.WitnessScriptHashAddress(chainParams, reverseSwap.RedeemScript)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &boltzrpc.RescueSwap{
+		Id:                 reverseSwap.Id,
+		Type:               boltzrpc.SwapType_REVERSE_SUBMARINE,
+		Currency:           node.getCurrencySymbol(reverseSwap.Currency),
+		PrivateKey:         hex.EncodeToString(reverseSwap.PrivateKey.Serialize()),
+		Preimage:           hex.EncodeToString(reverseSwap.Preimage),
+		RedeemScript:       hex.EncodeToString(reverseSwap.RedeemScript),
+		LockupAddress:      lockupAddress,
+		TimeoutBlockHeight: reverseSwap.TimeoutBlockHeight,
+		BlindingKey:        formatRescueBlindingKey(reverseSwap.BlindingKey),
+		Address:            reverseSwap.ClaimAddress,
+		Node:               server.getNodeName(reverseSwap.Node),
+	}, nil
+}
+
+func formatRescueBlindingKey(blindingKey *btcec.PrivateKey) string {
+	if blindingKey == nil {
+		return ""
+	}
+
+	return hex.EncodeToString(blindingKey.Serialize())
+}
+
+// Imported swaps are rebuilt like recovered ones, but with the key of the rescue file instead of the ones of the seed
+func (server *routedBoltzServer) importSwap(nodeName string, rescueSwap *boltzrpc.RescueSwap, importedSwap *boltzrpc.RecoveredSwap) error {
+	if rescueSwap.Id == "" {
+		return errors.New("swap has no ID")
+	}
+
+	if nodeName == "" {
+		nodeName = rescueSwap.Node
+	}
+
+	node, err := server.getNode(nodeName)
+
+	if err != nil {
+		return err
+	}
+
+	key, err := parseRescueKey(rescueSwap)
+
+	if err != nil {
+		return err
+	}
+
+	var pairId string
+
+	if rescueSwap.Currency != "" && rescueSwap.Currency != node.Symbol {
+		pairId = rescueSwap.Currency + "/" + node.Symbol
+	}
+
+	return server.recoverSwap(node, []recoveryKey{*key}, &boltzrpc.LostSwap{
+		Id:           rescueSwap.Id,
+		RedeemScript: rescueSwap.RedeemScript,
+		PairId:       pairId,
+		Address:      rescueSwap.Address,
+	}, importedSwap)
+}
+
+func parseRescueKey(rescueSwap *boltzrpc.RescueSwap) (*recoveryKey, error) {
+	privateKeyBytes, err := hex.DecodeString(rescueSwap.PrivateKey)
+
+	if err != nil || len(privateKeyBytes) != btcec.PrivKeyBytesLen {
+		return nil, errors.New("invalid private key")
+	}
+
+	privateKey, publicKey := btcec.PrivKeyFromBytes(btcec.S256(), privateKeyBytes)
+
+	key := &recoveryKey{
+		index:      -1,
+		privateKey: privateKey,
+		publicKey:  publicKey.SerializeCompressed(),
+	}
+
+	if rescueSwap.Preimage != "" {
+		key.preimage, err = hex.DecodeString(rescueSwap.Preimage)
+
+		if err != nil {
+			return nil, errors.New("could not decode preimage: " + err.Error())
+		}
+
+		preimageHash := sha256.Sum256(key.preimage)
+		key.preimageHash160 = input.Ripemd160H(preimageHash[:])
+	}
+
+	return key, nil
+}
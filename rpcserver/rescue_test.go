@@ -0,0 +1,109 @@
+package rpcserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRescueReverseSwap(t *testing.T) {
+	server := &routedBoltzServer{
+		nodes: []*Node{{Name: "lnd", Symbol: "BTC", ChainParams: &chaincfg.RegressionNetParams}},
+	}
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	preimage := make([]byte, 32)
+	redeemScript, _ := hex.DecodeString("8201208763a914fb05e8ae5f8d7ce5a4a0d5bc8bb0bb2c32f1d16c8821037c7980160182adad9eaea06c1b1cdf9dfdce5ef865c386a112bff4a62196caf6677502f800b1752103de7f16653d93ff6ceac681050e75692d7a6fa05ea473d7df90aeac40fa11e28d68ac")
+
+	reverseSwap := database.ReverseSwap{
+		Id:                 "reverse",
+		PrivateKey:         privateKey,
+		Preimage:           preimage,
+		RedeemScript:       redeemScript,
+		ClaimAddress:       "bcrt1qmv7y8ugpmjgqcxmn2z8hxhmfynhz0gq6lcq4jz",
+		TimeoutBlockHeight: 248,
+	}
+
+	rescueSwap, err := server.newRescueReverseSwap(&reverseSwap)
+	assert.Nil(t, err)
+
+	lockupAddress, _ := boltz.WitnessScriptHashAddress(&chaincfg.RegressionNetParams, redeemScript)
+
+	assert.Equal(t, &boltzrpc.RescueSwap{
+		Id:                 reverseSwap.Id,
+		Type:               boltzrpc.SwapType_REVERSE_SUBMARINE,
+		Currency:           "BTC",
+		PrivateKey:         hex.EncodeToString(privateKey.Serialize()),
+		Preimage:           hex.EncodeToString(preimage),
+		RedeemScript:       hex.EncodeToString(redeemScript),
+		LockupAddress:      lockupAddress,
+		TimeoutBlockHeight: reverseSwap.TimeoutBlockHeight,
+		Address:            reverseSwap.ClaimAddress,
+		Node:               "lnd",
+	}, rescueSwap)
+
+	// The key and preimage of the file are the ones of the Reverse Swap
+	key, err := parseRescueKey(rescueSwap)
+	assert.Nil(t, err)
+
+	preimageHash := sha256.Sum256(preimage)
+
+	assert.Equal(t, int64(-1), key.index)
+	assert.Equal(t, privateKey.Serialize(), key.privateKey.Serialize())
+	assert.Equal(t, privateKey.PubKey().SerializeCompressed(), key.publicKey)
+	assert.Equal(t, preimage, key.preimage)
+	assert.Equal(t, input.Ripemd160H(preimageHash[:]), key.preimageHash160)
+}
+
+func TestParseRescueKey(t *testing.T) {
+	// Swaps of the web app have no preimage
+	key, err := parseRescueKey(&boltzrpc.RescueSwap{
+		PrivateKey: "b3d6e8c4c1e4d4b5d4e93f1e0f3a6f0a6a4c7c1f2b0cf6f2b8a8f0b1e2c3d4e5",
+	})
+
+	assert.Nil(t, err)
+	assert.Nil(t, key.preimage)
+	assert.Nil(t, key.preimageHash160)
+
+	_, err = parseRescueKey(&boltzrpc.RescueSwap{PrivateKey: "b3d6"})
+	assert.Equal(t, "invalid private key", err.Error())
+
+	_, err = parseRescueKey(&boltzrpc.RescueSwap{
+		PrivateKey: "b3d6e8c4c1e4d4b5d4e93f1e0f3a6f0a6a4c7c1f2b0cf6f2b8a8f0b1e2c3d4e5",
+		Preimage:   "not hex",
+	})
+	assert.Contains(t, err.Error(), "could not decode preimage")
+}
+
+func TestImportSwaps(t *testing.T) {
+	server := &routedBoltzServer{
+		nodes: []*Node{{Name: "lnd", Symbol: "BTC", ChainParams: &chaincfg.RegressionNetParams}},
+	}
+
+	response, err := server.ImportSwaps(context.Background(), &boltzrpc.ImportSwapsRequest{
+		Swaps: []*boltzrpc.RescueSwap{
+			{},
+			{Id: "unknownNode", Node: "second"},
+			{Id: "invalidKey", PrivateKey: "00"},
+		},
+	})
+
+	// Swaps that cannot be imported are reported individually
+	assert.Nil(t, err)
+	assert.Len(t, response.Swaps, 3)
+
+	assert.Equal(t, "swap has no ID", response.Swaps[0].Error)
+	assert.Equal(t, "could not find node second", response.Swaps[1].Error)
+	assert.Equal(t, "invalid private key", response.Swaps[2].Error)
+}
@@ -3,36 +3,41 @@ package rpcserver
 import (
 	"bytes"
 	"context"
-	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"github.com/BoltzExchange/boltz-lnd/accounting"
+	"github.com/BoltzExchange/boltz-lnd/autoswap"
 	"github.com/BoltzExchange/boltz-lnd/boltz"
 	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
 	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/keys"
 	"github.com/BoltzExchange/boltz-lnd/lnd"
 	"github.com/BoltzExchange/boltz-lnd/logger"
-	"github.com/BoltzExchange/boltz-lnd/nursery"
 	"github.com/BoltzExchange/boltz-lnd/utils"
 	"github.com/btcsuite/btcd/btcec"
-	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/zpay32"
 	"math"
 	"strconv"
+	"time"
 )
 
+const defaultWalletAccount = "default"
+
 type routedBoltzServer struct {
 	boltzrpc.BoltzServer
 
-	symbol      string
-	chainParams *chaincfg.Params
+	// The first node is the one of the [LND] section
+	nodes []*Node
+
+	feePolicy  *FeePolicy
+	accounting *accounting.Config
 
-	lnd      *lnd.LND
-	boltz    *boltz.Boltz
-	nursery  *nursery.Nursery
-	database *database.Database
+	database    *database.Database
+	seed        *keys.Seed
+	autoSwapper *autoswap.AutoSwapper
 }
 
 func handleError(err error) error {
@@ -43,23 +48,46 @@ func handleError(err error) error {
 	return err
 }
 
-func (server *routedBoltzServer) GetInfo(_ context.Context, _ *boltzrpc.GetInfoRequest) (*boltzrpc.GetInfoResponse, error) {
-	lndInfo, err := server.lnd.GetInfo()
+func (server *routedBoltzServer) GetInfo(_ context.Context, request *boltzrpc.GetInfoRequest) (*boltzrpc.GetInfoResponse, error) {
+	nodes, err := server.filterNodes(request.Node)
 
 	if err != nil {
 		return nil, handleError(err)
 	}
 
+	response := &boltzrpc.GetInfoResponse{}
+
+	for _, node := range nodes {
+		lndInfo, err := node.LND.GetInfo()
+
+		if err != nil {
+			return nil, handleError(errors.New("could not get info of node " + node.Name + ": " + err.Error()))
+		}
+
+		response.Nodes = append(response.Nodes, &boltzrpc.NodeInfo{
+			Name:        node.Name,
+			Symbol:      node.Symbol,
+			Network:     node.ChainParams.Name,
+			LndPubkey:   lndInfo.IdentityPubkey,
+			BlockHeight: lndInfo.BlockHeight,
+		})
+	}
+
+	response.Symbol = response.Nodes[0].Symbol
+	response.Network = response.Nodes[0].Network
+	response.LndPubkey = response.Nodes[0].LndPubkey
+	response.BlockHeight = response.Nodes[0].BlockHeight
+
 	pendingSwaps, err := server.database.QueryPendingSwaps()
 
 	if err != nil {
 		return nil, handleError(err)
 	}
 
-	var pendingSwapIds []string
-
 	for _, pendingSwap := range pendingSwaps {
-		pendingSwapIds = append(pendingSwapIds, pendingSwap.Id)
+		if server.isRequestedNode(request.Node, pendingSwap.Node) {
+			response.PendingSwaps = append(response.PendingSwaps, pendingSwap.Id)
+		}
 	}
 
 	pendingReverseSwaps, err := server.database.QueryPendingReverseSwaps()
@@ -68,39 +96,122 @@ func (server *routedBoltzServer) GetInfo(_ context.Context, _ *boltzrpc.GetInfoR
 		return nil, handleError(err)
 	}
 
-	var pendingReverseSwapIds []string
-
 	for _, pendingReverseSwap := range pendingReverseSwaps {
-		pendingReverseSwapIds = append(pendingReverseSwapIds, pendingReverseSwap.Id)
+		if server.isRequestedNode(request.Node, pendingReverseSwap.Node) {
+			response.PendingReverseSwaps = append(response.PendingReverseSwaps, pendingReverseSwap.Id)
+		}
 	}
 
-	return &boltzrpc.GetInfoResponse{
-		Symbol:              server.symbol,
-		Network:             server.chainParams.Name,
-		LndPubkey:           lndInfo.IdentityPubkey,
-		BlockHeight:         lndInfo.BlockHeight,
-		PendingSwaps:        pendingSwapIds,
-		PendingReverseSwaps: pendingReverseSwapIds,
-	}, nil
+	return response, nil
 }
 
-func (server *routedBoltzServer) GetServiceInfo(_ context.Context, _ *boltzrpc.GetServiceInfoRequest) (*boltzrpc.GetServiceInfoResponse, error) {
-	fees, limits, err := server.getPairs()
+func (server *routedBoltzServer) GetServiceInfo(_ context.Context, request *boltzrpc.GetServiceInfoRequest) (*boltzrpc.GetServiceInfoResponse, error) {
+	node, err := server.getNode(request.Node)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	pair, err := node.parsePair(request.PairId)
 
 	if err != nil {
 		return nil, handleError(err)
 	}
 
-	limits.Minimal = calculateDepositLimit(limits.Minimal, fees, true)
-	limits.Maximal = calculateDepositLimit(limits.Maximal, fees, false)
+	info, err := node.getPairInfo(pair)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	info.limits.Minimal = calculateDepositLimit(info.limits.Minimal, info.fees, true)
+	info.limits.Maximal = calculateDepositLimit(info.limits.Maximal, info.fees, false)
 
 	return &boltzrpc.GetServiceInfoResponse{
-		Fees:   fees,
-		Limits: limits,
+		Fees:   info.fees,
+		Limits: info.limits,
+		Rate:   float32(info.rate),
+	}, nil
+}
+
+func (server *routedBoltzServer) GetQuote(_ context.Context, request *boltzrpc.GetQuoteRequest) (*boltzrpc.GetQuoteResponse, error) {
+	if request.Amount <= 0 {
+		return nil, handleError(errors.New("amount has to be greater than 0"))
+	}
+
+	node, err := server.getNode(request.Node)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	pair, err := node.parsePair(request.PairId)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	info, err := node.getPairInfo(pair)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	feeRate, err := node.Nursery.EstimateFee(pair.currencySymbol())
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	var swapQuote *quote
+	var routingFeeLimit int64
+
+	switch request.Type {
+	case boltzrpc.SwapType_SUBMARINE:
+		refundFee := uint64(feeRate) * pair.transactionVsize(refundTransactionVsize)
+		swapQuote, err = quoteSwap(pair, info, uint64(request.Amount), request.Direction, refundFee)
+
+	case boltzrpc.SwapType_REVERSE_SUBMARINE:
+		claimFee := uint64(feeRate) * pair.transactionVsize(claimTransactionVsize)
+		swapQuote, err = quoteReverseSwap(pair, info, uint64(request.Amount), request.Direction, claimFee)
+
+		if err == nil {
+			routingFeeLimit = lnd.CalculateFeeLimit(btcutil.Amount(swapQuote.invoiceAmount))
+		}
+
+	default:
+		err = errors.New("quotes are only supported for submarine and reverse swaps")
+	}
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	err = checkQuoteLimits(swapQuote, info.limits)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &boltzrpc.GetQuoteResponse{
+		SendAmount:         int64(swapQuote.sendAmount),
+		ReceiveAmount:      int64(swapQuote.receiveAmount),
+		BoltzPercentageFee: int64(swapQuote.percentageFee),
+		BoltzMinerFee:      int64(swapQuote.boltzMinerFee),
+		MinerFee:           int64(swapQuote.minerFee),
+		SatPerVbyte:        feeRate,
+		RoutingFeeLimit:    routingFeeLimit,
+		Limits:             info.limits,
 	}, nil
 }
 
-func (server *routedBoltzServer) ListSwaps(_ context.Context, _ *boltzrpc.ListSwapsRequest) (*boltzrpc.ListSwapsResponse, error) {
+func (server *routedBoltzServer) ListSwaps(_ context.Context, request *boltzrpc.ListSwapsRequest) (*boltzrpc.ListSwapsResponse, error) {
+	_, err := server.filterNodes(request.Node)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
 	response := &boltzrpc.ListSwapsResponse{}
 
 	swaps, err := server.database.QuerySwaps()
@@ -110,16 +221,20 @@ func (server *routedBoltzServer) ListSwaps(_ context.Context, _ *boltzrpc.ListSw
 	}
 
 	for _, swap := range swaps {
+		if !server.isRequestedNode(request.Node, swap.Node) {
+			continue
+		}
+
 		// Check for a Channel Creation
 		channelCreation, err := server.database.QueryChannelCreation(swap.Id)
 
 		if err == nil {
 			response.ChannelCreations = append(response.ChannelCreations, &boltzrpc.CombinedChannelSwapInfo{
-				Swap:            serializeSwap(&swap),
+				Swap:            serializeSwap(&swap, server.getNodeName(swap.Node)),
 				ChannelCreation: serializeChannelCreation(channelCreation),
 			})
 		} else {
-			response.Swaps = append(response.Swaps, serializeSwap(&swap))
+			response.Swaps = append(response.Swaps, serializeSwap(&swap, server.getNodeName(swap.Node)))
 		}
 	}
 
@@ -131,7 +246,9 @@ func (server *routedBoltzServer) ListSwaps(_ context.Context, _ *boltzrpc.ListSw
 	}
 
 	for _, reverseSwap := range reverseSwaps {
-		response.ReverseSwaps = append(response.ReverseSwaps, serializeReverseSwap(&reverseSwap))
+		if server.isRequestedNode(request.Node, reverseSwap.Node) {
+			response.ReverseSwaps = append(response.ReverseSwaps, serializeReverseSwap(&reverseSwap, server.getNodeName(reverseSwap.Node)))
+		}
 	}
 
 	return response, nil
@@ -150,7 +267,7 @@ func (server *routedBoltzServer) GetSwapInfo(_ context.Context, request *boltzrp
 		}
 
 		return &boltzrpc.GetSwapInfoResponse{
-			Swap:            serializeSwap(swap),
+			Swap:            serializeSwap(swap, server.getNodeName(swap.Node)),
 			ChannelCreation: grpcChannelCreation,
 		}, nil
 	}
@@ -160,15 +277,83 @@ func (server *routedBoltzServer) GetSwapInfo(_ context.Context, request *boltzrp
 
 	if err == nil {
 		return &boltzrpc.GetSwapInfoResponse{
-			ReverseSwap: serializeReverseSwap(reverseSwap),
+			ReverseSwap: serializeReverseSwap(reverseSwap, server.getNodeName(reverseSwap.Node)),
 		}, nil
 	}
 
 	return nil, handleError(errors.New("could not find Swap or Reverse Swap with ID " + request.Id))
 }
 
+func (server *routedBoltzServer) GetFeeReport(_ context.Context, request *boltzrpc.GetFeeReportRequest) (*boltzrpc.GetFeeReportResponse, error) {
+	_, err := server.filterNodes(request.Node)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	to := time.Now()
+
+	if request.To != 0 {
+		to = time.Unix(request.To, 0)
+	}
+
+	var prices *accounting.Prices
+
+	if request.Fiat {
+		prices, err = server.accounting.LoadPrices()
+
+		if err != nil {
+			return nil, handleError(err)
+		}
+	}
+
+	entries, err := server.database.QueryLedgerEntries(time.Unix(request.From, 0), to)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	var requestedEntries []database.LedgerEntry
+
+	for _, entry := range entries {
+		if server.isRequestedNode(request.Node, entry.Node) {
+			requestedEntries = append(requestedEntries, entry)
+		}
+	}
+
+	response, err := buildFeeReport(requestedEntries, server.getNodeName, prices)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	if prices != nil {
+		response.FiatCurrency = server.accounting.FiatCurrency
+	}
+
+	return response, nil
+}
+
 func (server *routedBoltzServer) Deposit(_ context.Context, request *boltzrpc.DepositRequest) (*boltzrpc.DepositResponse, error) {
-	preimage, preimageHash, err := newPreimage()
+	node, err := server.getNode(request.Node)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	err = checkWalletFunding(request.FundFromWallet, true)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	keyIndex, err := server.newKeyIndex()
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	preimage, preimageHash, err := server.newPreimage(keyIndex)
 
 	if err != nil {
 		return nil, handleError(err)
@@ -176,15 +361,15 @@ func (server *routedBoltzServer) Deposit(_ context.Context, request *boltzrpc.De
 
 	logger.Info("Creating Swap with preimage hash: " + hex.EncodeToString(preimageHash))
 
-	privateKey, publicKey, err := newKeys()
+	privateKey, publicKey, err := server.newKeys(keyIndex)
 
 	if err != nil {
 		return nil, handleError(err)
 	}
 
-	response, err := server.boltz.CreateChannelCreation(boltz.CreateChannelCreationRequest{
+	response, err := node.Boltz.CreateChannelCreation(boltz.CreateChannelCreationRequest{
 		Type:            "submarine",
-		PairId:          server.symbol + "/" + server.symbol,
+		PairId:          node.Symbol + "/" + node.Symbol,
 		OrderSide:       "buy",
 		PreimageHash:    hex.EncodeToString(preimageHash),
 		RefundPublicKey: hex.EncodeToString(publicKey.SerializeCompressed()),
@@ -220,6 +405,8 @@ func (server *routedBoltzServer) Deposit(_ context.Context, request *boltzrpc.De
 		TimoutBlockHeight:   response.TimeoutBlockHeight,
 		LockupTransactionId: "",
 		RefundTransactionId: "",
+		Node:                server.getDatabaseNode(node),
+		KeyIndex:            int64(keyIndex),
 	}
 
 	err = boltz.CheckSwapScript(deposit.RedeemScript, preimageHash, deposit.PrivateKey, deposit.TimoutBlockHeight)
@@ -228,7 +415,7 @@ func (server *routedBoltzServer) Deposit(_ context.Context, request *boltzrpc.De
 		return nil, handleError(err)
 	}
 
-	err = boltz.CheckSwapAddress(server.chainParams, deposit.Address, deposit.RedeemScript, true)
+	err = checkSwapAddress(node.ChainParams, nil, &deposit, response)
 
 	if err != nil {
 		return nil, handleError(err)
@@ -242,40 +429,136 @@ func (server *routedBoltzServer) Deposit(_ context.Context, request *boltzrpc.De
 		return nil, handleError(err)
 	}
 
-	server.nursery.RegisterSwap(&deposit, nil)
+	// The amounts of deposits are set by the nursery once the lockup transaction was found
+	server.createLedgerEntry(node, nil, deposit.Id, database.LedgerEntry{Type: database.SubmarineSwap})
+
+	var fundingErr error
+
+	if request.FundFromWallet != nil {
+		fundingErr = server.fundSwap(node, &deposit, request.FundFromWallet, request.FundFromWallet.Amount)
+	}
+
+	node.Nursery.RegisterSwap(&deposit, nil)
 
 	logger.Info("Created new Swap " + deposit.Id + ": " + marshalJson(deposit.Serialize()))
 
+	if fundingErr != nil {
+		return nil, handleError(fundingErr)
+	}
+
 	return &boltzrpc.DepositResponse{
-		Id:                 response.Id,
-		Address:            deposit.Address,
-		TimeoutBlockHeight: uint32(deposit.TimoutBlockHeight),
+		Id:                  response.Id,
+		Address:             deposit.Address,
+		TimeoutBlockHeight:  uint32(deposit.TimoutBlockHeight),
+		LockupTransactionId: deposit.LockupTransactionId,
 	}, nil
 }
 
-// TODO: custom refund address
-// TODO: automatic sending from LND wallet
 func (server *routedBoltzServer) CreateSwap(_ context.Context, request *boltzrpc.CreateSwapRequest) (*boltzrpc.CreateSwapResponse, error) {
 	logger.Info("Creating Swap for " + strconv.FormatInt(request.Amount, 10) + " satoshis")
 
-	invoice, err := server.lnd.AddInvoice(int64(request.Amount), nil, 0, utils.GetSwapMemo(server.symbol))
+	node, err := server.getNode(request.Node)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	feePolicy, err := server.feePolicy.withRequest(request.FeePolicy)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	err = checkWalletFunding(request.FundFromWallet, false)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	pair, err := node.parsePair(request.PairId)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	chainParams := pair.chainParams(node.ChainParams)
+
+	var outputType boltz.OutputType
+
+	if request.OutputType != "" {
+		outputType, err = boltz.ParseSwapOutputType(request.OutputType)
+
+		if err != nil {
+			return nil, handleError(err)
+		}
+	}
+
+	// The LND wallet cannot fund cross chain Swaps and refunds cannot go to it either
+	if pair.currency != nil {
+		if request.FundFromWallet != nil {
+			return nil, handleError(errors.New("cross chain Swaps cannot be funded from the LND wallet"))
+		}
+
+		if request.RefundAddress == "" {
+			return nil, handleError(errors.New("a refund address is required for cross chain Swaps"))
+		}
+	}
+
+	if request.RefundAddress != "" {
+		err := checkAddress(chainParams, pair.liquidNetwork(), request.RefundAddress)
+
+		if err != nil {
+			return nil, handleError(errors.New("invalid refund address: " + err.Error()))
+		}
+	}
+
+	info, err := node.getPairInfo(pair)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	// What Boltz actually expects is checked again once the Swap was created
+	err = feePolicy.checkQuotedSwapFee(pair, info, uint64(request.Amount))
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	invoice, err := node.LND.AddInvoice(int64(request.Amount), nil, 0, utils.GetSwapMemo(node.Symbol))
 
 	if err != nil {
 		return nil, handleError(err)
 	}
 
-	privateKey, publicKey, err := newKeys()
+	// The invoice of a Swap that is rejected after it was added must not be paid by anyone
+	isSwapCreated := false
+
+	defer func() {
+		if !isSwapCreated {
+			cancelInvoice(node, invoice.RHash)
+		}
+	}()
+
+	keyIndex, err := server.newKeyIndex()
 
 	if err != nil {
 		return nil, handleError(err)
 	}
 
-	response, err := server.boltz.CreateSwap(boltz.CreateSwapRequest{
+	privateKey, publicKey, err := server.newKeys(keyIndex)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	response, err := node.Boltz.CreateSwap(boltz.CreateSwapRequest{
 		Type:            "submarine",
-		PairId:          server.symbol + "/" + server.symbol,
-		OrderSide:       "buy",
+		PairId:          pair.id,
+		OrderSide:       pair.orderSide(false),
 		Invoice:         invoice.PaymentRequest,
 		RefundPublicKey: hex.EncodeToString(publicKey.SerializeCompressed()),
+		OutputType:      request.OutputType,
 	})
 
 	if err != nil {
@@ -302,6 +585,10 @@ func (server *routedBoltzServer) CreateSwap(_ context.Context, request *boltzrpc
 		TimoutBlockHeight:   response.TimeoutBlockHeight,
 		LockupTransactionId: "",
 		RefundTransactionId: "",
+		RefundAddress:       request.RefundAddress,
+		Currency:            pair.currencySymbol(),
+		Node:                server.getDatabaseNode(node),
+		KeyIndex:            int64(keyIndex),
 	}
 
 	err = boltz.CheckSwapScript(swap.RedeemScript, invoice.RHash, swap.PrivateKey, swap.TimoutBlockHeight)
@@ -310,13 +597,29 @@ func (server *routedBoltzServer) CreateSwap(_ context.Context, request *boltzrpc
 		return nil, handleError(err)
 	}
 
-	err = boltz.CheckSwapAddress(server.chainParams, swap.Address, swap.RedeemScript, true)
+	err = checkSwapAddress(chainParams, pair.liquidNetwork(), &swap, response)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	if request.OutputType != "" && swap.OutputType != outputType {
+		return nil, handleError(errors.New("Boltz returned a " + swap.OutputType.String() + " lockup address instead of a " + outputType.String() + " one"))
+	}
+
+	err = checkSwapAmount(pair, info, uint64(request.Amount), swap.ExpectedAmount)
 
 	if err != nil {
 		return nil, handleError(err)
 	}
 
-	logger.Info("Verified redeem script and address of Swap " + swap.Id)
+	err = feePolicy.checkSwapFee(pair, info.rate, uint64(request.Amount), swap.ExpectedAmount)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	logger.Info("Verified redeem script, address and fees of Swap " + swap.Id)
 
 	err = server.database.CreateSwap(swap)
 
@@ -324,15 +627,30 @@ func (server *routedBoltzServer) CreateSwap(_ context.Context, request *boltzrpc
 		return nil, handleError(err)
 	}
 
-	server.nursery.RegisterSwap(&swap, nil)
+	isSwapCreated = true
+
+	server.createLedgerEntry(node, pair, swap.Id, newSwapLedgerEntry(pair, info, uint64(request.Amount), swap.ExpectedAmount))
+
+	var fundingErr error
+
+	if request.FundFromWallet != nil {
+		fundingErr = server.fundSwap(node, &swap, request.FundFromWallet, int64(swap.ExpectedAmount))
+	}
+
+	node.Nursery.RegisterSwap(&swap, nil)
 
 	logger.Info("Created new Swap " + swap.Id + ": " + marshalJson(swap.Serialize()))
 
+	if fundingErr != nil {
+		return nil, handleError(fundingErr)
+	}
+
 	return &boltzrpc.CreateSwapResponse{
-		Id:             swap.Id,
-		Address:        response.Address,
-		ExpectedAmount: int64(response.ExpectedAmount),
-		Bip21:          response.Bip21,
+		Id:                  swap.Id,
+		Address:             response.Address,
+		ExpectedAmount:      int64(response.ExpectedAmount),
+		Bip21:               response.Bip21,
+		LockupTransactionId: swap.LockupTransactionId,
 	}, nil
 }
 
@@ -347,25 +665,72 @@ func (server *routedBoltzServer) CreateChannel(_ context.Context, request *boltz
 		strconv.FormatUint(uint64(request.InboundLiquidity), 10) + "% inbound liquidity for " +
 		strconv.FormatInt(request.Amount, 10) + " satoshis")
 
-	preimage, preimageHash, err := newPreimage()
+	node, err := server.getNode(request.Node)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	feePolicy, err := server.feePolicy.withRequest(request.FeePolicy)
 
 	if err != nil {
 		return nil, handleError(err)
 	}
 
-	invoice, err := server.lnd.AddHoldInvoice(
+	err = checkWalletFunding(request.FundFromWallet, false)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	// Channel Creations are always on the chain of LND
+	pair := &swapPair{id: node.Symbol + "/" + node.Symbol}
+	info, err := node.getPairInfo(pair)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	err = feePolicy.checkQuotedSwapFee(pair, info, uint64(request.Amount))
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	keyIndex, err := server.newKeyIndex()
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	preimage, preimageHash, err := server.newPreimage(keyIndex)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	invoice, err := node.LND.AddHoldInvoice(
 		preimageHash,
 		int64(request.Amount),
 		// TODO: query timeout block delta from API
-		utils.CalculateInvoiceExpiry(144, utils.GetBlockTime(server.symbol)),
-		"Channel Creation from "+server.symbol,
+		utils.CalculateInvoiceExpiry(144, utils.GetBlockTime(node.Symbol)),
+		"Channel Creation from "+node.Symbol,
 	)
 
 	if err != nil {
 		return nil, handleError(err)
 	}
 
-	privateKey, publicKey, err := newKeys()
+	// The hold invoice of a Channel Creation that is rejected after it was added must not be paid by anyone
+	isSwapCreated := false
+
+	defer func() {
+		if !isSwapCreated {
+			cancelInvoice(node, preimageHash)
+		}
+	}()
+
+	privateKey, publicKey, err := server.newKeys(keyIndex)
 
 	if err != nil {
 		return nil, handleError(err)
@@ -373,9 +738,9 @@ func (server *routedBoltzServer) CreateChannel(_ context.Context, request *boltz
 
 	inboundLiquidity := getDefaultInboundLiquidity(request.InboundLiquidity)
 
-	response, err := server.boltz.CreateChannelCreation(boltz.CreateChannelCreationRequest{
+	response, err := node.Boltz.CreateChannelCreation(boltz.CreateChannelCreationRequest{
 		Type:            "submarine",
-		PairId:          server.symbol + "/" + server.symbol,
+		PairId:          pair.id,
 		OrderSide:       "buy",
 		Invoice:         invoice.PaymentRequest,
 		RefundPublicKey: hex.EncodeToString(publicKey.SerializeCompressed()),
@@ -410,6 +775,8 @@ func (server *routedBoltzServer) CreateChannel(_ context.Context, request *boltz
 		TimoutBlockHeight:   response.TimeoutBlockHeight,
 		LockupTransactionId: "",
 		RefundTransactionId: "",
+		Node:                server.getDatabaseNode(node),
+		KeyIndex:            int64(keyIndex),
 	}
 
 	channelCreation := database.ChannelCreation{
@@ -427,13 +794,19 @@ func (server *routedBoltzServer) CreateChannel(_ context.Context, request *boltz
 		return nil, handleError(err)
 	}
 
-	err = boltz.CheckSwapAddress(server.chainParams, swap.Address, swap.RedeemScript, true)
+	err = checkSwapAddress(node.ChainParams, nil, &swap, response)
 
 	if err != nil {
 		return nil, handleError(err)
 	}
 
-	logger.Info("Verified redeem script and address of Channel Creation " + swap.Id)
+	err = feePolicy.checkSwapFee(pair, info.rate, uint64(request.Amount), swap.ExpectedAmount)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	logger.Info("Verified redeem script, address and fees of Channel Creation " + swap.Id)
 
 	err = server.database.CreateSwap(swap)
 
@@ -447,32 +820,69 @@ func (server *routedBoltzServer) CreateChannel(_ context.Context, request *boltz
 		return nil, handleError(err)
 	}
 
-	server.nursery.RegisterSwap(&swap, &channelCreation)
+	isSwapCreated = true
+
+	ledgerEntry := newSwapLedgerEntry(pair, info, uint64(request.Amount), swap.ExpectedAmount)
+	ledgerEntry.Type = database.ChannelCreationSwap
+	server.createLedgerEntry(node, pair, swap.Id, ledgerEntry)
+
+	var fundingErr error
+
+	if request.FundFromWallet != nil {
+		fundingErr = server.fundSwap(node, &swap, request.FundFromWallet, int64(swap.ExpectedAmount))
+	}
+
+	node.Nursery.RegisterSwap(&swap, &channelCreation)
 
 	logger.Info("Created new Channel Creation " + swap.Id + ": " + marshalJson(swap.Serialize()) + "\n" + marshalJson(channelCreation.Serialize()))
 
+	if fundingErr != nil {
+		return nil, handleError(fundingErr)
+	}
+
 	return &boltzrpc.CreateSwapResponse{
-		Id:             swap.Id,
-		Address:        response.Address,
-		ExpectedAmount: int64(response.ExpectedAmount),
-		Bip21:          response.Bip21,
+		Id:                  swap.Id,
+		Address:             response.Address,
+		ExpectedAmount:      int64(response.ExpectedAmount),
+		Bip21:               response.Bip21,
+		LockupTransactionId: swap.LockupTransactionId,
 	}, nil
 }
 
 func (server *routedBoltzServer) CreateReverseSwap(_ context.Context, request *boltzrpc.CreateReverseSwapRequest) (*boltzrpc.CreateReverseSwapResponse, error) {
 	logger.Info("Creating Reverse Swap for " + strconv.FormatInt(request.Amount, 10) + " satoshis")
 
+	node, err := server.getNode(request.Node)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	feePolicy, err := server.feePolicy.withRequest(request.FeePolicy)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	pair, err := node.parsePair(request.PairId)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
 	claimAddress := request.Address
 
 	if claimAddress != "" {
-		_, err := btcutil.DecodeAddress(claimAddress, server.chainParams)
+		err := checkAddress(pair.chainParams(node.ChainParams), pair.liquidNetwork(), claimAddress)
 
 		if err != nil {
 			return nil, handleError(err)
 		}
+	} else if pair.currency != nil {
+		return nil, handleError(errors.New("a claim address is required for cross chain Reverse Swaps"))
 	} else {
 		var err error
-		claimAddress, err = server.lnd.NewAddress()
+		claimAddress, err = node.LND.NewAddress()
 
 		if err != nil {
 			return nil, handleError(err)
@@ -481,7 +891,19 @@ func (server *routedBoltzServer) CreateReverseSwap(_ context.Context, request *b
 		logger.Info("Got claim address from LND: " + claimAddress)
 	}
 
-	preimage, preimageHash, err := newPreimage()
+	info, err := node.getPairInfo(pair)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	keyIndex, err := server.newKeyIndex()
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	preimage, preimageHash, err := server.newPreimage(keyIndex)
 
 	if err != nil {
 		return nil, handleError(err)
@@ -489,16 +911,16 @@ func (server *routedBoltzServer) CreateReverseSwap(_ context.Context, request *b
 
 	logger.Info("Generated preimage " + hex.EncodeToString(preimage))
 
-	privateKey, publicKey, err := newKeys()
+	privateKey, publicKey, err := server.newKeys(keyIndex)
 
 	if err != nil {
 		return nil, handleError(err)
 	}
 
-	response, err := server.boltz.CreateReverseSwap(boltz.CreateReverseSwapRequest{
+	response, err := node.Boltz.CreateReverseSwap(boltz.CreateReverseSwapRequest{
 		Type:           "reverseSubmarine",
-		PairId:         server.symbol + "/" + server.symbol,
-		OrderSide:      "buy",
+		PairId:         pair.id,
+		OrderSide:      pair.orderSide(true),
 		InvoiceAmount:  uint64(request.Amount),
 		PreimageHash:   hex.EncodeToString(preimageHash),
 		ClaimPublicKey: hex.EncodeToString(publicKey.SerializeCompressed()),
@@ -527,6 +949,17 @@ func (server *routedBoltzServer) CreateReverseSwap(_ context.Context, request *b
 		TimeoutBlockHeight:  response.TimeoutBlockHeight,
 		LockupTransactionId: "",
 		ClaimTransactionId:  "",
+		Currency:            pair.currencySymbol(),
+		Node:                server.getDatabaseNode(node),
+		KeyIndex:            int64(keyIndex),
+	}
+
+	if response.BlindingKey != "" {
+		reverseSwap.BlindingKey, err = parseBlindingKey(response.BlindingKey)
+
+		if err != nil {
+			return nil, handleError(err)
+		}
 	}
 
 	err = boltz.CheckReverseSwapScript(reverseSwap.RedeemScript, preimageHash, privateKey, response.TimeoutBlockHeight)
@@ -535,7 +968,7 @@ func (server *routedBoltzServer) CreateReverseSwap(_ context.Context, request *b
 		return nil, handleError(err)
 	}
 
-	invoice, err := zpay32.Decode(reverseSwap.Invoice, server.chainParams)
+	invoice, err := zpay32.Decode(reverseSwap.Invoice, node.ChainParams)
 
 	if err != nil {
 		return nil, handleError(err)
@@ -545,7 +978,23 @@ func (server *routedBoltzServer) CreateReverseSwap(_ context.Context, request *b
 		return nil, handleError(errors.New("invalid invoice preimage hash"))
 	}
 
-	logger.Info("Verified redeem script and invoice of Reverse Swap " + reverseSwap.Id)
+	if invoice.MilliSat == nil || uint64(invoice.MilliSat.ToSatoshis()) != uint64(request.Amount) {
+		return nil, handleError(errors.New("invalid invoice amount"))
+	}
+
+	err = checkReverseSwapAmount(pair, info, uint64(request.Amount), reverseSwap.OnchainAmount)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	err = feePolicy.checkReverseSwapFee(pair, info.rate, uint64(request.Amount), reverseSwap.OnchainAmount)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	logger.Info("Verified redeem script, invoice and fees of Reverse Swap " + reverseSwap.Id)
 
 	err = server.database.CreateReverseSwap(reverseSwap)
 
@@ -553,6 +1002,8 @@ func (server *routedBoltzServer) CreateReverseSwap(_ context.Context, request *b
 		return nil, handleError(err)
 	}
 
+	server.createLedgerEntry(node, pair, reverseSwap.Id, newReverseSwapLedgerEntry(pair, info, uint64(request.Amount), reverseSwap.OnchainAmount))
+
 	// TODO: error handling in case the swap fails
 	var claimTransactionIdChan chan string
 
@@ -560,11 +1011,11 @@ func (server *routedBoltzServer) CreateReverseSwap(_ context.Context, request *b
 		claimTransactionIdChan = make(chan string)
 	}
 
-	server.nursery.RegisterReverseSwap(reverseSwap, claimTransactionIdChan)
+	node.Nursery.RegisterReverseSwap(reverseSwap, claimTransactionIdChan)
 
 	logger.Info("Created new Reverse Swap " + reverseSwap.Id + ": " + marshalJson(reverseSwap.Serialize()))
 
-	payment, err := server.payInvoice(reverseSwap.Invoice, reverseSwap.Id)
+	payment, err := node.payInvoice(reverseSwap.Invoice, reverseSwap.Id, request.ChannelId)
 
 	if err != nil {
 		dbErr := server.database.UpdateReverseSwapState(&reverseSwap, boltzrpc.SwapState_ERROR, err.Error())
@@ -576,6 +1027,12 @@ func (server *routedBoltzServer) CreateReverseSwap(_ context.Context, request *b
 		return nil, handleError(err)
 	}
 
+	err = server.database.SetLedgerRoutingFee(reverseSwap.Id, uint64(payment))
+
+	if err != nil {
+		logger.Warning("Could not set routing fee of Reverse Swap " + reverseSwap.Id + " in ledger: " + err.Error())
+	}
+
 	claimTransactionId := ""
 
 	if claimTransactionIdChan != nil {
@@ -590,44 +1047,300 @@ func (server *routedBoltzServer) CreateReverseSwap(_ context.Context, request *b
 	}, nil
 }
 
-func (server *routedBoltzServer) payInvoice(invoice string, id string) (int64, error) {
-	payment, err := server.lnd.PayInvoice(invoice, 3, 30)
+func (server *routedBoltzServer) RefundSwap(_ context.Context, request *boltzrpc.RefundSwapRequest) (*boltzrpc.RefundSwapResponse, error) {
+	if request.SatPerVbyte < 0 {
+		return nil, handleError(errors.New("fee cannot be negative"))
+	}
+
+	swap, err := server.database.QuerySwap(request.Id)
+
+	if err != nil {
+		return nil, handleError(errors.New("could not find Swap with ID " + request.Id))
+	}
+
+	if swap.State == boltzrpc.SwapState_SUCCESSFUL || swap.State == boltzrpc.SwapState_REFUNDED {
+		return nil, handleError(errors.New("Swap " + swap.Id + " cannot be refunded because its state is " + swap.State.String()))
+	}
+
+	node, err := server.getSwapNode(swap.Node)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	chainParams, blockHeight, err := node.getSwapChain(swap)
 
 	if err != nil {
-		return 0, err
+		return nil, handleError(err)
 	}
 
-	logger.Info("Paid invoice of Reverse Swap " + id + " with fee of " + utils.FormatMilliSat(payment.FeeMsat) + " satoshis")
+	if blockHeight < swap.TimoutBlockHeight {
+		return nil, handleError(errors.New("Swap " + swap.Id + " can be refunded at block height " +
+			strconv.FormatUint(uint64(swap.TimoutBlockHeight), 10) + " but current block height is " +
+			strconv.FormatUint(uint64(blockHeight), 10)))
+	}
+
+	refundAddress := request.Address
+
+	if refundAddress != "" {
+		err = checkAddress(chainParams, node.getLiquidNetwork(swap.Currency), refundAddress)
+
+		if err != nil {
+			return nil, handleError(errors.New("invalid refund address: " + err.Error()))
+		}
+	} else if swap.RefundAddress != "" {
+		refundAddress = swap.RefundAddress
+	} else if swap.Currency != "" {
+		return nil, handleError(errors.New("a refund address is required for cross chain Swaps"))
+	} else {
+		refundAddress, err = node.LND.NewAddress()
 
-	return payment.FeeMsat, nil
+		if err != nil {
+			return nil, handleError(err)
+		}
+
+		logger.Info("Got refund address from LND: " + refundAddress)
+	}
+
+	logger.Info("Refunding Swap " + swap.Id + " to " + refundAddress)
+
+	refundTransactionId, err := node.Nursery.RefundSwaps([]database.Swap{*swap}, refundAddress, request.SatPerVbyte)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &boltzrpc.RefundSwapResponse{
+		RefundTransactionId: refundTransactionId,
+	}, nil
 }
 
-func (server *routedBoltzServer) getPairs() (*boltzrpc.Fees, *boltzrpc.Limits, error) {
-	pairsResponse, err := server.boltz.GetPairs()
+func (server *routedBoltzServer) BumpFee(_ context.Context, request *boltzrpc.BumpFeeRequest) (*boltzrpc.BumpFeeResponse, error) {
+	if request.SatPerVbyte < 0 {
+		return nil, handleError(errors.New("fee cannot be negative"))
+	}
+
+	pendingTransaction, err := server.database.QueryPendingTransaction(request.TransactionId)
 
 	if err != nil {
-		return nil, nil, err
+		return nil, handleError(errors.New("transaction " + request.TransactionId + " is not a pending claim or refund transaction"))
 	}
 
-	pairSymbol := server.symbol + "/" + server.symbol
-	pair, hasPair := pairsResponse.Pairs[pairSymbol]
+	node, err := server.getSwapNode(pendingTransaction.Node)
 
-	if !hasPair {
-		return nil, nil, errors.New("could not find pair with symbol " + pairSymbol)
+	if err != nil {
+		return nil, handleError(err)
 	}
 
-	minerFees := pair.Fees.MinerFees.BaseAsset
+	transactionId, err := node.Nursery.BumpFee(request.TransactionId, request.SatPerVbyte)
 
-	return &boltzrpc.Fees{
-			Percentage: pair.Fees.Percentage,
-			Miner: &boltzrpc.MinerFees{
-				Normal:  uint32(minerFees.Normal),
-				Reverse: uint32(minerFees.Reverse.Lockup + minerFees.Reverse.Claim),
-			},
-		}, &boltzrpc.Limits{
-			Minimal: int64(pair.Limits.Minimal),
-			Maximal: int64(pair.Limits.Maximal),
-		}, nil
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &boltzrpc.BumpFeeResponse{
+		TransactionId: transactionId,
+	}, nil
+}
+
+func (server *routedBoltzServer) ListDanglingChannels(_ context.Context, request *boltzrpc.ListDanglingChannelsRequest) (*boltzrpc.ListDanglingChannelsResponse, error) {
+	_, err := server.filterNodes(request.Node)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	danglingChannels, err := server.database.QueryDanglingChannels()
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	response := &boltzrpc.ListDanglingChannelsResponse{}
+
+	for _, danglingChannel := range danglingChannels {
+		if !server.isRequestedNode(request.Node, danglingChannel.Node) {
+			continue
+		}
+
+		// Channels of nodes that are not configured anymore are not closed
+		var closeHeight uint32
+
+		if node, err := server.getSwapNode(danglingChannel.Node); err == nil {
+			closeHeight = node.Nursery.DanglingChannelCloseHeight(&danglingChannel)
+		}
+
+		response.Channels = append(response.Channels, serializeDanglingChannel(&danglingChannel, server.getNodeName(danglingChannel.Node), closeHeight))
+	}
+
+	return response, nil
+}
+
+func (server *routedBoltzServer) SubscribeSwapEvents(request *boltzrpc.SubscribeSwapEventsRequest, stream boltzrpc.Boltz_SubscribeSwapEventsServer) error {
+	events, unsubscribe := server.database.SubscribeSwapEvents()
+	defer unsubscribe()
+
+	logger.Info("New subscriber to Swap events")
+
+	for {
+		select {
+		case event, isOpen := <-events:
+			if !isOpen {
+				return nil
+			}
+
+			if !isSwapEventRequested(request, &event) {
+				continue
+			}
+
+			err := stream.Send(serializeSwapEvent(&event, server.getNodeName))
+
+			if err != nil {
+				return handleError(err)
+			}
+
+		case <-stream.Context().Done():
+			logger.Info("Subscriber to Swap events disconnected")
+			return nil
+		}
+	}
+}
+
+func (server *routedBoltzServer) GetAutoSwapConfig(_ context.Context, _ *boltzrpc.GetAutoSwapConfigRequest) (*boltzrpc.GetAutoSwapConfigResponse, error) {
+	cfg := server.autoSwapper.GetConfig()
+
+	return &boltzrpc.GetAutoSwapConfigResponse{
+		Config: serializeAutoSwapConfig(&cfg),
+	}, nil
+}
+
+func (server *routedBoltzServer) SetAutoSwapConfig(_ context.Context, request *boltzrpc.SetAutoSwapConfigRequest) (*boltzrpc.SetAutoSwapConfigResponse, error) {
+	if request.Config == nil {
+		return nil, handleError(errors.New("no config specified"))
+	}
+
+	err := server.autoSwapper.SetConfig(parseAutoSwapConfig(request.Config))
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	cfg := server.autoSwapper.GetConfig()
+
+	return &boltzrpc.SetAutoSwapConfigResponse{
+		Config: serializeAutoSwapConfig(&cfg),
+	}, nil
+}
+
+func (server *routedBoltzServer) GetAutoSwapRecommendations(_ context.Context, _ *boltzrpc.GetAutoSwapRecommendationsRequest) (*boltzrpc.GetAutoSwapRecommendationsResponse, error) {
+	recommendations, err := server.autoSwapper.GetRecommendations()
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	response := &boltzrpc.GetAutoSwapRecommendationsResponse{}
+
+	for _, recommendation := range recommendations {
+		response.Recommendations = append(response.Recommendations, serializeAutoSwapRecommendation(recommendation))
+	}
+
+	return response, nil
+}
+
+// The daemon only serves the other calls once it was unlocked
+func (server *routedBoltzServer) Unlock(_ context.Context, _ *boltzrpc.UnlockRequest) (*boltzrpc.UnlockResponse, error) {
+	return nil, handleError(errors.New("boltz-lnd is unlocked already"))
+}
+
+// Sends coins from the LND wallet to the lockup address and saves the id of the lockup transaction
+func (server *routedBoltzServer) fundSwap(node *Node, swap *database.Swap, funding *boltzrpc.WalletFunding, amount int64) error {
+	logger.Info("Funding Swap " + swap.Id + " with " + strconv.FormatInt(amount, 10) + " satoshis from the LND wallet")
+
+	response, err := node.LND.SendCoins(swap.Address, amount, funding.ConfTarget, funding.SatPerVbyte, "Lockup of Swap "+swap.Id)
+
+	if err != nil {
+		return errors.New("created Swap " + swap.Id + " but could not fund it: " + err.Error())
+	}
+
+	logger.Info("Funded Swap " + swap.Id + " with transaction " + response.Txid)
+
+	err = server.database.SetSwapLockupTransactionId(swap, response.Txid)
+
+	if err != nil {
+		return errors.New("could not set lockup transaction id of Swap " + swap.Id + ": " + err.Error())
+	}
+
+	return nil
+}
+
+func cancelInvoice(node *Node, preimageHash []byte) {
+	_, err := node.LND.CancelInvoice(preimageHash)
+
+	if err != nil {
+		logger.Warning("Could not cancel invoice " + hex.EncodeToString(preimageHash) + ": " + err.Error())
+		return
+	}
+
+	logger.Info("Canceled invoice " + hex.EncodeToString(preimageHash))
+}
+
+// Returns the nodes of which information is requested. All nodes are returned if no name is specified
+func (server *routedBoltzServer) filterNodes(name string) ([]*Node, error) {
+	if name == "" {
+		return server.nodes, nil
+	}
+
+	node, err := server.getNode(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []*Node{node}, nil
+}
+
+func (server *routedBoltzServer) isRequestedNode(name string, databaseNode string) bool {
+	return name == "" || name == server.getNodeName(databaseNode)
+}
+
+func isSwapEventRequested(request *boltzrpc.SubscribeSwapEventsRequest, event *database.SwapEvent) bool {
+	if request.Id != "" && request.Id != event.Id {
+		return false
+	}
+
+	if len(request.Types) == 0 {
+		return true
+	}
+
+	for _, swapType := range request.Types {
+		if swapType == serializeSwapType(event.Type) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func checkWalletFunding(funding *boltzrpc.WalletFunding, requireAmount bool) error {
+	if funding == nil {
+		return nil
+	}
+
+	// The version of the LND API that is used does not support selecting coins from other accounts
+	if funding.Account != "" && funding.Account != defaultWalletAccount {
+		return errors.New("funding from account \"" + funding.Account + "\" is not supported, only the \"" + defaultWalletAccount + "\" account can be used")
+	}
+
+	if funding.ConfTarget < 0 || funding.SatPerVbyte < 0 {
+		return errors.New("conf target and fee rate of the funding transaction cannot be negative")
+	}
+
+	if requireAmount && funding.Amount <= 0 {
+		return errors.New("an amount is required to fund a deposit from the LND wallet")
+	}
+
+	return nil
 }
 
 func getDefaultInboundLiquidity(inboundLiquidity uint32) uint32 {
@@ -652,8 +1365,19 @@ func calculateDepositLimit(limit int64, fees *boltzrpc.Fees, isMin bool) int64 {
 	return int64(limitFloat) + int64(fees.Miner.Normal)
 }
 
-func newKeys() (*btcec.PrivateKey, *btcec.PublicKey, error) {
-	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+// Reserves the index from which the keys and preimage of a new swap are derived
+func (server *routedBoltzServer) newKeyIndex() (uint32, error) {
+	keyIndex, err := server.database.NewKeyIndex()
+
+	if err != nil {
+		return 0, errors.New("could not reserve key index: " + err.Error())
+	}
+
+	return keyIndex, nil
+}
+
+func (server *routedBoltzServer) newKeys(keyIndex uint32) (*btcec.PrivateKey, *btcec.PublicKey, error) {
+	privateKey, err := server.seed.DeriveKey(keyIndex)
 
 	if err != nil {
 		return nil, nil, err
@@ -664,9 +1388,23 @@ func newKeys() (*btcec.PrivateKey, *btcec.PublicKey, error) {
 	return privateKey, publicKey, err
 }
 
-func newPreimage() ([]byte, []byte, error) {
-	preimage := make([]byte, 32)
-	_, err := rand.Read(preimage)
+func parseBlindingKey(blindingKey string) (*btcec.PrivateKey, error) {
+	if blindingKey == "" {
+		return nil, errors.New("no blinding key for Liquid swap")
+	}
+
+	blindingKeyBytes, err := hex.DecodeString(blindingKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), blindingKeyBytes)
+	return privateKey, nil
+}
+
+func (server *routedBoltzServer) newPreimage(keyIndex uint32) ([]byte, []byte, error) {
+	preimage, err := server.seed.DerivePreimage(keyIndex)
 
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,45 @@
+package rpcserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckWalletFunding(t *testing.T) {
+	assert.Nil(t, checkWalletFunding(nil, true))
+	assert.Nil(t, checkWalletFunding(&boltzrpc.WalletFunding{}, false))
+	assert.Nil(t, checkWalletFunding(&boltzrpc.WalletFunding{Account: defaultWalletAccount, SatPerVbyte: 10}, false))
+
+	assert.Equal(
+		t,
+		"funding from account \"savings\" is not supported, only the \"default\" account can be used",
+		checkWalletFunding(&boltzrpc.WalletFunding{Account: "savings"}, false).Error(),
+	)
+
+	assert.Equal(
+		t,
+		"conf target and fee rate of the funding transaction cannot be negative",
+		checkWalletFunding(&boltzrpc.WalletFunding{SatPerVbyte: -1}, false).Error(),
+	)
+
+	assert.Equal(
+		t,
+		"an amount is required to fund a deposit from the LND wallet",
+		checkWalletFunding(&boltzrpc.WalletFunding{}, true).Error(),
+	)
+	assert.Nil(t, checkWalletFunding(&boltzrpc.WalletFunding{Amount: 100000}, true))
+}
+
+func TestRefundSwapNegativeFee(t *testing.T) {
+	server := &routedBoltzServer{}
+
+	_, err := server.RefundSwap(context.Background(), &boltzrpc.RefundSwapRequest{
+		Id:          "swap",
+		SatPerVbyte: -1,
+	})
+
+	assert.Equal(t, "fee cannot be negative", err.Error())
+}
@@ -1,11 +1,12 @@
 package rpcserver
 
 import (
+	"github.com/BoltzExchange/boltz-lnd/autoswap"
 	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
 	"github.com/BoltzExchange/boltz-lnd/database"
 )
 
-func serializeSwap(swap *database.Swap) *boltzrpc.SwapInfo {
+func serializeSwap(swap *database.Swap, nodeName string) *boltzrpc.SwapInfo {
 	serializedSwap := swap.Serialize()
 
 	return &boltzrpc.SwapInfo{
@@ -22,6 +23,11 @@ func serializeSwap(swap *database.Swap) *boltzrpc.SwapInfo {
 		TimeoutBlockHeight:  serializedSwap.TimeoutBlockHeight,
 		LockupTransactionId: serializedSwap.LockupTransactionId,
 		RefundTransactionId: serializedSwap.RefundTransactionId,
+		BlindingKey:         serializedSwap.BlindingKey,
+		RefundAddress:       serializedSwap.RefundAddress,
+		OutputType:          serializedSwap.OutputType,
+		Currency:            serializedSwap.Currency,
+		Node:                nodeName,
 	}
 }
 
@@ -38,7 +44,19 @@ func serializeChannelCreation(channelCreation *database.ChannelCreation) *boltzr
 	}
 }
 
-func serializeReverseSwap(reverseSwap *database.ReverseSwap) *boltzrpc.ReverseSwapInfo {
+func serializeDanglingChannel(danglingChannel *database.DanglingChannel, nodeName string, closeHeight uint32) *boltzrpc.DanglingChannel {
+	return &boltzrpc.DanglingChannel{
+		SwapId:               danglingChannel.SwapId,
+		ChannelPoint:         danglingChannel.ChannelPoint,
+		Node:                 nodeName,
+		DetectionHeight:      danglingChannel.DetectionHeight,
+		CloseHeight:          closeHeight,
+		ClosingTransactionId: danglingChannel.ClosingTransactionId,
+		ForceClosed:          danglingChannel.ForceClosed,
+	}
+}
+
+func serializeReverseSwap(reverseSwap *database.ReverseSwap, nodeName string) *boltzrpc.ReverseSwapInfo {
 	serializedReverseSwap := reverseSwap.Serialize()
 
 	return &boltzrpc.ReverseSwapInfo{
@@ -55,5 +73,85 @@ func serializeReverseSwap(reverseSwap *database.ReverseSwap) *boltzrpc.ReverseSw
 		TimeoutBlockHeight:  serializedReverseSwap.TimeoutBlockHeight,
 		LockupTransactionId: serializedReverseSwap.LockupTransactionId,
 		ClaimTransactionId:  serializedReverseSwap.ClaimTransactionId,
+		BlindingKey:         serializedReverseSwap.BlindingKey,
+		Currency:            serializedReverseSwap.Currency,
+		Node:                nodeName,
+	}
+}
+
+func serializeSwapType(swapType database.SwapType) boltzrpc.SwapType {
+	switch swapType {
+	case database.ReverseSubmarineSwap:
+		return boltzrpc.SwapType_REVERSE_SUBMARINE
+	case database.ChannelCreationSwap:
+		return boltzrpc.SwapType_CHANNEL_CREATION
+	default:
+		return boltzrpc.SwapType_SUBMARINE
+	}
+}
+
+// The node name in the database is empty for the node of the [LND] section, so it has to be resolved by the caller
+func serializeSwapEvent(event *database.SwapEvent, getNodeName func(databaseNode string) string) *boltzrpc.SwapEvent {
+	serializedEvent := &boltzrpc.SwapEvent{
+		Type: serializeSwapType(event.Type),
+		Id:   event.Id,
+	}
+
+	if event.Swap != nil {
+		serializedEvent.Swap = serializeSwap(event.Swap, getNodeName(event.Swap.Node))
+	}
+
+	if event.ChannelCreation != nil {
+		serializedEvent.ChannelCreation = serializeChannelCreation(event.ChannelCreation)
+	}
+
+	if event.ReverseSwap != nil {
+		serializedEvent.ReverseSwap = serializeReverseSwap(event.ReverseSwap, getNodeName(event.ReverseSwap.Node))
+	}
+
+	return serializedEvent
+}
+
+func serializeAutoSwapConfig(cfg *autoswap.Config) *boltzrpc.AutoSwapConfig {
+	return &boltzrpc.AutoSwapConfig{
+		Enabled:                cfg.Enabled,
+		DryRun:                 cfg.DryRun,
+		Interval:               cfg.Interval,
+		PerChannel:             cfg.PerChannel,
+		MinLocalBalancePercent: cfg.MinLocalBalancePercent,
+		MaxLocalBalancePercent: cfg.MaxLocalBalancePercent,
+		MaxFeePercent:          cfg.MaxFeePercent,
+		Budget:                 cfg.Budget,
+		BudgetInterval:         cfg.BudgetInterval,
+		MaxInFlight:            cfg.MaxInFlight,
+		Node:                   cfg.Node,
+	}
+}
+
+func parseAutoSwapConfig(cfg *boltzrpc.AutoSwapConfig) autoswap.Config {
+	return autoswap.Config{
+		Enabled:                cfg.Enabled,
+		DryRun:                 cfg.DryRun,
+		Interval:               cfg.Interval,
+		PerChannel:             cfg.PerChannel,
+		MinLocalBalancePercent: cfg.MinLocalBalancePercent,
+		MaxLocalBalancePercent: cfg.MaxLocalBalancePercent,
+		MaxFeePercent:          cfg.MaxFeePercent,
+		Budget:                 cfg.Budget,
+		BudgetInterval:         cfg.BudgetInterval,
+		MaxInFlight:            cfg.MaxInFlight,
+		Node:                   cfg.Node,
+	}
+}
+
+func serializeAutoSwapRecommendation(recommendation *autoswap.Recommendation) *boltzrpc.AutoSwapRecommendation {
+	return &boltzrpc.AutoSwapRecommendation{
+		Type:            serializeSwapType(recommendation.Type),
+		Amount:          recommendation.Amount,
+		ChannelId:       recommendation.ChannelId,
+		LocalBalance:    recommendation.LocalBalance,
+		Capacity:        recommendation.Capacity,
+		FeeEstimation:   recommendation.FeeEstimation,
+		DismissedReason: recommendation.DismissedReason,
 	}
 }
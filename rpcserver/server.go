@@ -2,14 +2,13 @@ package rpcserver
 
 import (
 	"context"
-	"github.com/BoltzExchange/boltz-lnd/boltz"
+	"github.com/BoltzExchange/boltz-lnd/accounting"
+	"github.com/BoltzExchange/boltz-lnd/autoswap"
 	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
 	"github.com/BoltzExchange/boltz-lnd/database"
-	"github.com/BoltzExchange/boltz-lnd/lnd"
+	"github.com/BoltzExchange/boltz-lnd/keys"
 	"github.com/BoltzExchange/boltz-lnd/logger"
 	"github.com/BoltzExchange/boltz-lnd/macaroons"
-	"github.com/BoltzExchange/boltz-lnd/nursery"
-	"github.com/btcsuite/btcd/chaincfg"
 	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
@@ -27,6 +26,11 @@ type RpcServer struct {
 	RestPort     int    `long:"rpc.rest.port" description:"REST port to which Boltz should listen"`
 	RestDisabled bool   `long:"rpc.rest.disable" description:"Disables the REST API proxy"`
 
+	MetricsEnabled       bool   `long:"rpc.metrics.enable" description:"Enables the Prometheus metrics endpoint"`
+	MetricsHost          string `long:"rpc.metrics.host" description:"Host to which the metrics endpoint should listen"`
+	MetricsPort          int    `long:"rpc.metrics.port" description:"Port to which the metrics endpoint should listen"`
+	MetricsTimeoutMargin uint32 `long:"rpc.metrics.timeoutmargin" description:"Number of blocks before their timeout at which pending swaps are reported as nearing their timeout"`
+
 	TlsCertPath string `long:"rpc.tlscert" description:"Path to the TLS certificate of boltz-lnd"`
 	TlsKeyPath  string `long:"rpc.tlskey" description:"Path to the TLS private key of boltz-lnd"`
 
@@ -35,13 +39,14 @@ type RpcServer struct {
 	ReadonlyMacaroonPath string `long:"rpc.readonlymacaroonpath" description:"Path to the readonly macaroon"`
 }
 
+// Start serves the RPC requests for the nodes. The first one has to be the node of the [LND] section
 func (server *RpcServer) Start(
-	symbol string,
-	chainParams *chaincfg.Params,
-	lnd *lnd.LND,
-	boltz *boltz.Boltz,
-	nursery *nursery.Nursery,
+	nodes []*Node,
+	feePolicy *FeePolicy,
+	accountingConfig *accounting.Config,
 	database *database.Database,
+	seed *keys.Seed,
+	autoSwapper *autoswap.AutoSwapper,
 ) chan error {
 	errChannel := make(chan error)
 
@@ -87,16 +92,22 @@ func (server *RpcServer) Start(
 			serverOpts = append(serverOpts, chainedUnary, chainedStream)
 		}
 
+		router := &routedBoltzServer{
+			nodes: nodes,
+
+			feePolicy:  feePolicy,
+			accounting: accountingConfig,
+
+			database:    database,
+			seed:        seed,
+			autoSwapper: autoSwapper,
+		}
+
 		grpcServer := grpc.NewServer(serverOpts...)
-		boltzrpc.RegisterBoltzServer(grpcServer, &routedBoltzServer{
-			symbol:      symbol,
-			chainParams: chainParams,
+		boltzrpc.RegisterBoltzServer(grpcServer, router)
 
-			lnd:      lnd,
-			boltz:    boltz,
-			nursery:  nursery,
-			database: database,
-		})
+		// Autoswap creates its swaps through the router to apply the same checks as for swaps created via gRPC
+		autoSwapper.Start(router)
 
 		rpcUrl := server.Host + ":" + strconv.Itoa(server.Port)
 
@@ -152,6 +163,12 @@ func (server *RpcServer) Start(
 				errChannel <- http.ListenAndServeTLS(restUrl, server.TlsCertPath, server.TlsKeyPath, mux)
 			}()
 		}
+
+		if server.MetricsEnabled {
+			go func() {
+				errChannel <- server.startMetricsServer(router, database)
+			}()
+		}
 	}()
 
 	return errChannel
@@ -0,0 +1,147 @@
+package rpcserver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	unlockMethod        = "/boltzrpc.Boltz/Unlock"
+	setPassphraseMethod = "/boltzrpc.Boltz/SetPassphrase"
+)
+
+var errDaemonLocked = errors.New("boltz-lnd is locked; unlock it with \"boltzcli unlock\" first")
+
+// unlocker serves only the Unlock and SetPassphrase calls while the database is locked. The Macaroon root keys are
+// encrypted too, so the calls cannot be authenticated with Macaroons
+type unlocker struct {
+	boltzrpc.UnimplementedBoltzServer
+
+	database *database.Database
+
+	lock     sync.Mutex
+	unlocked chan struct{}
+}
+
+func (unlocker *unlocker) Unlock(_ context.Context, request *boltzrpc.UnlockRequest) (*boltzrpc.UnlockResponse, error) {
+	unlocker.lock.Lock()
+	defer unlocker.lock.Unlock()
+
+	err := unlocker.database.Unlock(request.Passphrase)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	close(unlocker.unlocked)
+
+	return &boltzrpc.UnlockResponse{}, nil
+}
+
+// Anybody who can reach the port could choose the passphrase otherwise
+func (unlocker *unlocker) SetPassphrase(ctx context.Context, request *boltzrpc.SetPassphraseRequest) (*boltzrpc.SetPassphraseResponse, error) {
+	if !isLoopbackPeer(ctx) {
+		return nil, handleError(errors.New("the passphrase can only be set from localhost"))
+	}
+
+	unlocker.lock.Lock()
+	defer unlocker.lock.Unlock()
+
+	err := unlocker.database.SetPassphrase(request.Passphrase)
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	close(unlocker.unlocked)
+
+	return &boltzrpc.SetPassphraseResponse{}, nil
+}
+
+func isLoopbackPeer(ctx context.Context) bool {
+	peerInfo, hasPeer := peer.FromContext(ctx)
+
+	if !hasPeer {
+		return false
+	}
+
+	address, isTcp := peerInfo.Addr.(*net.TCPAddr)
+
+	return isTcp && address.IP.IsLoopback()
+}
+
+// WaitForUnlock serves the Unlock and SetPassphrase calls on the gRPC port until the database was unlocked. The REST
+// proxy is not available while the database is locked
+func (server *RpcServer) WaitForUnlock(database *database.Database) error {
+	certData, err := loadCertificate(server.TlsCertPath, server.TlsKeyPath, false)
+
+	if err != nil {
+		return err
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(certData)),
+		grpc.UnaryInterceptor(func(
+			ctx context.Context,
+			req interface{},
+			info *grpc.UnaryServerInfo,
+			handler grpc.UnaryHandler,
+		) (interface{}, error) {
+			if info.FullMethod != unlockMethod && info.FullMethod != setPassphraseMethod {
+				return nil, errDaemonLocked
+			}
+
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(
+			_ interface{},
+			_ grpc.ServerStream,
+			_ *grpc.StreamServerInfo,
+			_ grpc.StreamHandler,
+		) error {
+			return errDaemonLocked
+		}),
+	)
+
+	unlocker := &unlocker{
+		database: database,
+		unlocked: make(chan struct{}),
+	}
+
+	boltzrpc.RegisterBoltzServer(grpcServer, unlocker)
+
+	rpcUrl := server.Host + ":" + strconv.Itoa(server.Port)
+	listener, err := net.Listen("tcp", rpcUrl)
+
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Waiting for the database to be unlocked on: " + rpcUrl)
+
+	errChannel := make(chan error, 1)
+
+	go func() {
+		errChannel <- grpcServer.Serve(listener)
+	}()
+
+	select {
+	case <-unlocker.unlocked:
+		// Lets the response of the Unlock call be sent before the port is freed for the RPC server
+		grpcServer.GracefulStop()
+		return nil
+
+	case err := <-errChannel:
+		return err
+	}
+}
@@ -0,0 +1,100 @@
+package rpcserver
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func TestWaitForUnlock(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "boltz-lnd")
+	assert.Nil(t, err)
+
+	defer os.RemoveAll(dataDir)
+
+	db := &database.Database{
+		Path:    path.Join(dataDir, "boltz.db"),
+		Encrypt: true,
+	}
+	assert.Nil(t, db.Connect())
+
+	server := &RpcServer{
+		Host:        "127.0.0.1",
+		Port:        19002,
+		TlsCertPath: path.Join(dataDir, "tls.cert"),
+		TlsKeyPath:  path.Join(dataDir, "tls.key"),
+	}
+
+	errChannel := make(chan error)
+
+	go func() {
+		errChannel <- server.WaitForUnlock(db)
+	}()
+
+	// The certificate is written before the server listens
+	for !fileExists(server.TlsKeyPath) {
+		select {
+		case err := <-errChannel:
+			t.Fatal(err)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	creds, err := credentials.NewClientTLSFromFile(server.TlsCertPath, "")
+	assert.Nil(t, err)
+
+	connection, err := grpc.Dial(
+		server.Host+":"+strconv.Itoa(server.Port),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithBlock(),
+	)
+	assert.Nil(t, err)
+
+	defer connection.Close()
+
+	client := boltzrpc.NewBoltzClient(connection)
+
+	_, err = client.GetInfo(context.Background(), &boltzrpc.GetInfoRequest{})
+	assert.Contains(t, err.Error(), errDaemonLocked.Error())
+
+	_, err = client.Unlock(context.Background(), &boltzrpc.UnlockRequest{})
+	assert.Contains(t, err.Error(), "passphrase cannot be empty")
+
+	// Unlocking a database without passphrase does not set one
+	_, err = client.Unlock(context.Background(), &boltzrpc.UnlockRequest{Passphrase: "passphrase"})
+	assert.Contains(t, err.Error(), database.ErrNoPassphrase.Error())
+
+	_, err = client.SetPassphrase(context.Background(), &boltzrpc.SetPassphraseRequest{Passphrase: "passphrase"})
+	assert.Nil(t, err)
+
+	assert.Nil(t, <-errChannel)
+
+	isLocked, err := db.IsLocked()
+	assert.Nil(t, err)
+	assert.False(t, isLocked)
+}
+
+func TestIsLoopbackPeer(t *testing.T) {
+	newPeerContext := func(address net.Addr) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: address})
+	}
+
+	assert.True(t, isLoopbackPeer(newPeerContext(&net.TCPAddr{IP: net.ParseIP("127.0.0.1")})))
+	assert.True(t, isLoopbackPeer(newPeerContext(&net.TCPAddr{IP: net.ParseIP("::1")})))
+
+	assert.False(t, isLoopbackPeer(newPeerContext(&net.TCPAddr{IP: net.ParseIP("192.168.1.2")})))
+	assert.False(t, isLoopbackPeer(newPeerContext(&net.UnixAddr{Name: "boltz.sock"})))
+	assert.False(t, isLoopbackPeer(context.Background()))
+}
@@ -6,8 +6,9 @@ import (
 
 // Block times in minutes
 const BitcoinBlockTime = float64(10)
-const LitecoinBlockTime = float64(2.5) 
-const LiquidBitcoinBlockTime = float64(1)
+const LitecoinBlockTime = float64(2.5)
+const LiquidBlockTime = float64(1)
+
 func GetBlockTime(symbol string) float64 {
 	var blockTime float64
 
@@ -17,8 +18,9 @@ func GetBlockTime(symbol string) float64 {
 
 	case "LTC":
 		blockTime = LitecoinBlockTime
-	case "LBTC";
-		blockTime = LiquidBitcoinBlockTime
+
+	case LiquidSymbol:
+		blockTime = LiquidBlockTime
 	}
 
 	return blockTime
@@ -8,6 +8,7 @@ import (
 func TestGetBlockTime(t *testing.T) {
 	assert.Equal(t, float64(10), GetBlockTime("BTC"))
 	assert.Equal(t, 2.5, GetBlockTime("LTC"))
+	assert.Equal(t, float64(1), GetBlockTime(LiquidSymbol))
 
 	// Should return 0 when the symbol cannot be found
 	assert.Equal(t, float64(0), GetBlockTime(""))
@@ -17,11 +18,14 @@ func TestGetBlockTime(t *testing.T) {
 func TestBlocksToHours(t *testing.T) {
 	assert.Equal(t, float64(10), BitcoinBlockTime)
 	assert.Equal(t, 2.5, LitecoinBlockTime)
+	assert.Equal(t, float64(1), LiquidBlockTime)
 
 	assert.Equal(t, "23.3", BlocksToHours(140, BitcoinBlockTime))
 	assert.Equal(t, "1.0", BlocksToHours(6, BitcoinBlockTime))
 
 	assert.Equal(t, "2.5", BlocksToHours(60, LitecoinBlockTime))
+
+	assert.Equal(t, "2.0", BlocksToHours(120, LiquidBlockTime))
 }
 
 func TestCalculateInvoiceExpiry(t *testing.T) {
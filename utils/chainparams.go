@@ -1,44 +1,104 @@
 package utils
 
 import (
+	"errors"
+
 	bitcoinCfg "github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/wire"
 	litecoinCfg "github.com/ltcsuite/ltcd/chaincfg"
-	LiquidNetworkCfg "github.com/liquidbitcoin/lbtcd/chaincfg"
+	liquidCfg "github.com/vulpemventures/go-elements/network"
 )
 
+// LiquidSymbol is the symbol of L-BTC in the pairs of the Boltz API
+const LiquidSymbol = "L-BTC"
+
 func ApplyLitecoinParams(litecoinParams litecoinCfg.Params) *bitcoinCfg.Params {
-	var bitcoinParams bitcoinCfg.Params 
-func ApplyLiquidBitcoinParams(LiquidBitcoinParams LiquidBitcoinCfg.Params)
+	var bitcoinParams bitcoinCfg.Params
 
 	bitcoinParams.Name = litecoinParams.Name
 	bitcoinParams.Net = wire.BitcoinNet(litecoinParams.Net)
 	bitcoinParams.DefaultPort = litecoinParams.DefaultPort
 	bitcoinParams.Bech32HRPSegwit = litecoinParams.Bech32HRPSegwit
-	bitcoinParams.Name=LiquidBitcoin.Name
-	bitcoinParams.Net=WireBitcoinNet(LiquidBitcoin.Net)
-	bticoinParams.DefaultPort = LiquidBitcoinParams.DefaultPort
-	bitcoinParams.Bech32HRPSegwit=LiquidBitcoin.Bech32HRPSegwit
 
 	bitcoinParams.PubKeyHashAddrID = litecoinParams.PubKeyHashAddrID
 	bitcoinParams.ScriptHashAddrID = litecoinParams.ScriptHashAddrID
 	bitcoinParams.PrivateKeyID = litecoinParams.PrivateKeyID
 	bitcoinParams.WitnessPubKeyHashAddrID = litecoinParams.WitnessPubKeyHashAddrID
 	bitcoinParams.WitnessScriptHashAddrID = litecoinParams.WitnessScriptHashAddrID
-	bitcoinParams.PubKeyHashAddrID = LiquidBitcoinParams.PubKeyHashAddrID
-	bitcoinParams.ScriptHashAddID = LiquidBitcoinParams.ScriptHashAddrID
-	bitcoinParams.PrivateKeyID= LiquidBitcoinParams.PrivateKeyID
-	bitcoinParams.WitnessPubKeyHashAddrID = LiquidBitcoinParams.WitnessPubKeyHashAddrID
-	bitcoinParams.WitnessScriptHashAddrID = LiquidBitcoinParams.WitnessScriptHashAddrID
 
 	bitcoinParams.HDPrivateKeyID = litecoinParams.HDPrivateKeyID
 	bitcoinParams.HDPublicKeyID = litecoinParams.HDPublicKeyID
-	bitcoinParams.HDPrivateKeyID = LiquidBitcoinParams.HDPrivateKeyID
-	bitcoinParams.HDPublicKeyID = LiquidBitcoinParams.HDPublicKeyID
 
 	bitcoinParams.HDCoinType = litecoinParams.HDCoinType
-	bitcoinParams.HDCoinType = LiquidBitcoinParams.HDCoinType
 
+	return &bitcoinParams
+}
+
+// ApplyLiquidParams maps the unconfidential address prefixes of a Liquid network to Bitcoin chain params.
+// Confidential addresses and transactions have to be handled with the Liquid network directly
+func ApplyLiquidParams(liquidParams liquidCfg.Network) *bitcoinCfg.Params {
+	var bitcoinParams bitcoinCfg.Params
+
+	bitcoinParams.Name = liquidParams.Name
+	bitcoinParams.Bech32HRPSegwit = liquidParams.Bech32
+
+	bitcoinParams.PubKeyHashAddrID = liquidParams.PubKeyHash
+	bitcoinParams.ScriptHashAddrID = liquidParams.ScriptHash
+	bitcoinParams.PrivateKeyID = liquidParams.Wif
+
+	bitcoinParams.HDPrivateKeyID = liquidParams.HDPrivateKey
+	bitcoinParams.HDPublicKeyID = liquidParams.HDPublicKey
 
 	return &bitcoinParams
 }
+
+// GetLiquidNetwork returns the Liquid network for chain params that were created with ApplyLiquidParams
+func GetLiquidNetwork(chainParams *bitcoinCfg.Params) *liquidCfg.Network {
+	switch chainParams.Name {
+	case liquidCfg.Liquid.Name:
+		return &liquidCfg.Liquid
+
+	case liquidCfg.Regtest.Name:
+		return &liquidCfg.Regtest
+	}
+
+	return nil
+}
+
+// GetChainParams returns the chain params of a currency on a network like "mainnet", "testnet" or "regtest"
+func GetChainParams(symbol string, network string) (*bitcoinCfg.Params, error) {
+	switch symbol {
+	case "BTC":
+		switch network {
+		case "mainnet":
+			return &bitcoinCfg.MainNetParams, nil
+		case "testnet":
+			return &bitcoinCfg.TestNet3Params, nil
+		case "regtest":
+			return &bitcoinCfg.RegressionNetParams, nil
+		}
+
+	case "LTC":
+		switch network {
+		case "mainnet":
+			return ApplyLitecoinParams(litecoinCfg.MainNetParams), nil
+		case "testnet":
+			return ApplyLitecoinParams(litecoinCfg.TestNet4Params), nil
+		case "regtest":
+			return ApplyLitecoinParams(litecoinCfg.RegressionNetParams), nil
+		}
+
+	case LiquidSymbol:
+		switch network {
+		case "mainnet":
+			return ApplyLiquidParams(liquidCfg.Liquid), nil
+		case "regtest":
+			return ApplyLiquidParams(liquidCfg.Regtest), nil
+		}
+
+	default:
+		return nil, errors.New("currency " + symbol + " not supported")
+	}
+
+	return nil, errors.New("network " + network + " of " + symbol + " not supported")
+}
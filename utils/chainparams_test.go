@@ -1,8 +1,10 @@
 package utils
 
 import (
+	bitcoinCfg "github.com/btcsuite/btcd/chaincfg"
 	litecoinCfg "github.com/ltcsuite/ltcd/chaincfg"
 	"github.com/stretchr/testify/assert"
+	liquidCfg "github.com/vulpemventures/go-elements/network"
 	"reflect"
 	"testing"
 )
@@ -45,3 +47,47 @@ func TestApplyLitecoinParams(t *testing.T) {
 
 	assert.Equal(t, 10, comparedValues)
 }
+
+func TestApplyLiquidParams(t *testing.T) {
+	params := ApplyLiquidParams(liquidCfg.Regtest)
+
+	assert.Equal(t, liquidCfg.Regtest.Name, params.Name)
+	assert.Equal(t, liquidCfg.Regtest.Bech32, params.Bech32HRPSegwit)
+
+	assert.Equal(t, liquidCfg.Regtest.PubKeyHash, params.PubKeyHashAddrID)
+	assert.Equal(t, liquidCfg.Regtest.ScriptHash, params.ScriptHashAddrID)
+	assert.Equal(t, liquidCfg.Regtest.Wif, params.PrivateKeyID)
+
+	assert.Equal(t, liquidCfg.Regtest.HDPrivateKey, params.HDPrivateKeyID)
+	assert.Equal(t, liquidCfg.Regtest.HDPublicKey, params.HDPublicKeyID)
+}
+
+func TestGetLiquidNetwork(t *testing.T) {
+	assert.Equal(t, &liquidCfg.Liquid, GetLiquidNetwork(ApplyLiquidParams(liquidCfg.Liquid)))
+	assert.Equal(t, &liquidCfg.Regtest, GetLiquidNetwork(ApplyLiquidParams(liquidCfg.Regtest)))
+
+	assert.Nil(t, GetLiquidNetwork(&bitcoinCfg.MainNetParams))
+}
+
+func TestGetChainParams(t *testing.T) {
+	params, err := GetChainParams("BTC", "regtest")
+
+	assert.Nil(t, err)
+	assert.Equal(t, &bitcoinCfg.RegressionNetParams, params)
+
+	params, err = GetChainParams("LTC", "testnet")
+
+	assert.Nil(t, err)
+	assert.Equal(t, litecoinCfg.TestNet4Params.Name, params.Name)
+
+	params, err = GetChainParams(LiquidSymbol, "mainnet")
+
+	assert.Nil(t, err)
+	assert.Equal(t, liquidCfg.Liquid.Name, params.Name)
+
+	_, err = GetChainParams(LiquidSymbol, "testnet")
+	assert.Equal(t, "network testnet of L-BTC not supported", err.Error())
+
+	_, err = GetChainParams("DOGE", "mainnet")
+	assert.Equal(t, "currency DOGE not supported", err.Error())
+}
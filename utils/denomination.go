@@ -5,10 +5,9 @@ func GetSmallestUnitName(symbol string) string {
 	case "LTC":
 		return "litoshi"
 
-	default:
-		return "satoshi"
-	case "LBTC"
+	case LiquidSymbol:
 		return "liquitoshi"
+
 	default:
 		return "satoshi"
 	}
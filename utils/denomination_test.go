@@ -8,6 +8,7 @@ import (
 func TestGetSmallestUnitName(t *testing.T) {
 	assert.Equal(t, "satoshi", GetSmallestUnitName("BTC"))
 	assert.Equal(t, "litoshi", GetSmallestUnitName("LTC"))
+	assert.Equal(t, "liquitoshi", GetSmallestUnitName(LiquidSymbol))
 
 	// Default value
 	assert.Equal(t, "satoshi", GetSmallestUnitName("NOTFOUND"))
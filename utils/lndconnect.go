@@ -8,7 +8,7 @@ import (
 	"strings"
 )
 
-func ConnectBoltzLnd(lnd *lnd.LND, boltz *boltz.Boltz, symbol string) (string, error) {
+func ConnectBoltzLnd(lnd lnd.LightningClient, boltz *boltz.Boltz, symbol string) (string, error) {
 	nodes, err := boltz.GetNodes()
 
 	if err != nil {
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// ReadPassphrase reads the passphrase of an encrypted database from the terminal or, if it is not a terminal, from stdin
+func ReadPassphrase() (string, error) {
+	stdin := int(os.Stdin.Fd())
+
+	if !term.IsTerminal(stdin) {
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+
+		if err != nil && err != io.EOF {
+			return "", err
+		}
+
+		return strings.TrimRight(line, "\r\n"), nil
+	}
+
+	fmt.Print("Passphrase: ")
+	passphrase, err := term.ReadPassword(stdin)
+	fmt.Println()
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(passphrase), nil
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"encoding/json"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ParseRescueFile parses a file of the exportrescue command or a refund file of the Boltz web app. The latter contain a
+// single swap instead of a list of them
+func ParseRescueFile(file []byte) ([]*boltzrpc.RescueSwap, error) {
+	var rawSwaps []json.RawMessage
+
+	if json.Unmarshal(file, &rawSwaps) != nil {
+		rawSwaps = []json.RawMessage{file}
+	}
+
+	unmarshalOptions := protojson.UnmarshalOptions{
+		DiscardUnknown: true,
+	}
+
+	swaps := make([]*boltzrpc.RescueSwap, 0, len(rawSwaps))
+
+	for _, rawSwap := range rawSwaps {
+		var swap boltzrpc.RescueSwap
+		err := unmarshalOptions.Unmarshal(rawSwap, &swap)
+
+		if err != nil {
+			return nil, err
+		}
+
+		swaps = append(swaps, &swap)
+	}
+
+	return swaps, nil
+}
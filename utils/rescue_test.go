@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRescueFile(t *testing.T) {
+	// Refund file of the web app with a field that is not known
+	swaps, err := ParseRescueFile([]byte(`{"id": "webApp", "currency": "BTC", "privateKey": "01", "redeemScript": "02", "timeoutBlockHeight": 123, "asset": "BTC"}`))
+
+	assert.Nil(t, err)
+	assert.Len(t, swaps, 1)
+	assert.Equal(t, "webApp", swaps[0].Id)
+	assert.Equal(t, "BTC", swaps[0].Currency)
+	assert.Equal(t, "01", swaps[0].PrivateKey)
+	assert.Equal(t, "02", swaps[0].RedeemScript)
+	assert.Equal(t, uint32(123), swaps[0].TimeoutBlockHeight)
+	assert.Equal(t, boltzrpc.SwapType_SUBMARINE, swaps[0].Type)
+
+	swaps, err = ParseRescueFile([]byte(`[{"id": "swap"}, {"id": "reverse", "type": "REVERSE_SUBMARINE", "preimage": "03"}]`))
+
+	assert.Nil(t, err)
+	assert.Len(t, swaps, 2)
+	assert.Equal(t, "swap", swaps[0].Id)
+	assert.Equal(t, "reverse", swaps[1].Id)
+	assert.Equal(t, boltzrpc.SwapType_REVERSE_SUBMARINE, swaps[1].Type)
+	assert.Equal(t, "03", swaps[1].Preimage)
+
+	_, err = ParseRescueFile([]byte("not json"))
+	assert.NotNil(t, err)
+}
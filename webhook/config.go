@@ -0,0 +1,48 @@
+package webhook
+
+import (
+	"errors"
+	"net/url"
+)
+
+type Config struct {
+	Urls []string `long:"webhook.url" description:"URL to which swap events are posted. Can be set multiple times"`
+
+	// Not serialized to keep it out of the log of the parsed config
+	Secret string `long:"webhook.secret" description:"Shared secret with which the payloads are signed" json:"-"`
+
+	MaxAttempts   uint32 `long:"webhook.maxattempts" description:"Number of times the delivery of a notification is attempted before it is given up on"`
+	RetryInterval uint32 `long:"webhook.retryinterval" description:"Seconds after which a failed delivery is retried for the first time. The interval doubles with every attempt"`
+}
+
+func (cfg *Config) Validate() error {
+	if len(cfg.Urls) == 0 {
+		return nil
+	}
+
+	if cfg.Secret == "" {
+		return errors.New("secret has to be set when webhook URLs are configured")
+	}
+
+	for _, webhookUrl := range cfg.Urls {
+		parsedUrl, err := url.Parse(webhookUrl)
+
+		if err != nil {
+			return errors.New("invalid URL " + webhookUrl + ": " + err.Error())
+		}
+
+		if parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https" {
+			return errors.New("URL " + webhookUrl + " has to use http or https")
+		}
+	}
+
+	if cfg.MaxAttempts == 0 {
+		return errors.New("maximal number of attempts has to be greater than 0")
+	}
+
+	if cfg.RetryInterval == 0 {
+		return errors.New("retry interval has to be greater than 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,313 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/BoltzExchange/boltz-lnd/logger"
+)
+
+// Header that contains the hex encoded HMAC-SHA256 of the request body keyed with the shared secret
+const SignatureHeader = "X-Boltz-Signature"
+
+const (
+	requestTimeout = 10 * time.Second
+	pollInterval   = 5 * time.Second
+
+	// The retry interval doubles with every failed attempt up to this delay
+	maxRetryDelay = time.Hour
+)
+
+// Events that are sent for state changes. Others are sent when refund or claim transactions are broadcast
+const (
+	refundBroadcastEvent = "refund_broadcast"
+	claimBroadcastEvent  = "claim_broadcast"
+)
+
+// Payload is the JSON body that is posted to the webhook URLs
+type Payload struct {
+	Event    string `json:"event"`
+	SwapType string `json:"swapType"`
+	Id       string `json:"id"`
+	State    string `json:"state"`
+
+	Error         string `json:"error,omitempty"`
+	TransactionId string `json:"transactionId,omitempty"`
+
+	Timestamp int64 `json:"timestamp"`
+}
+
+// States of Swaps and Reverse Swaps for which notifications are sent
+var notifiedStates = map[boltzrpc.SwapState]bool{
+	boltzrpc.SwapState_SUCCESSFUL:   true,
+	boltzrpc.SwapState_ERROR:        true,
+	boltzrpc.SwapState_SERVER_ERROR: true,
+	boltzrpc.SwapState_ABANDONED:    true,
+}
+
+type Notifier struct {
+	cfg      *Config
+	database *database.Database
+
+	client *http.Client
+
+	// Signals the delivery loop that new notifications were added to the outbox
+	newNotification chan bool
+}
+
+func (notifier *Notifier) Init(cfg *Config, database *database.Database) error {
+	err := cfg.Validate()
+
+	if err != nil {
+		return errors.New("invalid webhook config: " + err.Error())
+	}
+
+	notifier.cfg = cfg
+	notifier.database = database
+
+	notifier.client = &http.Client{
+		Timeout: requestTimeout,
+	}
+	notifier.newNotification = make(chan bool, 1)
+
+	return nil
+}
+
+// Start adds notifications to the outbox in the transactions of the swap updates that cause them and delivers them.
+// Notifications that were in the outbox already when the daemon was stopped are delivered too
+func (notifier *Notifier) Start() {
+	if len(notifier.cfg.Urls) == 0 {
+		return
+	}
+
+	logger.Info("Starting webhook notifier for " + strconv.Itoa(len(notifier.cfg.Urls)) + " URLs")
+
+	notifier.database.RecordSwapEvents(notifier.recordSwapEvent)
+
+	// The subscription only speeds up the delivery. Events it drops are delivered on the next poll
+	events, _ := notifier.database.SubscribeSwapEvents()
+
+	go func() {
+		for event := range events {
+			if len(getPayloads(event, time.Now())) == 0 {
+				continue
+			}
+
+			select {
+			case notifier.newNotification <- true:
+			default:
+			}
+		}
+	}()
+
+	go func() {
+		ticker := time.NewTicker(pollInterval)
+
+		for {
+			notifier.deliverDueNotifications(time.Now())
+
+			select {
+			case <-ticker.C:
+			case <-notifier.newNotification:
+			}
+		}
+	}()
+}
+
+func (notifier *Notifier) recordSwapEvent(transaction *database.Database, event database.SwapEvent) error {
+	return notifier.addNotifications(transaction, event, time.Now())
+}
+
+// Errors roll back the update that caused the event, so that no notification is lost
+func (notifier *Notifier) addNotifications(transaction *database.Database, event database.SwapEvent, now time.Time) error {
+	for _, payload := range getPayloads(event, now) {
+		rawPayload, err := json.Marshal(payload)
+
+		if err != nil {
+			return errors.New("could not serialize webhook payload: " + err.Error())
+		}
+
+		transactionId := ""
+
+		if payload.Event == refundBroadcastEvent || payload.Event == claimBroadcastEvent {
+			transactionId = payload.TransactionId
+		}
+
+		for _, webhookUrl := range notifier.cfg.Urls {
+			_, err := transaction.CreateWebhookNotification(database.WebhookNotification{
+				Url:           webhookUrl,
+				SwapId:        payload.Id,
+				Event:         payload.Event,
+				TransactionId: transactionId,
+				Payload:       string(rawPayload),
+				NextAttempt:   now,
+			})
+
+			if err != nil {
+				return errors.New("could not save webhook notification for " + payload.Id + ": " + err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+func (notifier *Notifier) deliverDueNotifications(now time.Time) {
+	notifications, err := notifier.database.QueryDueWebhookNotifications(now)
+
+	if err != nil {
+		logger.Error("Could not query webhook notifications: " + err.Error())
+		return
+	}
+
+	for i := range notifications {
+		notification := &notifications[i]
+		description := "webhook notification " + notification.Event + " of " + notification.SwapId + " to " + notification.Url
+
+		err := notifier.send(notification)
+
+		if err == nil {
+			logger.Info("Delivered " + description)
+			err = notifier.database.SetWebhookNotificationDelivered(notification)
+		} else {
+			failed := notification.Attempts+1 >= notifier.cfg.MaxAttempts
+
+			if failed {
+				logger.Error("Giving up on " + description + ": " + err.Error())
+			} else {
+				logger.Warning("Could not deliver " + description + ": " + err.Error())
+			}
+
+			err = notifier.database.SetWebhookNotificationAttemptFailed(
+				notification,
+				now.Add(notifier.getRetryDelay(notification.Attempts+1)),
+				failed,
+			)
+		}
+
+		if err != nil {
+			logger.Error("Could not update " + description + ": " + err.Error())
+		}
+	}
+}
+
+func (notifier *Notifier) send(notification *database.WebhookNotification) error {
+	payload := []byte(notification.Payload)
+
+	request, err := http.NewRequest(http.MethodPost, notification.Url, bytes.NewReader(payload))
+
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(SignatureHeader, Sign(notifier.cfg.Secret, payload))
+
+	response, err := notifier.client.Do(request)
+
+	if err != nil {
+		return err
+	}
+
+	_ = response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return errors.New("unexpected status code " + strconv.Itoa(response.StatusCode))
+	}
+
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the payload keyed with the secret
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (notifier *Notifier) getRetryDelay(attempts uint32) time.Duration {
+	delay := time.Duration(notifier.cfg.RetryInterval) * time.Second
+
+	for i := uint32(1); i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+
+	return delay
+}
+
+// The same payloads are created for every event of a swap that reached a notified state. The outbox makes sure that
+// they are only sent once
+func getPayloads(event database.SwapEvent, now time.Time) []Payload {
+	var payloads []Payload
+
+	newPayload := func(swapType string, eventName string, state boltzrpc.SwapState, error string, transactionId string) {
+		payloads = append(payloads, Payload{
+			Event:         eventName,
+			SwapType:      swapType,
+			Id:            event.Id,
+			State:         state.String(),
+			Error:         error,
+			TransactionId: transactionId,
+			Timestamp:     now.Unix(),
+		})
+	}
+
+	switch event.Type {
+	case database.SubmarineSwap, database.ChannelCreationSwap:
+		swap := event.Swap
+
+		if swap == nil {
+			break
+		}
+
+		swapType := "submarine"
+
+		if event.Type == database.ChannelCreationSwap {
+			swapType = "channelCreation"
+		}
+
+		if notifiedStates[swap.State] {
+			newPayload(swapType, formatState(swap.State), swap.State, swap.Error, "")
+		}
+
+		if swap.RefundTransactionId != "" {
+			newPayload(swapType, refundBroadcastEvent, swap.State, swap.Error, swap.RefundTransactionId)
+		}
+
+	case database.ReverseSubmarineSwap:
+		reverseSwap := event.ReverseSwap
+
+		if reverseSwap == nil {
+			break
+		}
+
+		if reverseSwap.ClaimTransactionId != "" {
+			newPayload("reverse", claimBroadcastEvent, reverseSwap.State, reverseSwap.Error, reverseSwap.ClaimTransactionId)
+		}
+
+		if notifiedStates[reverseSwap.State] {
+			newPayload("reverse", formatState(reverseSwap.State), reverseSwap.State, reverseSwap.Error, reverseSwap.ClaimTransactionId)
+		}
+	}
+
+	return payloads
+}
+
+func formatState(state boltzrpc.SwapState) string {
+	return strings.ToLower(state.String())
+}
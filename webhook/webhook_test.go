@@ -0,0 +1,200 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-lnd/boltzrpc"
+	"github.com/BoltzExchange/boltz-lnd/database"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+)
+
+var testConfig = Config{
+	Secret:        "secret",
+	MaxAttempts:   2,
+	RetryInterval: 10,
+}
+
+func newTestNotifier(t *testing.T, urls []string) (*Notifier, func()) {
+	dataDir, err := ioutil.TempDir("", "boltz-lnd")
+	assert.Nil(t, err)
+
+	db := &database.Database{
+		Path: path.Join(dataDir, "boltz.db"),
+	}
+	assert.Nil(t, db.Connect())
+
+	cfg := testConfig
+	cfg.Urls = urls
+
+	notifier := &Notifier{}
+	assert.Nil(t, notifier.Init(&cfg, db))
+
+	return notifier, func() {
+		_ = os.RemoveAll(dataDir)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cfg := testConfig
+	assert.Nil(t, cfg.Validate())
+
+	cfg.Urls = []string{"https://example.com/webhook"}
+	assert.Nil(t, cfg.Validate())
+
+	cfg.Urls = []string{"ftp://example.com"}
+	assert.Equal(t, "URL ftp://example.com has to use http or https", cfg.Validate().Error())
+
+	cfg.Urls = []string{"https://example.com/webhook"}
+	cfg.Secret = ""
+	assert.Equal(t, "secret has to be set when webhook URLs are configured", cfg.Validate().Error())
+}
+
+func TestSign(t *testing.T) {
+	// Test vector 2 of RFC 4231
+	assert.Equal(
+		t,
+		"5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843",
+		Sign("Jefe", []byte("what do ya want for nothing?")),
+	)
+}
+
+func TestGetRetryDelay(t *testing.T) {
+	notifier := &Notifier{cfg: &testConfig}
+
+	assert.Equal(t, 10*time.Second, notifier.getRetryDelay(1))
+	assert.Equal(t, 20*time.Second, notifier.getRetryDelay(2))
+	assert.Equal(t, 80*time.Second, notifier.getRetryDelay(4))
+	assert.Equal(t, maxRetryDelay, notifier.getRetryDelay(100))
+}
+
+func TestGetPayloads(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+
+	assert.Empty(t, getPayloads(database.SwapEvent{
+		Type: database.SubmarineSwap,
+		Id:   "swap",
+		Swap: &database.Swap{Id: "swap", State: boltzrpc.SwapState_PENDING},
+	}, now))
+
+	assert.Equal(t, []Payload{
+		{Event: "error", SwapType: "channelCreation", Id: "swap", State: "ERROR", Error: "failed", Timestamp: now.Unix()},
+	}, getPayloads(database.SwapEvent{
+		Type: database.ChannelCreationSwap,
+		Id:   "swap",
+		Swap: &database.Swap{Id: "swap", State: boltzrpc.SwapState_ERROR, Error: "failed"},
+	}, now))
+
+	assert.Equal(t, []Payload{
+		{Event: refundBroadcastEvent, SwapType: "submarine", Id: "swap", State: "REFUNDED", TransactionId: "refund", Timestamp: now.Unix()},
+	}, getPayloads(database.SwapEvent{
+		Type: database.SubmarineSwap,
+		Id:   "swap",
+		Swap: &database.Swap{Id: "swap", State: boltzrpc.SwapState_REFUNDED, RefundTransactionId: "refund"},
+	}, now))
+
+	assert.Equal(t, []Payload{
+		{Event: claimBroadcastEvent, SwapType: "reverse", Id: "reverse", State: "SUCCESSFUL", TransactionId: "claim", Timestamp: now.Unix()},
+		{Event: "successful", SwapType: "reverse", Id: "reverse", State: "SUCCESSFUL", TransactionId: "claim", Timestamp: now.Unix()},
+	}, getPayloads(database.SwapEvent{
+		Type:        database.ReverseSubmarineSwap,
+		Id:          "reverse",
+		ReverseSwap: &database.ReverseSwap{Id: "reverse", State: boltzrpc.SwapState_SUCCESSFUL, ClaimTransactionId: "claim"},
+	}, now))
+}
+
+func TestDeliverNotifications(t *testing.T) {
+	var received []Payload
+	failRequests := true
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, err := ioutil.ReadAll(request.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, Sign(testConfig.Secret, body), request.Header.Get(SignatureHeader))
+
+		if failRequests {
+			writer.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var payload Payload
+		assert.Nil(t, json.Unmarshal(body, &payload))
+
+		received = append(received, payload)
+	}))
+	defer server.Close()
+
+	notifier, cleanup := newTestNotifier(t, []string{server.URL})
+	defer cleanup()
+
+	now := time.Unix(1600000000, 0)
+	event := database.SwapEvent{
+		Type: database.SubmarineSwap,
+		Id:   "swap",
+		Swap: &database.Swap{Id: "swap", State: boltzrpc.SwapState_SUCCESSFUL},
+	}
+
+	// Events of swaps that were notified about already are ignored
+	assert.Nil(t, notifier.addNotifications(notifier.database, event, now))
+	assert.Nil(t, notifier.addNotifications(notifier.database, event, now))
+
+	notifier.deliverDueNotifications(now)
+	assert.Empty(t, received)
+
+	// The failed delivery is retried after the retry interval
+	failRequests = false
+
+	notifier.deliverDueNotifications(now)
+	assert.Empty(t, received)
+
+	notifier.deliverDueNotifications(now.Add(10 * time.Second))
+	assert.Len(t, received, 1)
+	assert.Equal(t, "successful", received[0].Event)
+
+	notifier.deliverDueNotifications(now.Add(time.Hour))
+	assert.Len(t, received, 1)
+}
+
+func TestRecordSwapEvents(t *testing.T) {
+	notifier, cleanup := newTestNotifier(t, []string{"https://example.com/webhook"})
+	defer cleanup()
+
+	notifier.database.RecordSwapEvents(notifier.recordSwapEvent)
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	reverseSwap := database.ReverseSwap{
+		Id:         "reverse",
+		State:      boltzrpc.SwapState_PENDING,
+		PrivateKey: privateKey,
+	}
+	assert.Nil(t, notifier.database.CreateReverseSwap(reverseSwap))
+
+	// Notifications are in the outbox as soon as the update returns, even if nobody reads the swap events
+	_, _ = notifier.database.SubscribeSwapEvents()
+
+	assert.Nil(t, notifier.database.SetReverseSwapClaimTransactionId(&reverseSwap, "claim"))
+
+	// A claim transaction with a bumped fee is notified about too
+	assert.Nil(t, notifier.database.SetReverseSwapClaimTransactionId(&reverseSwap, "bumped"))
+	assert.Nil(t, notifier.database.UpdateReverseSwapState(&reverseSwap, boltzrpc.SwapState_SUCCESSFUL, ""))
+
+	notifications, err := notifier.database.QueryDueWebhookNotifications(time.Now().Add(time.Minute))
+	assert.Nil(t, err)
+
+	assert.Len(t, notifications, 3)
+	assert.Equal(t, claimBroadcastEvent, notifications[0].Event)
+	assert.Equal(t, "claim", notifications[0].TransactionId)
+	assert.Equal(t, claimBroadcastEvent, notifications[1].Event)
+	assert.Equal(t, "bumped", notifications[1].TransactionId)
+	assert.Equal(t, "successful", notifications[2].Event)
+	assert.Equal(t, "", notifications[2].TransactionId)
+}